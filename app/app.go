@@ -4,8 +4,10 @@ import (
 	"context"
 	"path"
 
+	"github.com/alecsavvy/mojave/config"
 	"github.com/alecsavvy/mojave/store"
 	"github.com/cockroachdb/pebble"
+	dbm "github.com/cometbft/cometbft-db"
 	cfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/p2p"
 	"github.com/cometbft/cometbft/privval"
@@ -19,17 +21,24 @@ import (
 type App struct {
 	logger *zap.SugaredLogger
 	node   *nm.Node
+	db     *pebble.DB
+
+	// txIndexDB is opened for the node but not closed by it when it stops.
+	txIndexDB dbm.DB
 }
 
 // NewApp starts a node from an already-initialized config. The caller must have
 // written config.toml, genesis.json, priv validator key/state, and node key to the config's RootDir.
-func NewApp(cmtConfig *cfg.Config) (*App, error) {
+func NewApp(cmtConfig *cfg.Config, appConfig *config.AppConfig) (*App, error) {
 	z, _ := zap.NewDevelopment()
 	logger := z.Sugar()
 
 	if err := cmtConfig.ValidateBasic(); err != nil {
 		return nil, err
 	}
+	if err := appConfig.ValidateBasic(); err != nil {
+		return nil, err
+	}
 
 	pv := privval.LoadFilePV(cmtConfig.PrivValidatorKeyFile(), cmtConfig.PrivValidatorStateFile())
 	nodeKey, err := p2p.LoadNodeKey(cmtConfig.NodeKeyFile())
@@ -49,7 +58,29 @@ func NewApp(cmtConfig *cfg.Config) (*App, error) {
 	logger = logger.With("addr", addr)
 
 	appStore := store.NewStore(db)
-	abci := NewKVStoreApplication(logger, appStore)
+	snapshots, err := store.NewSnapshotStore(path.Join(cmtConfig.RootDir, "snapshots"), appConfig.SnapshotKeepRecent, store.DefaultSnapshotChunkSize)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := NewDirContentSource(path.Join(cmtConfig.RootDir, "content"))
+	if err != nil {
+		return nil, err
+	}
+
+	abci, err := NewKVStoreApplication(logger, appStore, snapshots, content, appConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	var txIndexDB dbm.DB
+	dbProvider := func(ctx *cfg.DBContext) (dbm.DB, error) {
+		db, err := cfg.DefaultDBProvider(ctx)
+		if ctx.ID == "tx_index" {
+			txIndexDB = db
+		}
+		return db, err
+	}
 
 	node, err := nm.NewNode(
 		context.Background(),
@@ -58,7 +89,7 @@ func NewApp(cmtConfig *cfg.Config) (*App, error) {
 		nodeKey,
 		proxy.NewLocalClientCreator(abci),
 		nm.DefaultGenesisDocProviderFunc(cmtConfig),
-		cfg.DefaultDBProvider,
+		dbProvider,
 		nm.DefaultMetricsProvider(cmtConfig.Instrumentation),
 		cmtLogger,
 	)
@@ -72,6 +103,9 @@ func NewApp(cmtConfig *cfg.Config) (*App, error) {
 	return &App{
 		logger: logger,
 		node:   node,
+		db:     db,
+
+		txIndexDB: txIndexDB,
 	}, nil
 }
 
@@ -80,6 +114,16 @@ func (a *App) Start() error {
 	return nil
 }
 
+// Stop stops the node and closes the app's store once consensus no longer writes to it.
 func (a *App) Stop() error {
-	return a.node.Stop()
+	if err := a.node.Stop(); err != nil {
+		return err
+	}
+	a.node.Wait()
+	if a.txIndexDB != nil {
+		if err := a.txIndexDB.Close(); err != nil {
+			return err
+		}
+	}
+	return a.db.Close()
 }
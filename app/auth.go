@@ -0,0 +1,23 @@
+package app
+
+import (
+	"bytes"
+
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+)
+
+// authorize checks every "from" field of a transaction body against the transaction signer,
+// so that a key can only ever act on its own account.
+func authorize(transaction *v1.Transaction) error {
+	signer := transaction.Header.FromPubkey
+
+	switch body := transaction.Body.GetBody().(type) {
+	case *v1.TransactionBody_TokenTransfer:
+		if !bytes.Equal(body.TokenTransfer.FromPubkey, signer) {
+			return newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_UNAUTHORIZED,
+				"token transfer from %x is not signed by its owner", body.TokenTransfer.FromPubkey)
+		}
+	}
+
+	return nil
+}
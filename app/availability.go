@@ -0,0 +1,294 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/cockroachdb/pebble"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"google.golang.org/protobuf/proto"
+)
+
+// Validators attest to the registered content they can serve in the vote extension of every
+// precommit. The proposer of the next block gathers the attestations of the commit it builds on
+// into an availability transaction at the start of its block, and executing it scores content
+// by the share of voting power that attested to it.
+//
+// Registering content can cost next to nothing, so each height only covers a window of at most
+// MaxAvailabilityContent items, starting at a position picked by the height and wrapping around.
+// Validators attest to what they serve within the window of the height they vote at, and the
+// block carrying their votes rescores the items of that window. Votes at a height are made
+// against the state before its block, so the window only holds content registered before the
+// height, however much has been registered by the time the votes are scored.
+
+// ContentSource reports whether this node can currently serve a content item.
+type ContentSource interface {
+	HasContent(contentHash []byte) bool
+}
+
+// DirContentSource serves the content stored in a directory, each item in a file named after
+// the hex encoded hash of its bytes.
+type DirContentSource struct {
+	dir string
+}
+
+func NewDirContentSource(dir string) (*DirContentSource, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &DirContentSource{dir: dir}, nil
+}
+
+func (s *DirContentSource) HasContent(contentHash []byte) bool {
+	info, err := os.Stat(filepath.Join(s.dir, hex.EncodeToString(contentHash)))
+	return err == nil && info.Mode().IsRegular()
+}
+
+// availabilityBasisPoints is what a content item attested to by every validator scores.
+const availabilityBasisPoints = 10_000
+
+// MaxAvailabilityContent is the most content items an attestation lists and a block rescores.
+const MaxAvailabilityContent = 1024
+
+// eachAvailabilityContent calls fn for the content registered before height in the availability
+// window of the votes at height.
+func (app *KVStoreApplication) eachAvailabilityContent(ctx context.Context, height int64, fn func(content *v1.ContentState) error) error {
+	start := sha256.Sum256(binary.BigEndian.AppendUint64(nil, uint64(height)))
+	return app.store.EachContentFrom(ctx, start[:], uint64(height), MaxAvailabilityContent, fn)
+}
+
+// attestation is an availability attestation verified against the commit it is part of.
+type attestation struct {
+	contentHashes [][]byte
+	power         int64
+}
+
+func (app *KVStoreApplication) ExtendVote(ctx context.Context, extend *abcitypes.ExtendVoteRequest) (*abcitypes.ExtendVoteResponse, error) {
+	attestation := &v1.AvailabilityAttestation{}
+	if app.content != nil {
+		if err := app.eachAvailabilityContent(ctx, extend.Height, func(content *v1.ContentState) error {
+			if app.content.HasContent(content.ContentHash) {
+				attestation.ContentHashes = append(attestation.ContentHashes, content.ContentHash)
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		// the window wraps around, and attestations are sorted
+		slices.SortFunc(attestation.ContentHashes, bytes.Compare)
+	}
+
+	extension, err := proto.Marshal(attestation)
+	if err != nil {
+		return nil, err
+	}
+	return &abcitypes.ExtendVoteResponse{VoteExtension: extension}, nil
+}
+
+func (app *KVStoreApplication) VerifyVoteExtension(ctx context.Context, verify *abcitypes.VerifyVoteExtensionRequest) (*abcitypes.VerifyVoteExtensionResponse, error) {
+	if _, err := app.checkAttestation(ctx, verify.Height, verify.VoteExtension); err != nil {
+		app.logger.Warnw("rejecting vote extension", "height", verify.Height, "validator", fmt.Sprintf("%X", verify.ValidatorAddress), "err", err)
+		return &abcitypes.VerifyVoteExtensionResponse{Status: abcitypes.VERIFY_VOTE_EXTENSION_STATUS_REJECT}, nil
+	}
+	return &abcitypes.VerifyVoteExtensionResponse{Status: abcitypes.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT}, nil
+}
+
+// checkAttestation decodes a vote extension at height and checks that it only attests to content
+// registered before the height, each item once and in order, and to no more items than a window
+// holds.
+func (app *KVStoreApplication) checkAttestation(ctx context.Context, height int64, extension []byte) (*v1.AvailabilityAttestation, error) {
+	attestation := &v1.AvailabilityAttestation{}
+	if err := proto.Unmarshal(extension, attestation); err != nil {
+		return nil, err
+	}
+	if len(attestation.ContentHashes) > MaxAvailabilityContent {
+		return nil, fmt.Errorf("attestation lists %d content items, more than the max of %d",
+			len(attestation.ContentHashes), MaxAvailabilityContent)
+	}
+
+	var last []byte
+	for _, contentHash := range attestation.ContentHashes {
+		if last != nil && bytes.Compare(contentHash, last) <= 0 {
+			return nil, errors.New("attested content hashes are not sorted and unique")
+		}
+		last = contentHash
+
+		// content is never unregistered, so content registered when the attestation was made still is
+		content, err := app.store.GetContent(ctx, app.store.DB, contentHash)
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, fmt.Errorf("content %x is not registered", contentHash)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if content.RegisteredHeight >= uint64(height) {
+			return nil, fmt.Errorf("content %x was registered at height %d, not before the votes at height %d",
+				contentHash, content.RegisteredHeight, height)
+		}
+	}
+	return attestation, nil
+}
+
+// buildAvailabilityTx gathers the attestations of the commit a proposal at height builds on. It
+// returns nil if the commit has none, as when vote extensions are not enabled yet.
+func (app *KVStoreApplication) buildAvailabilityTx(ctx context.Context, height int64, commit abcitypes.ExtendedCommitInfo) ([]byte, error) {
+	availability := &v1.AvailabilityTransaction{Height: height - 1, Round: commit.Round}
+	for _, vote := range commit.Votes {
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit || len(vote.ExtensionSignature) == 0 {
+			continue
+		}
+		signed := &v1.SignedAttestation{
+			ValidatorAddress:   vote.Validator.Address,
+			Extension:          vote.VoteExtension,
+			ExtensionSignature: vote.ExtensionSignature,
+		}
+
+		// CometBFT has verified every vote extension in the commit, but only against the state
+		// the voter's node had, so anything the rest of the network would reject is left out
+		if _, err := app.verifyAttestation(ctx, availability, signed); err != nil {
+			app.logger.Warnw("leaving out availability attestation", "height", availability.Height, "validator", fmt.Sprintf("%X", vote.Validator.Address), "err", err)
+			continue
+		}
+		availability.Attestations = append(availability.Attestations, signed)
+	}
+	if len(availability.Attestations) == 0 {
+		return nil, nil
+	}
+
+	return proto.Marshal(&v1.SignedTransaction{Availability: availability})
+}
+
+// decodeAvailabilityTx returns the availability transaction tx holds, or nil if it holds any other.
+func decodeAvailabilityTx(tx []byte) *v1.AvailabilityTransaction {
+	var signedTransaction v1.SignedTransaction
+	if err := proto.Unmarshal(tx, &signedTransaction); err != nil {
+		return nil
+	}
+	return signedTransaction.Availability
+}
+
+// checkAvailability checks an availability transaction in the block at height against the commit
+// of the previous height the block carries, and returns its attestations with the voting power of
+// the validators that made them.
+func (app *KVStoreApplication) checkAvailability(ctx context.Context, availability *v1.AvailabilityTransaction, height int64, lastCommit abcitypes.CommitInfo) ([]attestation, error) {
+	if len(availability.Attestations) == 0 {
+		return nil, errors.New("availability transaction has no attestations")
+	}
+	if availability.Height != height-1 || availability.Round != lastCommit.Round {
+		return nil, fmt.Errorf("attestations are for height %d round %d, not the last commit at height %d round %d",
+			availability.Height, availability.Round, height-1, lastCommit.Round)
+	}
+
+	powers := make(map[string]int64, len(lastCommit.Votes))
+	for _, vote := range lastCommit.Votes {
+		if vote.BlockIdFlag == cmtproto.BlockIDFlagCommit {
+			powers[string(vote.Validator.Address)] = vote.Validator.Power
+		}
+	}
+
+	attestations := make([]attestation, 0, len(availability.Attestations))
+	for _, signed := range availability.Attestations {
+		power, ok := powers[string(signed.ValidatorAddress)]
+		if !ok {
+			return nil, fmt.Errorf("validator %X did not commit the last block or attested twice", signed.ValidatorAddress)
+		}
+		delete(powers, string(signed.ValidatorAddress))
+
+		contentHashes, err := app.verifyAttestation(ctx, availability, signed)
+		if err != nil {
+			return nil, fmt.Errorf("validator %X: %w", signed.ValidatorAddress, err)
+		}
+		attestations = append(attestations, attestation{contentHashes: contentHashes, power: power})
+	}
+	return attestations, nil
+}
+
+// verifyAttestation checks the signature CometBFT made over a validator's vote extension and the
+// attestation it holds.
+func (app *KVStoreApplication) verifyAttestation(ctx context.Context, availability *v1.AvailabilityTransaction, signed *v1.SignedAttestation) ([][]byte, error) {
+	validator, err := app.store.GetValidator(ctx, app.store.DB, signed.ValidatorAddress)
+	if err != nil {
+		return nil, fmt.Errorf("get validator: %w", err)
+	}
+
+	signBytes := cmttypes.VoteExtensionSignBytes(app.chainID, &cmtproto.Vote{
+		Type:      cmtproto.PrecommitType,
+		Height:    availability.Height,
+		Round:     availability.Round,
+		Extension: signed.Extension,
+	})
+	if !ed25519.PubKey(validator.Pubkey).VerifySignature(signBytes, signed.ExtensionSignature) {
+		return nil, errors.New("invalid vote extension signature")
+	}
+
+	attestation, err := app.checkAttestation(ctx, availability.Height, signed.Extension)
+	if err != nil {
+		return nil, err
+	}
+	return attestation.ContentHashes, nil
+}
+
+// executeAvailability scores the content in the availability window of the last commit by the share
+// of its voting power that attested to it. It runs first in the block, so the committed content is
+// current.
+func (app *KVStoreApplication) executeAvailability(ctx context.Context, block *blockContext, availability *v1.AvailabilityTransaction) (*v1.TransactionResultBody, error) {
+	if block.txIndex != 0 {
+		return nil, newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
+			"availability transaction must be the first in the block")
+	}
+
+	attestations, err := app.checkAvailability(ctx, availability, block.height, block.lastCommit)
+	if err != nil {
+		return nil, &txError{code: v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST, err: err}
+	}
+
+	// CometBFT caps the total voting power well below the largest uint64
+	var totalPower uint64
+	for _, vote := range block.lastCommit.Votes {
+		totalPower += uint64(vote.Validator.Power)
+	}
+	attestedPower := make(map[string]uint64)
+	for _, attestation := range attestations {
+		for _, contentHash := range attestation.contentHashes {
+			attestedPower[string(contentHash)] += uint64(attestation.power)
+		}
+	}
+
+	// the scores are written like a transaction's writes, reaching the block only if all succeed
+	cache := block.cache.Child()
+	if err := app.eachAvailabilityContent(ctx, availability.Height, func(content *v1.ContentState) error {
+		// attested power never exceeds the total, so neither does the score exceed the basis points
+		bps, _ := mulDiv(attestedPower[string(content.ContentHash)], availabilityBasisPoints, totalPower, false)
+		if uint32(bps) == content.AvailabilityBps {
+			return nil
+		}
+		content.AvailabilityBps = uint32(bps)
+		content.AvailabilityHeight = uint64(availability.Height)
+		return app.store.SetContent(ctx, cache, content)
+	}); err != nil {
+		cache.Discard()
+		return nil, err
+	}
+	if err := cache.Write(block.cache); err != nil {
+		return nil, err
+	}
+
+	app.logger.Debugw("updated content availability", "height", availability.Height, "attestations", len(attestations))
+	return &v1.TransactionResultBody{
+		Body: &v1.TransactionResultBody_Availability{
+			Availability: &v1.AvailabilityResult{Attestations: uint32(len(attestations))},
+		},
+	}, nil
+}
@@ -0,0 +1,105 @@
+package app
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"unicode"
+
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/alecsavvy/mojave/store"
+	"github.com/cockroachdb/pebble"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Events are typed by the proto messages in gen/mojave/v1 whose names end in Event. Each becomes
+// an ABCI event named after the message, TransferEvent as "transfer", with an indexed attribute
+// for every field: bytes in hex, numbers in decimal, enums by name and strings as they are.
+// CometBFT indexes them, so transactions can be searched with queries like
+// "transfer.to='<hex pubkey>'". Transactions report their events in their ExecTxResult, and
+// everything the block does outside its transactions in the events of the FinalizeBlockResponse.
+
+// typedEvent converts an event message into an ABCI event.
+func typedEvent(msg proto.Message) abcitypes.Event {
+	m := msg.ProtoReflect()
+	fields := m.Descriptor().Fields()
+	event := abcitypes.Event{
+		Type:       eventType(string(m.Descriptor().Name())),
+		Attributes: make([]abcitypes.EventAttribute, 0, fields.Len()),
+	}
+	for i := range fields.Len() {
+		field := fields.Get(i)
+		event.Attributes = append(event.Attributes, abcitypes.EventAttribute{
+			Key:   string(field.Name()),
+			Value: eventValue(field, m.Get(field)),
+			Index: true,
+		})
+	}
+	return event
+}
+
+// eventType turns the name of an event message into snake case without the Event suffix.
+func eventType(name string) string {
+	var b strings.Builder
+	for i, r := range strings.TrimSuffix(name, "Event") {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func eventValue(field protoreflect.FieldDescriptor, value protoreflect.Value) string {
+	switch field.Kind() {
+	case protoreflect.BytesKind:
+		return hex.EncodeToString(value.Bytes())
+	case protoreflect.StringKind:
+		return value.String()
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind, protoreflect.Fixed32Kind, protoreflect.Fixed64Kind:
+		return strconv.FormatUint(value.Uint(), 10)
+	case protoreflect.Int32Kind, protoreflect.Int64Kind, protoreflect.Sint32Kind, protoreflect.Sint64Kind:
+		return strconv.FormatInt(value.Int(), 10)
+	case protoreflect.EnumKind:
+		if enum := field.Enum().Values().ByNumber(value.Enum()); enum != nil {
+			return string(enum.Name())
+		}
+		return strconv.Itoa(int(value.Enum()))
+	default:
+		return value.String()
+	}
+}
+
+// emit adds an event to the transaction being executed. Events of a step of the transaction
+// that fails are dropped with its writes.
+func (tc *txContext) emit(msg proto.Message) {
+	tc.events = append(tc.events, typedEvent(msg))
+}
+
+// emit adds an event of the block itself.
+func (block *blockContext) emit(msg proto.Message) {
+	block.events = append(block.events, typedEvent(msg))
+}
+
+// getOrCreateAccount returns the account from rw, creating an empty one if it does not exist yet
+// and emitting the account's creation as an event of the block.
+func (app *KVStoreApplication) getOrCreateAccount(ctx context.Context, block *blockContext, rw store.ReadWriter, pubkey []byte) (*v1.AccountState, error) {
+	account, err := app.store.GetAccount(ctx, rw, pubkey)
+	if !errors.Is(err, pebble.ErrNotFound) {
+		return account, err
+	}
+
+	account = &v1.AccountState{Pubkey: pubkey}
+	if err := app.store.UpdateAccount(ctx, rw, account); err != nil {
+		return nil, err
+	}
+	block.emit(&v1.AccountCreatedEvent{Pubkey: pubkey})
+	return account, nil
+}
@@ -0,0 +1,375 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	mcrypto "github.com/alecsavvy/mojave/crypto"
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/alecsavvy/mojave/store"
+	"github.com/alecsavvy/mojave/utils"
+	"github.com/cockroachdb/pebble"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// txError is a transaction failure with the result code it should be reported as.
+// Errors that are not a txError are reported as internal errors.
+type txError struct {
+	code v1.TransactionResultErrorCode
+	err  error
+}
+
+func (e *txError) Error() string {
+	return e.err.Error()
+}
+
+func (e *txError) Unwrap() error {
+	return e.err
+}
+
+func newTxError(code v1.TransactionResultErrorCode, format string, args ...any) error {
+	return &txError{code: code, err: fmt.Errorf(format, args...)}
+}
+
+// resultError converts an execution error into the error reported in the transaction result.
+func resultError(err error) *v1.TransactionResultError {
+	var txErr *txError
+	if errors.As(err, &txErr) {
+		return &v1.TransactionResultError{Code: txErr.code, Log: err.Error()}
+	}
+	var outOfWatts *OutOfWattsError
+	if errors.As(err, &outOfWatts) {
+		return &v1.TransactionResultError{
+			Code: v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_WATT_LIMIT,
+			Log:  err.Error(),
+		}
+	}
+	return &v1.TransactionResultError{
+		Code: v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INTERNAL,
+		Log:  err.Error(),
+	}
+}
+
+// blockContext is the state shared by all transactions of the block being finalized.
+type blockContext struct {
+	height int64
+	// lastCommit is the commit of the previous height the block carries
+	lastCommit abcitypes.CommitInfo
+	// txIndex is the position in the block of the transaction being executed
+	txIndex int
+	// cache holds every write of the block, layered over the committed state
+	cache *store.Cache
+	// params are the params committed before the block, which all of its transactions execute with
+	params    *v1.Params
+	wattsUsed uint64
+	// consensusParamUpdates is the change to the consensus params made in the block, if any
+	consensusParamUpdates *cmtproto.ConsensusParams
+	// fees collected from the block's transactions, paid to the proposer once all have executed
+	fees uint64
+	// events of the block outside its transactions
+	events []abcitypes.Event
+}
+
+// txContext is what a transaction handler executes against. All store access goes
+// through it so that every read and write is metered.
+type txContext struct {
+	ctx   context.Context
+	store *store.Store
+	block *blockContext
+	// cache is a child of the block's cache. It only reaches the block once the step of
+	// the transaction that wrote to it has succeeded.
+	cache       *store.Cache
+	meter       *WattMeter
+	transaction *v1.Transaction
+	// events emitted by the steps of the transaction that have succeeded so far
+	events []abcitypes.Event
+	// created holds the pubkeys of accounts getOrCreateAccount returned that are not written
+	// yet, so that their creation is emitted once they are
+	created map[string]bool
+}
+
+func (tc *txContext) chargeRead(size int) error {
+	return tc.meter.Consume(WattsRead+uint64(size)*WattsReadPerByte, "store read")
+}
+
+func (tc *txContext) chargeWrite(size int) error {
+	return tc.meter.Consume(WattsWrite+uint64(size)*WattsWritePerByte, "store write")
+}
+
+func (tc *txContext) getAccount(pubkey []byte) (*v1.AccountState, error) {
+	account, err := tc.store.GetAccount(tc.ctx, tc.cache, pubkey)
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			if err := tc.chargeRead(0); err != nil {
+				return nil, err
+			}
+		}
+		return nil, err
+	}
+	if err := tc.chargeRead(proto.Size(account)); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// getOrCreateAccount returns an empty account if none exists yet. The account is only
+// written once the caller updates it, so its creation is emitted by updateAccount.
+func (tc *txContext) getOrCreateAccount(pubkey []byte) (*v1.AccountState, error) {
+	account, err := tc.getAccount(pubkey)
+	if errors.Is(err, pebble.ErrNotFound) {
+		if tc.created == nil {
+			tc.created = map[string]bool{}
+		}
+		tc.created[string(pubkey)] = true
+		return &v1.AccountState{Pubkey: pubkey}, nil
+	}
+	return account, err
+}
+
+// updateAccount writes an account, emitting its creation when it is written for the first time.
+func (tc *txContext) updateAccount(account *v1.AccountState) error {
+	if err := tc.chargeWrite(proto.Size(account)); err != nil {
+		return err
+	}
+	if err := tc.store.UpdateAccount(tc.ctx, tc.cache, account); err != nil {
+		return err
+	}
+	if tc.created[string(account.Pubkey)] {
+		delete(tc.created, string(account.Pubkey))
+		tc.emit(&v1.AccountCreatedEvent{Pubkey: account.Pubkey})
+	}
+	return nil
+}
+
+func (tc *txContext) getContent(contentHash []byte) (*v1.ContentState, error) {
+	content, err := tc.store.GetContent(tc.ctx, tc.cache, contentHash)
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			if err := tc.chargeRead(0); err != nil {
+				return nil, err
+			}
+		}
+		return nil, err
+	}
+	if err := tc.chargeRead(proto.Size(content)); err != nil {
+		return nil, err
+	}
+	return content, nil
+}
+
+func (tc *txContext) setContent(content *v1.ContentState) error {
+	if err := tc.chargeWrite(proto.Size(content)); err != nil {
+		return err
+	}
+	return tc.store.SetContent(tc.ctx, tc.cache, content)
+}
+
+func (tc *txContext) setKeyValue(kv *v1.KeyValueState) error {
+	if err := tc.chargeWrite(proto.Size(kv)); err != nil {
+		return err
+	}
+	return tc.store.SetKeyValue(tc.ctx, tc.cache, kv)
+}
+
+// executeTx runs a single transaction of the block being finalized and returns its result with
+// the events it emitted. Failures never abort the block, they are reported through the error of
+// the returned result.
+func (app *KVStoreApplication) executeTx(ctx context.Context, block *blockContext, tx []byte) (*v1.TransactionResult, []abcitypes.Event) {
+	result := &v1.TransactionResult{
+		Header: &v1.TransactionResultHeader{
+			TxHash:      utils.Hash(tx),
+			BlockHeight: uint64(block.height),
+		},
+	}
+
+	var meter *WattMeter
+	var tc *txContext
+	body, err := func() (*v1.TransactionResultBody, error) {
+		signedTransaction, err := mcrypto.DecodeSignedTransaction(tx)
+		if err != nil {
+			return nil, &txError{code: v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST, err: err}
+		}
+		if signedTransaction.Availability != nil {
+			return app.executeAvailability(ctx, block, signedTransaction.Availability)
+		}
+
+		transaction, err := mcrypto.VerifyTransaction(signedTransaction)
+		if err != nil {
+			return nil, &txError{code: v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_SIGNATURE, err: err}
+		}
+		result.Header.ChainId = transaction.Header.ChainId
+		result.Header.Nonce = transaction.Header.Nonce
+
+		if err := checkChainID(app.chainID, transaction.Header.ChainId); err != nil {
+			return nil, &txError{code: v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_CHAIN_ID, err: err}
+		}
+
+		if err := checkWattLimit(transaction.Header.WattLimit, block.params.MaxBlockWatts, block.params.MaxBlockWatts-block.wattsUsed); err != nil {
+			return nil, &txError{code: v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_WATT_LIMIT, err: err}
+		}
+		meter = NewWattMeter(transaction.Header.WattLimit)
+
+		tc = &txContext{
+			ctx:         ctx,
+			store:       app.store,
+			block:       block,
+			cache:       block.cache.Child(),
+			meter:       meter,
+			transaction: transaction,
+		}
+		return app.runTx(tc)
+	}()
+
+	if meter != nil {
+		result.Header.WattsUsed = meter.Used()
+		block.wattsUsed += meter.Used()
+	}
+	var events []abcitypes.Event
+	if tc != nil {
+		events = tc.events
+	}
+	if err != nil {
+		result.Error = resultError(err)
+		return result, events
+	}
+	result.Body = body
+	return result, events
+}
+
+// runTx executes a verified transaction in two steps, each in its own child of the block's cache.
+// The first charges for the signature check, bumps the signer's nonce and takes the fee; it is
+// kept even if the body then fails so that a replayed transaction is rejected and a failing one
+// still pays for its block space. The second authorizes the body against the signer and runs
+// its handler; it is discarded as a whole if the handler fails partway through.
+func (app *KVStoreApplication) runTx(tc *txContext) (*v1.TransactionResultBody, error) {
+	if err := app.chargeTx(tc); err != nil {
+		tc.cache.Discard()
+		tc.events = nil
+		tc.created = nil
+		return nil, err
+	}
+	if err := tc.cache.Write(tc.block.cache); err != nil {
+		return nil, err
+	}
+
+	events := len(tc.events)
+	body, err := app.dispatchTx(tc)
+	if err != nil {
+		tc.cache.Discard()
+		tc.events = tc.events[:events]
+		tc.created = nil
+		return nil, err
+	}
+	if err := tc.cache.Write(tc.block.cache); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+func (app *KVStoreApplication) chargeTx(tc *txContext) error {
+	if err := tc.meter.Consume(WattsSignatureCheck, "signature check"); err != nil {
+		return err
+	}
+
+	header := tc.transaction.Header
+	signer, err := tc.getOrCreateAccount(header.FromPubkey)
+	if err != nil {
+		return err
+	}
+	if err := checkNonce(signer.Nonce, header.Nonce); err != nil {
+		return &txError{code: v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_NONCE, err: err}
+	}
+	if err := checkMinFee(tc.block.params, header.Fee); err != nil {
+		return err
+	}
+	if err := checkFee(signer.Balance, header.Fee); err != nil {
+		return err
+	}
+	signer.Nonce = header.Nonce
+	signer.Balance -= header.Fee
+	if err := tc.updateAccount(signer); err != nil {
+		return err
+	}
+	tc.block.fees += header.Fee
+	return nil
+}
+
+// checkBody rejects transaction bodies that could never execute, whatever the state.
+func checkBody(params *v1.Params, transaction *v1.Transaction) error {
+	switch body := transaction.Body.GetBody().(type) {
+	case *v1.TransactionBody_KeyValue:
+		return checkKeyValue(params, body.KeyValue)
+	case *v1.TransactionBody_TokenTransfer:
+		return nil
+	case *v1.TransactionBody_ContentRegistration:
+		return checkContentHash(body.ContentRegistration.ContentHash)
+	case *v1.TransactionBody_AddValidator:
+		return checkValidatorKey(body.AddValidator.Pubkey)
+	case *v1.TransactionBody_RemoveValidator:
+		return checkValidatorKey(body.RemoveValidator.Pubkey)
+	case *v1.TransactionBody_Bond:
+		return checkStakeAmount(body.Bond.Amount)
+	case *v1.TransactionBody_Delegate:
+		if err := checkValidatorKey(body.Delegate.ValidatorPubkey); err != nil {
+			return err
+		}
+		return checkStakeAmount(body.Delegate.Amount)
+	case *v1.TransactionBody_Unbond:
+		if err := checkValidatorKey(body.Unbond.ValidatorPubkey); err != nil {
+			return err
+		}
+		return checkStakeAmount(body.Unbond.Amount)
+	case *v1.TransactionBody_Unjail:
+		return nil
+	case *v1.TransactionBody_UpdateParams:
+		return nil
+	case *v1.TransactionBody_SubmitProposal:
+		return checkSubmitProposal(body.SubmitProposal)
+	case *v1.TransactionBody_Deposit:
+		return checkStakeAmount(body.Deposit.Amount)
+	case *v1.TransactionBody_Vote:
+		return checkVoteOption(body.Vote.Option)
+	default:
+		return newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST, "unknown transaction body type: %T", body)
+	}
+}
+
+func (app *KVStoreApplication) dispatchTx(tc *txContext) (*v1.TransactionResultBody, error) {
+	if err := authorize(tc.transaction); err != nil {
+		return nil, err
+	}
+
+	switch body := tc.transaction.Body.GetBody().(type) {
+	case *v1.TransactionBody_KeyValue:
+		return app.handleKeyValue(tc, body.KeyValue)
+	case *v1.TransactionBody_TokenTransfer:
+		return app.handleTokenTransfer(tc, body.TokenTransfer)
+	case *v1.TransactionBody_ContentRegistration:
+		return app.handleContentRegistration(tc, body.ContentRegistration)
+	case *v1.TransactionBody_AddValidator:
+		return app.handleAddValidator(tc, body.AddValidator)
+	case *v1.TransactionBody_RemoveValidator:
+		return app.handleRemoveValidator(tc, body.RemoveValidator)
+	case *v1.TransactionBody_Bond:
+		return app.handleBond(tc, body.Bond)
+	case *v1.TransactionBody_Delegate:
+		return app.handleDelegate(tc, body.Delegate)
+	case *v1.TransactionBody_Unbond:
+		return app.handleUnbond(tc, body.Unbond)
+	case *v1.TransactionBody_Unjail:
+		return app.handleUnjail(tc, body.Unjail)
+	case *v1.TransactionBody_UpdateParams:
+		return app.handleUpdateParams(tc, body.UpdateParams)
+	case *v1.TransactionBody_SubmitProposal:
+		return app.handleSubmitProposal(tc, body.SubmitProposal)
+	case *v1.TransactionBody_Deposit:
+		return app.handleDeposit(tc, body.Deposit)
+	case *v1.TransactionBody_Vote:
+		return app.handleVote(tc, body.Vote)
+	default:
+		return nil, newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST, "unknown transaction body type: %T", body)
+	}
+}
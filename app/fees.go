@@ -0,0 +1,66 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/cockroachdb/pebble"
+)
+
+// checkMinFee rejects transactions that pay less than the min fee.
+func checkMinFee(params *v1.Params, fee uint64) error {
+	if fee < params.MinFee {
+		return newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INSUFFICIENT_FEE,
+			"fee %d is below min fee %d", fee, params.MinFee)
+	}
+	return nil
+}
+
+// checkFee rejects transactions whose signer cannot cover the fee from its balance.
+func checkFee(balance uint64, fee uint64) error {
+	if balance < fee {
+		return newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INSUFFICIENT_FUNDS,
+			"insufficient funds for fee: balance %d, fee %d", balance, fee)
+	}
+	return nil
+}
+
+// payFees credits the fees collected during the block to the account of the block's proposer.
+func (app *KVStoreApplication) payFees(ctx context.Context, block *blockContext, proposerAddress []byte) error {
+	if block.fees == 0 {
+		return nil
+	}
+
+	return app.creditValidator(ctx, block, proposerAddress, block.fees)
+}
+
+// creditValidator pays amount to the account owned by a validator's consensus key. A validator
+// CometBFT still reports but the state has no record of, such as one removed in the same block,
+// has no account to pay, so its amount goes to the community pool rather than halting the chain.
+func (app *KVStoreApplication) creditValidator(ctx context.Context, block *blockContext, address []byte, amount uint64) error {
+	if amount == 0 {
+		return nil
+	}
+
+	validator, err := app.store.GetValidator(ctx, block.cache, address)
+	if errors.Is(err, pebble.ErrNotFound) {
+		app.logger.Warnw("paying unknown validator's share to the community pool", "validator", fmt.Sprintf("%X", address), "amount", amount)
+		return app.fundCommunityPool(ctx, block.cache, amount)
+	}
+	if err != nil {
+		return fmt.Errorf("get validator %X: %w", address, err)
+	}
+
+	account, err := app.getOrCreateAccount(ctx, block, block.cache, validator.Pubkey)
+	if err != nil {
+		return err
+	}
+	if account.Balance > math.MaxUint64-amount {
+		return fmt.Errorf("crediting %d overflows balance %d of validator %X", amount, account.Balance, address)
+	}
+	account.Balance += amount
+	return app.store.UpdateAccount(ctx, block.cache, account)
+}
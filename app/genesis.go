@@ -0,0 +1,285 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/alecsavvy/mojave/config"
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/alecsavvy/mojave/store"
+	"github.com/cockroachdb/pebble"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+)
+
+// parseGenesisState decodes and validates the app_state of the genesis file. A genesis without
+// one starts from the zero state with the default params.
+func parseGenesisState(appState []byte) (*v1.GenesisState, error) {
+	state, err := config.UnmarshalAppState(appState)
+	if err != nil {
+		return nil, err
+	}
+
+	if admin := state.ValidatorAdminPubkey; len(admin) != 0 && len(admin) != ed25519.PubKeySize {
+		return nil, fmt.Errorf("invalid validator admin pubkey length %d", len(admin))
+	}
+
+	if state.StakingParams == nil {
+		state.StakingParams = config.DefaultStakingParams()
+	}
+	if err := checkStakingParams(state.StakingParams); err != nil {
+		return nil, err
+	}
+
+	if state.SlashingParams == nil {
+		state.SlashingParams = config.DefaultSlashingParams()
+	}
+	if err := checkSlashingParams(state.SlashingParams); err != nil {
+		return nil, err
+	}
+
+	if state.MintParams == nil {
+		state.MintParams = config.DefaultMintParams()
+	}
+	if err := checkMintParams(state.MintParams); err != nil {
+		return nil, err
+	}
+
+	if state.GovParams == nil {
+		state.GovParams = config.DefaultGovParams()
+	}
+	if err := checkGovParams(state.GovParams); err != nil {
+		return nil, err
+	}
+
+	if state.Params == nil {
+		state.Params = config.DefaultParams()
+	}
+	if err := checkParams(state.Params); err != nil {
+		return nil, err
+	}
+	if admin := state.ParamsAdminPubkey; len(admin) != 0 && len(admin) != ed25519.PubKeySize {
+		return nil, fmt.Errorf("invalid params admin pubkey length %d", len(admin))
+	}
+
+	for _, account := range state.Accounts {
+		if len(account.Pubkey) != ed25519.PubKeySize {
+			return nil, fmt.Errorf("invalid genesis account pubkey length %d", len(account.Pubkey))
+		}
+	}
+	keys := make(map[string]bool, len(state.KeyValues))
+	for _, kv := range state.KeyValues {
+		if err := checkKeyValue(state.Params, &v1.KeyValueTransaction{Key: kv.Key, Value: kv.Value}); err != nil {
+			return nil, fmt.Errorf("genesis key value %q: %w", kv.Key, err)
+		}
+		if keys[kv.Key] {
+			return nil, fmt.Errorf("duplicate genesis key value %q", kv.Key)
+		}
+		keys[kv.Key] = true
+	}
+	for _, validator := range state.Validators {
+		if len(validator.Pubkey) != ed25519.PubKeySize {
+			return nil, fmt.Errorf("invalid genesis validator pubkey length %d", len(validator.Pubkey))
+		}
+		if validator.Bonded < state.StakingParams.TokensPerPower {
+			return nil, fmt.Errorf("genesis validator %x bonds %d, less than the %d tokens of one unit of power",
+				validator.Pubkey, validator.Bonded, state.StakingParams.TokensPerPower)
+		}
+	}
+	return state, nil
+}
+
+// checkStakingParams rejects staking params that could not be applied.
+func checkStakingParams(params *v1.StakingParams) error {
+	if params.TokensPerPower == 0 {
+		return errors.New("tokens per power must be positive")
+	}
+	if params.UnbondingPeriod == 0 {
+		return errors.New("unbonding period must be positive")
+	}
+	return nil
+}
+
+// checkEvidenceAge rejects evidence that may be submitted after the tokens unbonding from the
+// offender at the infraction have left the unbonding queue, where they can no longer be slashed.
+func checkEvidenceAge(staking *v1.StakingParams, consensus *cmtproto.ConsensusParams) error {
+	if maxAge := consensus.GetEvidence().GetMaxAgeNumBlocks(); maxAge > 0 && uint64(maxAge) > staking.UnbondingPeriod {
+		return fmt.Errorf("evidence max age of %d blocks exceeds the unbonding period of %d blocks", maxAge, staking.UnbondingPeriod)
+	}
+	return nil
+}
+
+// checkMintParams rejects mint params that could not be applied.
+func checkMintParams(params *v1.MintParams) error {
+	if params.ReductionBps > basisPoints || params.ProposerBonusBps > basisPoints || params.CommunityPoolBps > basisPoints {
+		return fmt.Errorf("mint params basis points exceed %d", basisPoints)
+	}
+	if params.ReductionInterval != 0 && params.ReductionInterval < minReductionInterval {
+		return fmt.Errorf("reduction interval must be 0 or at least %d blocks", minReductionInterval)
+	}
+	return nil
+}
+
+// checkGovParams rejects governance params that could not be applied.
+func checkGovParams(params *v1.GovParams) error {
+	if params.DepositPeriod == 0 {
+		return errors.New("deposit period must be positive")
+	}
+	if params.VotingPeriod == 0 {
+		return errors.New("voting period must be positive")
+	}
+	if params.QuorumBps > basisPoints || params.ThresholdBps > basisPoints {
+		return fmt.Errorf("governance params basis points exceed %d", basisPoints)
+	}
+	return nil
+}
+
+// checkSlashingParams rejects slashing params that could not be applied.
+func checkSlashingParams(params *v1.SlashingParams) error {
+	if params.SignedBlocksWindow == 0 {
+		return errors.New("signed blocks window must be positive")
+	}
+	for _, param := range []struct {
+		name string
+		bps  uint32
+	}{
+		{"min signed", params.MinSignedBps},
+		{"downtime slash", params.DowntimeSlashBps},
+		{"double sign slash", params.DoubleSignSlashBps},
+	} {
+		if param.bps > basisPoints {
+			return fmt.Errorf("%s of %d basis points exceeds %d", param.name, param.bps, basisPoints)
+		}
+	}
+	return nil
+}
+
+// initGenesis writes the genesis state to cache. The genesis validators bond the tokens they are
+// given in app_state or, without any there, the tokens the power CometBFT gives them requires.
+func (app *KVStoreApplication) initGenesis(ctx context.Context, cache *store.Cache, genesis *v1.GenesisState, validators []abcitypes.ValidatorUpdate) error {
+	if err := app.store.SetParams(ctx, cache, genesis.Params); err != nil {
+		return err
+	}
+	if len(genesis.ParamsAdminPubkey) != 0 {
+		if err := app.store.SetParamsAdmin(ctx, cache, genesis.ParamsAdminPubkey); err != nil {
+			return err
+		}
+	}
+
+	params := genesis.StakingParams
+	if err := app.store.SetStakingParams(ctx, cache, params); err != nil {
+		return err
+	}
+	if err := app.store.SetSlashingParams(ctx, cache, genesis.SlashingParams); err != nil {
+		return err
+	}
+	if err := app.store.SetMintParams(ctx, cache, genesis.MintParams); err != nil {
+		return err
+	}
+	if err := app.store.SetMintState(ctx, cache, &v1.MintState{BlockReward: genesis.MintParams.BlockReward}); err != nil {
+		return err
+	}
+	if err := app.store.SetGovParams(ctx, cache, genesis.GovParams); err != nil {
+		return err
+	}
+
+	for _, kv := range genesis.KeyValues {
+		if err := app.store.SetKeyValue(ctx, cache, kv); err != nil {
+			return err
+		}
+	}
+
+	// the initial supply is every genesis balance and every token bonded at genesis
+	supply := &v1.Supply{}
+	addSupply := func(amount uint64) error {
+		if supply.Total > math.MaxUint64-amount {
+			return errors.New("genesis supply overflows")
+		}
+		supply.Total += amount
+		return nil
+	}
+	for _, genesisAccount := range genesis.Accounts {
+		_, err := app.store.GetAccount(ctx, cache, genesisAccount.Pubkey)
+		if err == nil {
+			return fmt.Errorf("duplicate genesis account %x", genesisAccount.Pubkey)
+		}
+		if !errors.Is(err, pebble.ErrNotFound) {
+			return err
+		}
+		if err := addSupply(genesisAccount.Balance); err != nil {
+			return err
+		}
+		account := &v1.AccountState{Pubkey: genesisAccount.Pubkey, Balance: genesisAccount.Balance}
+		if err := app.store.UpdateAccount(ctx, cache, account); err != nil {
+			return err
+		}
+	}
+	if len(genesis.ValidatorAdminPubkey) > 0 {
+		if err := app.store.SetValidatorAdmin(ctx, cache, genesis.ValidatorAdminPubkey); err != nil {
+			return err
+		}
+	}
+
+	bonds := genesis.Validators
+	if len(bonds) == 0 {
+		for _, update := range validators {
+			validator, err := validatorState(update)
+			if err != nil {
+				return err
+			}
+			if validator.Power <= 0 || uint64(validator.Power) > math.MaxUint64/params.TokensPerPower {
+				return fmt.Errorf("invalid genesis validator power %d", validator.Power)
+			}
+			bonds = append(bonds, &v1.GenesisValidator{Pubkey: validator.Pubkey, Bonded: uint64(validator.Power) * params.TokensPerPower})
+		}
+	}
+
+	for _, bond := range bonds {
+		if err := addSupply(bond.Bonded); err != nil {
+			return err
+		}
+		validator := &v1.ValidatorState{Address: ed25519.PubKey(bond.Pubkey).Address(), Pubkey: bond.Pubkey}
+		if _, err := app.store.GetValidator(ctx, cache, validator.Address); err == nil {
+			return fmt.Errorf("duplicate genesis validator %X", validator.Address)
+		}
+		shares, err := delegate(validator, bond.Bonded)
+		if err != nil {
+			return err
+		}
+		validator.Power = validatorPower(params, validator)
+		if err := app.store.SetValidator(ctx, cache, validator); err != nil {
+			return err
+		}
+
+		delegations, err := app.store.GetDelegations(ctx, cache, bond.Pubkey)
+		if err != nil {
+			return err
+		}
+		addShares(delegations, bond.Pubkey, shares)
+		if err := app.store.SetDelegations(ctx, cache, delegations); err != nil {
+			return err
+		}
+	}
+	return app.store.SetSupply(ctx, cache, supply)
+}
+
+// genesisValidators is the validator set InitChain reports to CometBFT, which replaces the
+// validators of the genesis file with it.
+func (app *KVStoreApplication) genesisValidators(ctx context.Context) ([]abcitypes.ValidatorUpdate, error) {
+	set, err := app.store.GetValidatorSet(ctx, app.store.DB)
+	if err != nil {
+		return nil, err
+	}
+	if len(set.Validators) == 0 {
+		return nil, errors.New("genesis has no validators with power")
+	}
+
+	updates := make([]abcitypes.ValidatorUpdate, len(set.Validators))
+	for i, validator := range set.Validators {
+		updates[i] = abcitypes.ValidatorUpdate{PubKeyType: ed25519.KeyType, PubKeyBytes: validator.Pubkey, Power: validator.Power}
+	}
+	return updates, nil
+}
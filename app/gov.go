@@ -0,0 +1,520 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/alecsavvy/mojave/store"
+	"github.com/cockroachdb/pebble"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"google.golang.org/protobuf/proto"
+)
+
+// Any account can submit a proposal to change the params, spend from the community pool or
+// schedule an upgrade. A proposal first collects deposits; once they reach min_deposit it is voted
+// on for the voting period, and a proposal that does not reach it within the deposit period
+// expires. When voting ends the votes are weighted by the tokens each voter has bonded to active
+// validators at that point. A proposal passes when the votes reach quorum_bps of the tokens bonded
+// to active validators and more than threshold_bps of the yes and no votes are yes, and its content
+// is then executed within the same block. Deposits are refunded when the votes reach quorum and
+// otherwise, like the deposits of expired proposals, go to the community pool.
+
+// checkProposalContent rejects proposal content that could never execute, whatever the state.
+func checkProposalContent(content *v1.ProposalContent) error {
+	switch content := content.GetContent().(type) {
+	case *v1.ProposalContent_UpdateParams:
+		return nil
+	case *v1.ProposalContent_CommunityPoolSpend:
+		if len(content.CommunityPoolSpend.RecipientPubkey) != ed25519.PubKeySize {
+			return newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
+				"invalid recipient pubkey length %d", len(content.CommunityPoolSpend.RecipientPubkey))
+		}
+		return checkStakeAmount(content.CommunityPoolSpend.Amount)
+	case *v1.ProposalContent_Upgrade:
+		if content.Upgrade.Name == "" {
+			return newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
+				"upgrade name must not be empty")
+		}
+		if content.Upgrade.Height == 0 {
+			return newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
+				"upgrade height must be positive")
+		}
+		return nil
+	default:
+		return newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
+			"unknown proposal content type: %T", content)
+	}
+}
+
+// checkSubmitProposal rejects proposals that could never execute, whatever the state.
+func checkSubmitProposal(submit *v1.SubmitProposalTransaction) error {
+	if submit.Title == "" {
+		return newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
+			"proposal title must not be empty")
+	}
+	return checkProposalContent(submit.Content)
+}
+
+// checkVoteOption rejects votes for options that do not exist.
+func checkVoteOption(option v1.VoteOption) error {
+	switch option {
+	case v1.VoteOption_VOTE_OPTION_YES, v1.VoteOption_VOTE_OPTION_NO, v1.VoteOption_VOTE_OPTION_ABSTAIN:
+		return nil
+	}
+	return newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
+		"invalid vote option %s", option)
+}
+
+func (tc *txContext) getGovParams() (*v1.GovParams, error) {
+	params, err := tc.store.GetGovParams(tc.ctx, tc.cache)
+	if err != nil {
+		return nil, err
+	}
+	if err := tc.chargeRead(proto.Size(params)); err != nil {
+		return nil, err
+	}
+	return params, nil
+}
+
+// getProposal returns a proposal, reporting one that was never submitted as an invalid request.
+func (tc *txContext) getProposal(id uint64) (*v1.Proposal, error) {
+	proposal, err := tc.store.GetProposal(tc.ctx, tc.cache, id)
+	if errors.Is(err, pebble.ErrNotFound) {
+		if err := tc.chargeRead(0); err != nil {
+			return nil, err
+		}
+		return nil, newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
+			"proposal %d does not exist", id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := tc.chargeRead(proto.Size(proposal)); err != nil {
+		return nil, err
+	}
+	return proposal, nil
+}
+
+func (tc *txContext) setProposal(proposal *v1.Proposal) error {
+	if err := tc.chargeWrite(proto.Size(proposal)); err != nil {
+		return err
+	}
+	return tc.store.SetProposal(tc.ctx, tc.cache, proposal)
+}
+
+// queueProposal records a proposal under the height its deposit or voting period ends at.
+func (tc *txContext) queueProposal(height uint64, id uint64) error {
+	queue, err := tc.store.GetProposalQueue(tc.ctx, tc.cache, height)
+	if err != nil {
+		return err
+	}
+	if err := tc.chargeRead(proto.Size(queue)); err != nil {
+		return err
+	}
+	if slices.Contains(queue.ProposalIds, id) {
+		return nil
+	}
+	queue.ProposalIds = append(queue.ProposalIds, id)
+	if err := tc.chargeWrite(proto.Size(queue)); err != nil {
+		return err
+	}
+	return tc.store.SetProposalQueue(tc.ctx, tc.cache, height, queue)
+}
+
+// deposit moves amount from the signer's balance into the deposit of a proposal in its deposit
+// period, and starts the voting period once the deposit reaches the min deposit.
+func (tc *txContext) deposit(proposal *v1.Proposal, params *v1.GovParams, amount uint64) error {
+	depositor := tc.transaction.Header.FromPubkey
+	if amount > 0 {
+		account, err := tc.getOrCreateAccount(depositor)
+		if err != nil {
+			return err
+		}
+		if account.Balance < amount {
+			return newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INSUFFICIENT_FUNDS,
+				"insufficient funds: balance %d, deposit %d", account.Balance, amount)
+		}
+		account.Balance -= amount
+		if err := tc.updateAccount(account); err != nil {
+			return err
+		}
+
+		record, err := tc.store.GetProposalDeposit(tc.ctx, tc.cache, proposal.Id, depositor)
+		if err != nil {
+			return err
+		}
+		if err := tc.chargeRead(proto.Size(record)); err != nil {
+			return err
+		}
+		record.Amount += amount
+		if err := tc.chargeWrite(proto.Size(record)); err != nil {
+			return err
+		}
+		if err := tc.store.SetProposalDeposit(tc.ctx, tc.cache, record); err != nil {
+			return err
+		}
+		// deposits never exceed the supply, so neither does their total
+		proposal.TotalDeposit += amount
+	}
+
+	if proposal.TotalDeposit >= params.MinDeposit {
+		proposal.Status = v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD
+		proposal.VotingEndHeight = uint64(tc.block.height) + params.VotingPeriod
+		if err := tc.queueProposal(proposal.VotingEndHeight, proposal.Id); err != nil {
+			return err
+		}
+	}
+	return tc.setProposal(proposal)
+}
+
+func (app *KVStoreApplication) handleSubmitProposal(tc *txContext, submit *v1.SubmitProposalTransaction) (*v1.TransactionResultBody, error) {
+	if err := tc.meter.Consume(WattsGovTx, "submit proposal transaction"); err != nil {
+		return nil, err
+	}
+	if err := checkSubmitProposal(submit); err != nil {
+		return nil, err
+	}
+
+	params, err := tc.getGovParams()
+	if err != nil {
+		return nil, err
+	}
+	id, err := tc.store.NextProposalID(tc.ctx, tc.cache)
+	if err != nil {
+		return nil, err
+	}
+	if err := tc.chargeWrite(8); err != nil {
+		return nil, err
+	}
+
+	height := uint64(tc.block.height)
+	proposal := &v1.Proposal{
+		Id:               id,
+		ProposerPubkey:   tc.transaction.Header.FromPubkey,
+		Title:            submit.Title,
+		Content:          submit.Content,
+		Status:           v1.ProposalStatus_PROPOSAL_STATUS_DEPOSIT_PERIOD,
+		SubmitHeight:     height,
+		DepositEndHeight: height + params.DepositPeriod,
+	}
+	if err := tc.queueProposal(proposal.DepositEndHeight, id); err != nil {
+		return nil, err
+	}
+	if err := tc.deposit(proposal, params, submit.InitialDeposit); err != nil {
+		return nil, err
+	}
+
+	return &v1.TransactionResultBody{
+		Body: &v1.TransactionResultBody_SubmitProposal{
+			SubmitProposal: &v1.SubmitProposalResult{ProposalId: id},
+		},
+	}, nil
+}
+
+func (app *KVStoreApplication) handleDeposit(tc *txContext, deposit *v1.DepositTransaction) (*v1.TransactionResultBody, error) {
+	if err := tc.meter.Consume(WattsGovTx, "deposit transaction"); err != nil {
+		return nil, err
+	}
+	if err := checkStakeAmount(deposit.Amount); err != nil {
+		return nil, err
+	}
+
+	proposal, err := tc.getProposal(deposit.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	if proposal.Status != v1.ProposalStatus_PROPOSAL_STATUS_DEPOSIT_PERIOD {
+		return nil, newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
+			"proposal %d is not in its deposit period", proposal.Id)
+	}
+	params, err := tc.getGovParams()
+	if err != nil {
+		return nil, err
+	}
+	if err := tc.deposit(proposal, params, deposit.Amount); err != nil {
+		return nil, err
+	}
+
+	return &v1.TransactionResultBody{
+		Body: &v1.TransactionResultBody_Deposit{
+			Deposit: &v1.DepositResult{},
+		},
+	}, nil
+}
+
+// checkVoter rejects voters with no tokens bonded to an active validator, whose votes would
+// carry no weight.
+func (tc *txContext) checkVoter(voter []byte) error {
+	delegations, err := tc.getDelegations(voter)
+	if err != nil {
+		return err
+	}
+	for _, delegation := range delegations.Delegations {
+		validator, err := tc.getValidator(delegation.ValidatorPubkey)
+		if errors.Is(err, pebble.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if validator.Power > 0 && tokensForShares(validator, delegation.Shares) > 0 {
+			return nil
+		}
+	}
+	return newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
+		"voter has no tokens bonded to an active validator")
+}
+
+// handleVote records the signer's vote, replacing any it cast on the proposal before. Its weight
+// is only known once voting ends.
+func (app *KVStoreApplication) handleVote(tc *txContext, vote *v1.VoteTransaction) (*v1.TransactionResultBody, error) {
+	if err := tc.meter.Consume(WattsGovTx, "vote transaction"); err != nil {
+		return nil, err
+	}
+	if err := checkVoteOption(vote.Option); err != nil {
+		return nil, err
+	}
+
+	proposal, err := tc.getProposal(vote.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	if proposal.Status != v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD {
+		return nil, newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
+			"proposal %d is not in its voting period", proposal.Id)
+	}
+
+	voter := tc.transaction.Header.FromPubkey
+	if err := tc.checkVoter(voter); err != nil {
+		return nil, err
+	}
+	record := &v1.Vote{ProposalId: proposal.Id, VoterPubkey: voter, Option: vote.Option}
+	if err := tc.chargeWrite(proto.Size(record)); err != nil {
+		return nil, err
+	}
+	if err := tc.store.SetProposalVote(tc.ctx, tc.cache, record); err != nil {
+		return nil, err
+	}
+
+	return &v1.TransactionResultBody{
+		Body: &v1.TransactionResultBody_Vote{
+			Vote: &v1.VoteResult{},
+		},
+	}, nil
+}
+
+// endProposals ends the deposit and voting periods that end with the block being finalized,
+// after all of its transactions.
+func (app *KVStoreApplication) endProposals(ctx context.Context, block *blockContext) error {
+	height := uint64(block.height)
+	queue, err := app.store.GetProposalQueue(ctx, block.cache, height)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range queue.ProposalIds {
+		proposal, err := app.store.GetProposal(ctx, block.cache, id)
+		if err != nil {
+			return fmt.Errorf("get proposal %d: %w", id, err)
+		}
+		// a proposal is queued for the end of its deposit period and again for the end of its
+		// voting period, and is only due for the one it is in
+		switch {
+		case proposal.Status == v1.ProposalStatus_PROPOSAL_STATUS_DEPOSIT_PERIOD && proposal.DepositEndHeight == height:
+			if err := app.forfeitDeposits(ctx, block, proposal); err != nil {
+				return err
+			}
+			proposal.Status = v1.ProposalStatus_PROPOSAL_STATUS_EXPIRED
+		case proposal.Status == v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD && proposal.VotingEndHeight == height:
+			if err := app.tallyProposal(ctx, block, proposal); err != nil {
+				return err
+			}
+		default:
+			continue
+		}
+		app.logger.Infow("proposal ended", "id", id, "status", proposal.Status.String(), "failure", proposal.Failure)
+		if err := app.store.SetProposal(ctx, block.cache, proposal); err != nil {
+			return err
+		}
+		block.emit(&v1.ProposalEndedEvent{ProposalId: id, Status: proposal.Status})
+	}
+
+	if len(queue.ProposalIds) == 0 {
+		return nil
+	}
+	return app.store.SetProposalQueue(ctx, block.cache, height, &v1.ProposalQueue{})
+}
+
+// tallyProposal counts the votes on a proposal whose voting period has ended, settles its
+// deposits and executes it if it passed.
+func (app *KVStoreApplication) tallyProposal(ctx context.Context, block *blockContext, proposal *v1.Proposal) error {
+	params, err := app.store.GetGovParams(ctx, block.cache)
+	if err != nil {
+		return err
+	}
+	tally, err := app.tally(ctx, block.cache, proposal.Id)
+	if err != nil {
+		return err
+	}
+	proposal.Tally = tally
+
+	if compareBps(tally.Yes+tally.No+tally.Abstain, tally.TotalBonded, params.QuorumBps) < 0 {
+		proposal.Status = v1.ProposalStatus_PROPOSAL_STATUS_REJECTED
+		return app.forfeitDeposits(ctx, block, proposal)
+	}
+	if err := app.refundDeposits(ctx, block, proposal); err != nil {
+		return err
+	}
+	if compareBps(tally.Yes, tally.Yes+tally.No, params.ThresholdBps) <= 0 {
+		proposal.Status = v1.ProposalStatus_PROPOSAL_STATUS_REJECTED
+		return nil
+	}
+
+	// the content runs in its own cache so that content failing partway through leaves no changes
+	// and emits no events
+	cache := block.cache.Child()
+	events := len(block.events)
+	if err := app.executeProposal(ctx, block, cache, proposal.Content); err != nil {
+		cache.Discard()
+		block.events = block.events[:events]
+		var txErr *txError
+		if !errors.As(err, &txErr) {
+			return err
+		}
+		proposal.Status = v1.ProposalStatus_PROPOSAL_STATUS_FAILED
+		proposal.Failure = err.Error()
+		return nil
+	}
+	if err := cache.Write(block.cache); err != nil {
+		return err
+	}
+	proposal.Status = v1.ProposalStatus_PROPOSAL_STATUS_PASSED
+	return nil
+}
+
+// compareBps compares part to bps basis points of whole, returning -1, 0 or +1 like bytes.Compare.
+func compareBps(part, whole uint64, bps uint32) int {
+	lhs := new(big.Int).Mul(new(big.Int).SetUint64(part), big.NewInt(basisPoints))
+	rhs := new(big.Int).Mul(new(big.Int).SetUint64(whole), big.NewInt(int64(bps)))
+	return lhs.Cmp(rhs)
+}
+
+// tally weighs the votes on a proposal by the tokens each voter has bonded to the active
+// validators.
+func (app *KVStoreApplication) tally(ctx context.Context, r store.Reader, id uint64) (*v1.TallyResult, error) {
+	set, err := app.store.GetValidatorSet(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	active := make(map[string]*v1.ValidatorState, len(set.Validators))
+	tally := &v1.TallyResult{}
+	for _, validator := range set.Validators {
+		active[string(validator.Pubkey)] = validator
+		tally.TotalBonded += validator.Tokens
+	}
+
+	err = app.store.EachProposalVote(ctx, r, id, func(vote *v1.Vote) error {
+		delegations, err := app.store.GetDelegations(ctx, r, vote.VoterPubkey)
+		if err != nil {
+			return err
+		}
+		var weight uint64
+		for _, delegation := range delegations.Delegations {
+			if validator, ok := active[string(delegation.ValidatorPubkey)]; ok {
+				weight += tokensForShares(validator, delegation.Shares)
+			}
+		}
+		// the weights never add up to more than the tokens bonded to the active validators
+		switch vote.Option {
+		case v1.VoteOption_VOTE_OPTION_YES:
+			tally.Yes += weight
+		case v1.VoteOption_VOTE_OPTION_NO:
+			tally.No += weight
+		case v1.VoteOption_VOTE_OPTION_ABSTAIN:
+			tally.Abstain += weight
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tally, nil
+}
+
+// executeProposal applies the content of a passed proposal to cache. Content that cannot be
+// applied returns a txError.
+func (app *KVStoreApplication) executeProposal(ctx context.Context, block *blockContext, cache *store.Cache, content *v1.ProposalContent) error {
+	switch content := content.GetContent().(type) {
+	case *v1.ProposalContent_UpdateParams:
+		return app.updateParams(ctx, block, cache, content.UpdateParams)
+	case *v1.ProposalContent_CommunityPoolSpend:
+		return app.spendCommunityPool(ctx, block, cache, content.CommunityPoolSpend)
+	case *v1.ProposalContent_Upgrade:
+		if content.Upgrade.Height <= uint64(block.height) {
+			return newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
+				"upgrade height %d has passed", content.Upgrade.Height)
+		}
+		return app.store.SetUpgradePlan(ctx, cache, content.Upgrade)
+	default:
+		return newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
+			"unknown proposal content type: %T", content)
+	}
+}
+
+// refundDeposits returns the deposits on a proposal to their depositors.
+func (app *KVStoreApplication) refundDeposits(ctx context.Context, block *blockContext, proposal *v1.Proposal) error {
+	return app.store.EachProposalDeposit(ctx, block.cache, proposal.Id, func(deposit *v1.Deposit) error {
+		account, err := app.getOrCreateAccount(ctx, block, block.cache, deposit.DepositorPubkey)
+		if err != nil {
+			return err
+		}
+		account.Balance += deposit.Amount
+		return app.store.UpdateAccount(ctx, block.cache, account)
+	})
+}
+
+// forfeitDeposits pays the deposits on a proposal into the community pool.
+func (app *KVStoreApplication) forfeitDeposits(ctx context.Context, block *blockContext, proposal *v1.Proposal) error {
+	return app.fundCommunityPool(ctx, block.cache, proposal.TotalDeposit)
+}
+
+// fundCommunityPool pays tokens already counted in the supply into the community pool.
+func (app *KVStoreApplication) fundCommunityPool(ctx context.Context, cache *store.Cache, amount uint64) error {
+	if amount == 0 {
+		return nil
+	}
+	pool, err := app.store.GetCommunityPool(ctx, cache)
+	if err != nil {
+		return err
+	}
+	// the pool is part of the supply, so it cannot overflow
+	pool.Balance += amount
+	return app.store.SetCommunityPool(ctx, cache, pool)
+}
+
+// spendCommunityPool pays tokens from the community pool to the recipient of a passed proposal.
+func (app *KVStoreApplication) spendCommunityPool(ctx context.Context, block *blockContext, cache *store.Cache, spend *v1.CommunityPoolSpend) error {
+	pool, err := app.store.GetCommunityPool(ctx, cache)
+	if err != nil {
+		return err
+	}
+	if pool.Balance < spend.Amount {
+		return newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INSUFFICIENT_FUNDS,
+			"insufficient community pool funds: balance %d, spend %d", pool.Balance, spend.Amount)
+	}
+	pool.Balance -= spend.Amount
+	if err := app.store.SetCommunityPool(ctx, cache, pool); err != nil {
+		return err
+	}
+
+	account, err := app.getOrCreateAccount(ctx, block, cache, spend.RecipientPubkey)
+	if err != nil {
+		return err
+	}
+	account.Balance += spend.Amount
+	return app.store.UpdateAccount(ctx, cache, account)
+}
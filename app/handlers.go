@@ -0,0 +1,130 @@
+package app
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math"
+
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/cockroachdb/pebble"
+)
+
+// checkKeyValue rejects key value transactions whose key or value is too large.
+func checkKeyValue(params *v1.Params, kvTx *v1.KeyValueTransaction) error {
+	if uint64(len(kvTx.Key)) > params.MaxKeyValueKeySize {
+		return newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
+			"key of %d bytes exceeds max key size %d", len(kvTx.Key), params.MaxKeyValueKeySize)
+	}
+	if uint64(len(kvTx.Value)) > params.MaxKeyValueValueSize {
+		return newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
+			"value of %d bytes exceeds max value size %d", len(kvTx.Value), params.MaxKeyValueValueSize)
+	}
+	return nil
+}
+
+func (app *KVStoreApplication) handleKeyValue(tc *txContext, kvTx *v1.KeyValueTransaction) (*v1.TransactionResultBody, error) {
+	if err := checkKeyValue(tc.block.params, kvTx); err != nil {
+		return nil, err
+	}
+	if err := tc.meter.Consume(WattsKeyValueTx, "key value transaction"); err != nil {
+		return nil, err
+	}
+
+	kv := &v1.KeyValueState{
+		Key:   kvTx.Key,
+		Value: kvTx.Value,
+	}
+	if err := tc.setKeyValue(kv); err != nil {
+		return nil, err
+	}
+	tc.emit(&v1.KeyValueSetEvent{Key: kv.Key, Setter: tc.transaction.Header.FromPubkey})
+
+	return &v1.TransactionResultBody{
+		Body: &v1.TransactionResultBody_KeyValue{
+			KeyValue: &v1.KeyValueResult{},
+		},
+	}, nil
+}
+
+func (app *KVStoreApplication) handleTokenTransfer(tc *txContext, tokenTx *v1.TokenTransferTransaction) (*v1.TransactionResultBody, error) {
+	if err := tc.meter.Consume(WattsTokenTransferTx, "token transfer transaction"); err != nil {
+		return nil, err
+	}
+
+	fromAccount, err := tc.getOrCreateAccount(tokenTx.FromPubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	if fromAccount.Balance < tokenTx.Amount {
+		return nil, newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INSUFFICIENT_FUNDS,
+			"insufficient funds: balance %d, transfer %d", fromAccount.Balance, tokenTx.Amount)
+	}
+	fromAccount.Balance -= tokenTx.Amount
+	if err := tc.updateAccount(fromAccount); err != nil {
+		return nil, err
+	}
+
+	toAccount, err := tc.getOrCreateAccount(tokenTx.ToPubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	if toAccount.Balance > math.MaxUint64-tokenTx.Amount {
+		return nil, newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
+			"transfer of %d overflows recipient balance %d", tokenTx.Amount, toAccount.Balance)
+	}
+	toAccount.Balance += tokenTx.Amount
+	if err := tc.updateAccount(toAccount); err != nil {
+		return nil, err
+	}
+	tc.emit(&v1.TransferEvent{From: tokenTx.FromPubkey, To: tokenTx.ToPubkey, Amount: tokenTx.Amount})
+
+	return &v1.TransactionResultBody{
+		Body: &v1.TransactionResultBody_TokenTransfer{
+			TokenTransfer: &v1.TokenTransferResult{},
+		},
+	}, nil
+}
+
+// checkContentHash rejects content hashes that are not a sha256 hash.
+func checkContentHash(contentHash []byte) error {
+	if len(contentHash) != sha256.Size {
+		return newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
+			"content hash of %d bytes is not a sha256 hash", len(contentHash))
+	}
+	return nil
+}
+
+func (app *KVStoreApplication) handleContentRegistration(tc *txContext, registration *v1.ContentRegistrationTransaction) (*v1.TransactionResultBody, error) {
+	if err := tc.meter.Consume(WattsContentRegistrationTx, "content registration transaction"); err != nil {
+		return nil, err
+	}
+	if err := checkContentHash(registration.ContentHash); err != nil {
+		return nil, err
+	}
+
+	_, err := tc.getContent(registration.ContentHash)
+	if err == nil {
+		return nil, newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
+			"content %x is already registered", registration.ContentHash)
+	}
+	if !errors.Is(err, pebble.ErrNotFound) {
+		return nil, err
+	}
+
+	content := &v1.ContentState{
+		ContentHash:      registration.ContentHash,
+		OwnerPubkey:      tc.transaction.Header.FromPubkey,
+		RegisteredHeight: uint64(tc.block.height),
+	}
+	if err := tc.setContent(content); err != nil {
+		return nil, err
+	}
+
+	return &v1.TransactionResultBody{
+		Body: &v1.TransactionResultBody_ContentRegistration{
+			ContentRegistration: &v1.ContentRegistrationResult{},
+		},
+	}, nil
+}
@@ -2,37 +2,112 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"math"
 
-	mcrypto "github.com/alecsavvy/mojave/crypto"
+	"github.com/alecsavvy/mojave/config"
 	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
 	"github.com/alecsavvy/mojave/store"
 	"github.com/alecsavvy/mojave/utils"
 	"github.com/cockroachdb/pebble"
 	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtcrypto "github.com/cometbft/cometbft/api/cometbft/crypto/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
 )
 
 type KVStoreApplication struct {
 	logger       *zap.SugaredLogger
+	config       *config.AppConfig
 	store        *store.Store
 	onGoingBlock *pebble.Batch
+
+	// snapshots keeps the state sync snapshots served to peers, and restore tracks a snapshot
+	// being restored from peers.
+	snapshots *store.SnapshotStore
+	restore   *snapshotRestore
+
+	// content is what this node attests to being able to serve in its vote extensions.
+	content ContentSource
+
+	// chainID is set from genesis in InitChain and every transaction must be signed for it.
+	chainID string
+
+	// upgrades are the software upgrades the binary knows, which set its app version.
+	upgrades []Upgrade
+
+	// mempool is what CheckTx validates against, so a sender can queue several transactions
+	// without waiting for a block but cannot queue more than its balance covers.
+	mempool *mempoolState
+
+	// height and appHash describe the last committed block, and finalizedHeight and finalizedAppHash
+	// the block staged in onGoingBlock. They are persisted with each block so Info can report them
+	// after a restart.
+	height           int64
+	appHash          []byte
+	finalizedHeight  int64
+	finalizedAppHash []byte
 }
 
 var _ abcitypes.Application = (*KVStoreApplication)(nil)
 
-func NewKVStoreApplication(logger *zap.SugaredLogger, db *store.Store) *KVStoreApplication {
+// NewKVStoreApplication creates the application over db. Snapshots are only taken when snapshots is set,
+// and content availability is only attested to when content is.
+func NewKVStoreApplication(logger *zap.SugaredLogger, db *store.Store, snapshots *store.SnapshotStore, content ContentSource, appConfig *config.AppConfig) (*KVStoreApplication, error) {
+	if err := db.DiscardInterruptedRestore(); err != nil {
+		return nil, err
+	}
+
+	// a node that has already run InitChain restores the chain ID and last block from its store
+	chainID, err := db.GetChainID(context.Background())
+	if err != nil && !errors.Is(err, pebble.ErrNotFound) {
+		return nil, err
+	}
+
+	height, appHash, err := db.GetLastBlock(context.Background())
+	if err != nil && !errors.Is(err, pebble.ErrNotFound) {
+		return nil, err
+	}
+
 	return &KVStoreApplication{
 		logger:       logger,
+		config:       appConfig,
 		store:        db,
+		snapshots:    snapshots,
+		content:      content,
 		onGoingBlock: nil,
-	}
+		chainID:      chainID,
+		upgrades:     Upgrades,
+		mempool:      newMempoolState(),
+		height:       height,
+		appHash:      appHash,
+	}, nil
 }
 
-func (app *KVStoreApplication) Info(_ context.Context, info *abcitypes.InfoRequest) (*abcitypes.InfoResponse, error) {
-	return &abcitypes.InfoResponse{}, nil
+func (app *KVStoreApplication) Info(ctx context.Context, info *abcitypes.InfoRequest) (*abcitypes.InfoResponse, error) {
+	// before InitChain the chain starts at the binary's app version
+	appVersion := app.appVersion()
+	if app.appHash != nil {
+		version, err := app.getAppVersion(ctx, app.store.DB)
+		if err != nil {
+			return nil, err
+		}
+		if version > appVersion {
+			return nil, fmt.Errorf("state is at app version %d, newer than the app version %d of this binary", version, appVersion)
+		}
+		appVersion = version
+	}
+
+	// a block that was finalized but not committed before a crash is not in the store,
+	// so CometBFT replays it from the height reported here
+	return &abcitypes.InfoResponse{
+		Version:          Version,
+		AppVersion:       appVersion,
+		LastBlockHeight:  app.height,
+		LastBlockAppHash: app.appHash,
+	}, nil
 }
 
 func (app *KVStoreApplication) Query(ctx context.Context, req *abcitypes.QueryRequest) (*abcitypes.QueryResponse, error) {
@@ -41,199 +116,213 @@ func (app *KVStoreApplication) Query(ctx context.Context, req *abcitypes.QueryRe
 		return nil, err
 	}
 
-	switch query.Query.(type) {
-	case *v1.Query_KeyValue:
-		kvQuery := query.GetKeyValue()
-		kv, err := app.store.GetKeyValue(ctx, kvQuery.Key)
+	resp := &abcitypes.QueryResponse{Height: app.height}
+
+	// queries read the latest state unless they ask for a retained height
+	var reader store.Reader = app.store.DB
+	if req.Height != 0 {
+		earliest, err := app.store.EarliestHeight()
 		if err != nil {
 			return nil, err
 		}
-
-		queryResponse := &v1.QueryResponse{
-			Response: &v1.QueryResponse_KeyValue{
-				KeyValue: kv,
-			},
+		if code, log := checkQueryHeight(req.Height, earliest, app.height); code != 0 {
+			resp.Code = uint32(code)
+			resp.Log = log
+			return resp, nil
 		}
+		resp.Height = req.Height
+		reader = app.store.At(req.Height)
+	}
+
+	key, err := store.QueryKey(query)
+	if err != nil {
+		return nil, err
+	}
+	resp.Key = key
+	value, closer, err := reader.Get(key)
+	if err != nil && !errors.Is(err, pebble.ErrNotFound) {
+		return nil, err
+	}
+	exists := err == nil
+	if exists {
+		defer closer.Close()
+	}
+
+	queryResponse, err := store.DecodeQueryResponse(query, value, exists)
+	var notFound *store.QueryNotFoundError
+	switch {
+	case errors.As(err, &notFound):
+		resp.Code = uint32(v1.QueryErrorCode_QUERY_ERROR_CODE_NOT_FOUND)
+		resp.Log = notFound.Error()
+	case err != nil:
+		return nil, err
+	default:
 		queryResponseBytes, err := proto.Marshal(queryResponse)
 		if err != nil {
 			return nil, err
 		}
-		return &abcitypes.QueryResponse{Value: queryResponseBytes}, nil
-	case *v1.Query_Account:
-		accountQuery := query.GetAccount()
-		app.logger.Infow("querying account", "pubkey", accountQuery.Pubkey)
-		account, err := app.store.GetAccount(ctx, accountQuery.Pubkey)
-		if err != nil {
-			return nil, err
-		}
+		resp.Value = queryResponseBytes
+	}
 
-		queryResponse := &v1.QueryResponse{
-			Response: &v1.QueryResponse_Account{
-				Account: account,
-			},
-		}
-		queryResponseBytes, err := proto.Marshal(queryResponse)
+	if req.Prove {
+		proofOps, err := app.proveKey(resp.Key, resp.Height)
 		if err != nil {
 			return nil, err
 		}
-		return &abcitypes.QueryResponse{Value: queryResponseBytes}, nil
+		resp.ProofOps = proofOps
 	}
 
-	return nil, fmt.Errorf("unknown query type: %T", query.Query)
+	return resp, nil
 }
 
-func (app *KVStoreApplication) CheckTx(_ context.Context, check *abcitypes.CheckTxRequest) (*abcitypes.CheckTxResponse, error) {
-	var signedTransaction v1.SignedTransaction
-	if err := proto.Unmarshal(check.Tx, &signedTransaction); err != nil {
+func (app *KVStoreApplication) CheckTx(ctx context.Context, check *abcitypes.CheckTxRequest) (*abcitypes.CheckTxResponse, error) {
+	params, err := app.getCommittedParams(ctx)
+	if err != nil {
 		return nil, err
 	}
 
-	_, err := mcrypto.VerifyTransaction(&signedTransaction)
+	recheck := check.Type == abcitypes.CHECK_TX_TYPE_RECHECK
+	// a rechecked transaction had its signature verified when it was first admitted
+	transaction, err := app.checkTx(ctx, params, app.mempool, check.Tx, !recheck)
+	var txErr *txError
+	if errors.As(err, &txErr) {
+		if recheck {
+			app.logger.Debugw("evicting transaction from mempool", "tx", fmt.Sprintf("%X", utils.Hash(check.Tx)), "err", err)
+		}
+		return &abcitypes.CheckTxResponse{Code: uint32(txErr.code), Log: err.Error()}, nil
+	}
 	if err != nil {
-		return &abcitypes.CheckTxResponse{Code: 1, Log: err.Error()}, nil
+		return nil, err
 	}
 
-	return &abcitypes.CheckTxResponse{Code: 0}, nil
+	return &abcitypes.CheckTxResponse{Code: 0, GasWanted: int64(transaction.Header.WattLimit)}, nil
 }
 
-func (app *KVStoreApplication) InitChain(_ context.Context, chain *abcitypes.InitChainRequest) (*abcitypes.InitChainResponse, error) {
+func (app *KVStoreApplication) InitChain(ctx context.Context, chain *abcitypes.InitChainRequest) (*abcitypes.InitChainResponse, error) {
+	// CometBFT calls InitChain again if the node stops before committing the first block,
+	// genesis state is only written once
+	if app.appHash != nil {
+		if app.chainID != chain.ChainId {
+			return nil, fmt.Errorf("store was initialized for chain %s, not %s", app.chainID, chain.ChainId)
+		}
+		validators, err := app.genesisValidators(ctx)
+		if err != nil {
+			return nil, err
+		}
+		consensusParams, err := app.store.GetConsensusParams(ctx, app.store.DB)
+		if err != nil {
+			return nil, err
+		}
+		return &abcitypes.InitChainResponse{AppHash: app.appHash, Validators: validators, ConsensusParams: consensusParams}, nil
+	}
+
+	genesis, err := parseGenesisState(chain.AppStateBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	cache := store.NewCache(app.store.DB)
+	if err := app.store.SetChainID(ctx, cache, chain.ChainId); err != nil {
+		return nil, err
+	}
+	if err := app.initGenesis(ctx, cache, genesis, chain.Validators); err != nil {
+		return nil, err
+	}
+	// the consensus params are kept in state so that changes to them can be validated, and carry
+	// the app version the chain starts at, which is the binary's
+	consensusParams := chain.ConsensusParams
+	if consensusParams == nil {
+		defaults := config.DefaultConsensusParams().ToProto()
+		defaults.Evidence.MaxAgeNumBlocks = int64(genesis.StakingParams.UnbondingPeriod)
+		consensusParams = &defaults
+	}
+	if err := checkEvidenceAge(genesis.StakingParams, consensusParams); err != nil {
+		return nil, err
+	}
+	consensusParams.Version = &cmtproto.VersionParams{App: app.appVersion()}
+	if err := app.store.SetConsensusParams(ctx, cache, consensusParams); err != nil {
+		return nil, err
+	}
+	if err := app.store.SetAppVersion(ctx, cache, app.appVersion()); err != nil {
+		return nil, err
+	}
+
 	batch := app.store.NewBatch()
-	// give zero address all the tokens for faucet
-	app.store.UpdateAccount(context.Background(), batch, &v1.AccountState{Pubkey: utils.ZeroAddress, Balance: math.MaxUint64})
+	appHash, err := app.store.WriteState(cache, batch, 0)
+	if err != nil {
+		return nil, err
+	}
+	if err := app.store.SetLastBlock(ctx, batch, 0, appHash); err != nil {
+		return nil, err
+	}
 	if err := batch.Commit(nil); err != nil {
 		return nil, err
 	}
+	app.chainID = chain.ChainId
+	app.appHash = appHash
 
-	return &abcitypes.InitChainResponse{}, nil
+	validators, err := app.genesisValidators(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &abcitypes.InitChainResponse{AppHash: appHash, Validators: validators, ConsensusParams: consensusParams}, nil
 }
 
-func (app *KVStoreApplication) PrepareProposal(_ context.Context, proposal *abcitypes.PrepareProposalRequest) (*abcitypes.PrepareProposalResponse, error) {
-	return &abcitypes.PrepareProposalResponse{Txs: proposal.Txs}, nil
+func (app *KVStoreApplication) PrepareProposal(ctx context.Context, proposal *abcitypes.PrepareProposalRequest) (*abcitypes.PrepareProposalResponse, error) {
+	availabilityTx, err := app.buildAvailabilityTx(ctx, proposal.Height, proposal.LocalLastCommit)
+	if err != nil {
+		return nil, err
+	}
+
+	maxTxBytes := proposal.MaxTxBytes
+	if availabilityTx != nil {
+		maxTxBytes -= cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{availabilityTx})
+		if maxTxBytes < 0 {
+			availabilityTx, maxTxBytes = nil, proposal.MaxTxBytes
+		}
+	}
+
+	txs, err := app.buildProposal(ctx, proposal.Txs, maxTxBytes)
+	if err != nil {
+		return nil, err
+	}
+	if availabilityTx != nil {
+		txs = append([][]byte{availabilityTx}, txs...)
+	}
+	return &abcitypes.PrepareProposalResponse{Txs: txs}, nil
 }
 
-func (app *KVStoreApplication) ProcessProposal(_ context.Context, proposal *abcitypes.ProcessProposalRequest) (*abcitypes.ProcessProposalResponse, error) {
+func (app *KVStoreApplication) ProcessProposal(ctx context.Context, proposal *abcitypes.ProcessProposalRequest) (*abcitypes.ProcessProposalResponse, error) {
+	if err := app.checkProposal(ctx, proposal.Txs, proposal.Height, proposal.ProposedLastCommit); err != nil {
+		app.logger.Warnw("rejecting proposal", "height", proposal.Height, "proposer", fmt.Sprintf("%X", proposal.ProposerAddress), "err", err)
+		return &abcitypes.ProcessProposalResponse{Status: abcitypes.PROCESS_PROPOSAL_STATUS_REJECT}, nil
+	}
 	return &abcitypes.ProcessProposalResponse{Status: abcitypes.PROCESS_PROPOSAL_STATUS_ACCEPT}, nil
 }
 
-func (app *KVStoreApplication) FinalizeBlock(_ context.Context, req *abcitypes.FinalizeBlockRequest) (*abcitypes.FinalizeBlockResponse, error) {
+func (app *KVStoreApplication) FinalizeBlock(ctx context.Context, req *abcitypes.FinalizeBlockRequest) (*abcitypes.FinalizeBlockResponse, error) {
 	var txs = make([]*abcitypes.ExecTxResult, len(req.Txs))
-	app.onGoingBlock = app.store.NewBatch()
+	block := &blockContext{
+		height:     req.Height,
+		lastCommit: req.DecidedLastCommit,
+		cache:      store.NewCache(app.store.DB),
+	}
+	params, err := app.store.GetParams(ctx, block.cache)
+	if err != nil {
+		return nil, err
+	}
+	block.params = params
+
+	if err := app.applyUpgrade(ctx, block); err != nil {
+		return nil, err
+	}
+	if err := app.punishValidators(ctx, block, req.Misbehavior); err != nil {
+		return nil, err
+	}
+
 	for i, tx := range req.Txs {
-		txHash := utils.Hash(tx)
-		txResult := func(tx []byte) *v1.TransactionResult {
-			var signedTransaction v1.SignedTransaction
-			if err := proto.Unmarshal(tx, &signedTransaction); err != nil {
-				return &v1.TransactionResult{
-					Error: &v1.TransactionResultError{
-						Code: v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
-						Log:  err.Error(),
-					},
-				}
-			}
-
-			transaction, err := mcrypto.VerifyTransaction(&signedTransaction)
-			if err != nil {
-				return &v1.TransactionResult{
-					Error: &v1.TransactionResultError{
-						Code: v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_SIGNATURE,
-						Log:  err.Error(),
-					},
-				}
-			}
-
-			switch transaction.Body.Body.(type) {
-			case *v1.TransactionBody_KeyValue:
-				kvTx := transaction.Body.GetKeyValue()
-				kv := &v1.KeyValueState{
-					Key:   kvTx.Key,
-					Value: kvTx.Value,
-				}
-				if err := app.store.SetKeyValue(context.Background(), app.onGoingBlock, kv); err != nil {
-					return &v1.TransactionResult{
-						Error: &v1.TransactionResultError{
-							Code: v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INTERNAL,
-							Log:  err.Error(),
-						},
-					}
-				}
-				return &v1.TransactionResult{
-					Header: &v1.TransactionResultHeader{
-						TxHash:      txHash,
-						BlockHeight: uint64(req.Height),
-						ChainId:     transaction.Header.ChainId,
-						Nonce:       transaction.Header.Nonce,
-					},
-					Body: &v1.TransactionResultBody{
-						Body: &v1.TransactionResultBody_KeyValue{
-							KeyValue: &v1.KeyValueResult{},
-						},
-					},
-				}
-			case *v1.TransactionBody_TokenTransfer:
-				tokenTx := transaction.Body.GetTokenTransfer()
-
-				fromAccount, err := app.store.GetAccount(context.Background(), tokenTx.FromPubkey)
-				if err != nil {
-					return &v1.TransactionResult{
-						Error: &v1.TransactionResultError{
-							Code: v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INTERNAL,
-							Log:  err.Error(),
-						},
-					}
-				}
-
-				fromAccount.Balance -= tokenTx.Amount
-				if err := app.store.UpdateAccount(context.Background(), app.onGoingBlock, fromAccount); err != nil {
-					return &v1.TransactionResult{
-						Error: &v1.TransactionResultError{
-							Code: v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INTERNAL,
-							Log:  err.Error(),
-						},
-					}
-				}
-
-				toAccount, err := app.store.GetOrCreateAccount(context.Background(), app.onGoingBlock, tokenTx.ToPubkey)
-				if err != nil {
-					return &v1.TransactionResult{
-						Error: &v1.TransactionResultError{
-							Code: v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INTERNAL,
-							Log:  err.Error(),
-						},
-					}
-				}
-
-				toAccount.Balance += tokenTx.Amount
-				if err := app.store.UpdateAccount(context.Background(), app.onGoingBlock, toAccount); err != nil {
-					return &v1.TransactionResult{
-						Error: &v1.TransactionResultError{
-							Code: v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INTERNAL,
-							Log:  err.Error(),
-						},
-					}
-				}
-
-				return &v1.TransactionResult{
-					Header: &v1.TransactionResultHeader{
-						TxHash:      txHash,
-						BlockHeight: uint64(req.Height),
-						ChainId:     transaction.Header.ChainId,
-						Nonce:       transaction.Header.Nonce,
-					},
-					Body: &v1.TransactionResultBody{
-						Body: &v1.TransactionResultBody_TokenTransfer{
-							TokenTransfer: &v1.TokenTransferResult{},
-						},
-					},
-				}
-			default:
-				return &v1.TransactionResult{
-					Error: &v1.TransactionResultError{
-						Code: v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
-						Log:  fmt.Sprintf("unknown transaction body type: %T", transaction.Body),
-					},
-				}
-			}
-		}(tx)
+		block.txIndex = i
+		txResult, events := app.executeTx(ctx, block, tx)
 
 		txResultBytes, err := proto.Marshal(txResult)
 		if err != nil {
@@ -246,42 +335,134 @@ func (app *KVStoreApplication) FinalizeBlock(_ context.Context, req *abcitypes.F
 		}
 
 		txs[i] = &abcitypes.ExecTxResult{
-			Code: code,
-			Data: txResultBytes,
+			Code:    code,
+			Data:    txResultBytes,
+			GasUsed: int64(txResult.Header.WattsUsed),
+			Events:  events,
+		}
+	}
+
+	if err := app.payFees(ctx, block, req.ProposerAddress); err != nil {
+		return nil, err
+	}
+	if err := app.mintRewards(ctx, block, req.ProposerAddress); err != nil {
+		return nil, err
+	}
+	if err := app.completeUnbonding(ctx, block); err != nil {
+		return nil, err
+	}
+	if err := app.endProposals(ctx, block); err != nil {
+		return nil, err
+	}
+	validatorUpdates, err := app.validatorUpdates(ctx, block)
+	if err != nil {
+		return nil, err
+	}
+
+	// the block's writes are staged in a batch that is only applied to the db on Commit,
+	// together with the block's height and app hash
+	app.onGoingBlock = app.store.NewBatch()
+	if retention := int64(app.config.StateRetention); retention > 0 && req.Height > retention {
+		if err := app.store.Prune(app.onGoingBlock, req.Height-retention+1); err != nil {
+			return nil, err
 		}
 	}
+	appHash, err := app.store.WriteState(block.cache, app.onGoingBlock, req.Height)
+	if err != nil {
+		return nil, err
+	}
+	if err := app.store.SetLastBlock(ctx, app.onGoingBlock, req.Height, appHash); err != nil {
+		return nil, err
+	}
+	app.finalizedHeight = req.Height
+	app.finalizedAppHash = appHash
 
-	app.logger.Infow("finalized block", "height", req.Height, "txs", len(req.Txs))
+	app.logger.Infow("finalized block", "height", req.Height, "txs", len(req.Txs), "watts", block.wattsUsed, "fees", block.fees, "app_hash", fmt.Sprintf("%X", appHash))
 
 	return &abcitypes.FinalizeBlockResponse{
-		TxResults: txs,
+		TxResults:             txs,
+		ValidatorUpdates:      validatorUpdates,
+		ConsensusParamUpdates: block.consensusParamUpdates,
+		AppHash:               appHash,
+		Events:                block.events,
 	}, nil
 }
 
-func (app KVStoreApplication) Commit(_ context.Context, commit *abcitypes.CommitRequest) (*abcitypes.CommitResponse, error) {
-	return &abcitypes.CommitResponse{}, app.onGoingBlock.Commit(nil)
+func (app *KVStoreApplication) Commit(_ context.Context, commit *abcitypes.CommitRequest) (*abcitypes.CommitResponse, error) {
+	// mempool transactions are rechecked against the new state, which rebuilds the mempool state
+	app.mempool.reset()
+	if err := app.onGoingBlock.Commit(nil); err != nil {
+		return nil, err
+	}
+	app.height = app.finalizedHeight
+	app.appHash = app.finalizedAppHash
+
+	app.maybeSnapshot()
+	return &abcitypes.CommitResponse{}, nil
 }
 
-func (app *KVStoreApplication) ListSnapshots(_ context.Context, snapshots *abcitypes.ListSnapshotsRequest) (*abcitypes.ListSnapshotsResponse, error) {
-	return &abcitypes.ListSnapshotsResponse{}, nil
+// getCommittedAccount reads an account from committed state. Accounts that have never
+// been written are returned empty rather than as an error.
+func (app *KVStoreApplication) getCommittedAccount(ctx context.Context, pubkey []byte) (*v1.AccountState, error) {
+	return app.getAccountAt(ctx, app.store.DB, pubkey)
 }
 
-func (app *KVStoreApplication) OfferSnapshot(_ context.Context, snapshot *abcitypes.OfferSnapshotRequest) (*abcitypes.OfferSnapshotResponse, error) {
-	return &abcitypes.OfferSnapshotResponse{}, nil
+// getAccountAt reads an account from r, the latest or a historical committed state.
+func (app *KVStoreApplication) getAccountAt(ctx context.Context, r store.Reader, pubkey []byte) (*v1.AccountState, error) {
+	account, err := app.store.GetAccount(ctx, r, pubkey)
+	if errors.Is(err, pebble.ErrNotFound) {
+		return &v1.AccountState{Pubkey: pubkey}, nil
+	}
+	return account, err
 }
 
-func (app *KVStoreApplication) LoadSnapshotChunk(_ context.Context, chunk *abcitypes.LoadSnapshotChunkRequest) (*abcitypes.LoadSnapshotChunkResponse, error) {
-	return &abcitypes.LoadSnapshotChunkResponse{}, nil
+// proveKey proves the value of key committed at height, or its absence, against the app hash of that height.
+func (app *KVStoreApplication) proveKey(key []byte, height int64) (*cmtcrypto.ProofOps, error) {
+	proof, err := app.store.Prove(key, height)
+	if err != nil {
+		return nil, err
+	}
+
+	proofBytes, err := proto.Marshal(proof)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cmtcrypto.ProofOps{
+		Ops: []cmtcrypto.ProofOp{{Type: store.ProofOpType, Key: key, Data: proofBytes}},
+	}, nil
 }
 
-func (app *KVStoreApplication) ApplySnapshotChunk(_ context.Context, chunk *abcitypes.ApplySnapshotChunkRequest) (*abcitypes.ApplySnapshotChunkResponse, error) {
-	return &abcitypes.ApplySnapshotChunkResponse{Result: abcitypes.APPLY_SNAPSHOT_CHUNK_RESULT_ACCEPT}, nil
+// checkQueryHeight rejects queries for heights that are not retained or not committed yet.
+func checkQueryHeight(height int64, earliest int64, latest int64) (v1.QueryErrorCode, string) {
+	switch {
+	case height > latest:
+		return v1.QueryErrorCode_QUERY_ERROR_CODE_INVALID_HEIGHT, fmt.Sprintf("height %d is in the future, latest height is %d", height, latest)
+	case height < 1:
+		return v1.QueryErrorCode_QUERY_ERROR_CODE_INVALID_HEIGHT, fmt.Sprintf("invalid height %d", height)
+	case height < earliest:
+		return v1.QueryErrorCode_QUERY_ERROR_CODE_PRUNED_HEIGHT, fmt.Sprintf("height %d has been pruned, earliest available height is %d", height, earliest)
+	}
+	return 0, ""
 }
 
-func (app KVStoreApplication) ExtendVote(_ context.Context, extend *abcitypes.ExtendVoteRequest) (*abcitypes.ExtendVoteResponse, error) {
-	return &abcitypes.ExtendVoteResponse{}, nil
+// checkNonce rejects replayed and out-of-order transactions. A transaction must carry
+// exactly the nonce following the last one executed by its sender.
+func checkNonce(lastNonce uint64, nonce uint64) error {
+	if nonce != lastNonce+1 {
+		return fmt.Errorf("invalid nonce: expected %d, got %d", lastNonce+1, nonce)
+	}
+	return nil
 }
 
-func (app *KVStoreApplication) VerifyVoteExtension(_ context.Context, verify *abcitypes.VerifyVoteExtensionRequest) (*abcitypes.VerifyVoteExtensionResponse, error) {
-	return &abcitypes.VerifyVoteExtensionResponse{}, nil
+// checkChainID rejects transactions that were not signed for this chain, so a transaction
+// cannot be replayed on another network.
+func checkChainID(chainID string, txChainID string) error {
+	if txChainID == "" {
+		return errors.New("chain id is empty")
+	}
+	if txChainID != chainID {
+		return fmt.Errorf("chain id mismatch: expected %s, got %s", chainID, txChainID)
+	}
+	return nil
 }
@@ -0,0 +1,155 @@
+package app
+
+import (
+	"context"
+
+	mcrypto "github.com/alecsavvy/mojave/crypto"
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+)
+
+// mempoolState is the state CheckTx validates transactions against: the committed state with
+// the transactions admitted to the mempool since the last commit applied over it. It only tracks
+// what decides whether a sender's next transaction can execute, its nonce and what its pending
+// transactions may take from its balance. The block builder keeps its own for the block it builds.
+type mempoolState struct {
+	senders map[string]*pendingSender
+}
+
+type pendingSender struct {
+	// nonce is the nonce of the sender's last admitted transaction.
+	nonce uint64
+	// debits is the most the sender's admitted transactions can take from its balance,
+	// their fees and the tokens they transfer.
+	debits uint64
+}
+
+func newMempoolState() *mempoolState {
+	return &mempoolState{senders: make(map[string]*pendingSender)}
+}
+
+// sender returns the pending state of an account, starting from its committed state.
+func (m *mempoolState) sender(account *v1.AccountState) *pendingSender {
+	pending, ok := m.senders[string(account.Pubkey)]
+	if !ok {
+		pending = &pendingSender{nonce: account.Nonce}
+		m.senders[string(account.Pubkey)] = pending
+	}
+	return pending
+}
+
+// reset drops every admitted transaction. After a commit CometBFT rechecks the transactions left
+// in the mempool in order, which admits them again over the new state and evicts those whose
+// nonce was used or whose funds were spent by the block.
+func (m *mempoolState) reset() {
+	clear(m.senders)
+}
+
+// checkTx validates a transaction and admits it to state. A rejected transaction returns a
+// txError, any other error is a failure to check it.
+func (app *KVStoreApplication) checkTx(ctx context.Context, params *v1.Params, state *mempoolState, tx []byte, verifySignature bool) (*v1.Transaction, error) {
+	transaction, err := app.validateTx(params, tx, verifySignature)
+	if err != nil {
+		return nil, err
+	}
+	if err := app.admitTx(ctx, state, transaction); err != nil {
+		return nil, err
+	}
+	return transaction, nil
+}
+
+// validateTx decodes a transaction and runs the checks that only depend on params. The
+// signature is only skipped for transactions that were verified when they entered the mempool.
+// Only canonical encodings decode, so a transaction cannot be resent under another tx hash.
+func (app *KVStoreApplication) validateTx(params *v1.Params, tx []byte, verifySignature bool) (*v1.Transaction, error) {
+	if uint64(len(tx)) > params.MaxTxSize {
+		return nil, newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
+			"transaction of %d bytes exceeds max transaction size %d", len(tx), params.MaxTxSize)
+	}
+
+	signedTransaction, err := mcrypto.DecodeSignedTransaction(tx)
+	if err != nil {
+		return nil, &txError{code: v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST, err: err}
+	}
+	if signedTransaction.Availability != nil {
+		return nil, newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
+			"availability transactions are only added by proposers")
+	}
+
+	decode := mcrypto.DecodeTransaction
+	if verifySignature {
+		decode = mcrypto.VerifyTransaction
+	}
+	transaction, err := decode(signedTransaction)
+	if err != nil {
+		return nil, &txError{code: v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_SIGNATURE, err: err}
+	}
+
+	header := transaction.Header
+	if err := checkChainID(app.chainID, header.ChainId); err != nil {
+		return nil, &txError{code: v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_CHAIN_ID, err: err}
+	}
+	if err := checkWattLimit(header.WattLimit, params.MaxBlockWatts, params.MaxBlockWatts); err != nil {
+		return nil, &txError{code: v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_WATT_LIMIT, err: err}
+	}
+	if err := checkMinFee(params, header.Fee); err != nil {
+		return nil, err
+	}
+	if err := checkBody(params, transaction); err != nil {
+		return nil, err
+	}
+	if err := authorize(transaction); err != nil {
+		return nil, err
+	}
+	return transaction, nil
+}
+
+// admitTx checks a transaction against the committed state of its sender and the transactions
+// already admitted to state, and records it there.
+func (app *KVStoreApplication) admitTx(ctx context.Context, state *mempoolState, transaction *v1.Transaction) error {
+	header := transaction.Header
+	account, err := app.getCommittedAccount(ctx, header.FromPubkey)
+	if err != nil {
+		return err
+	}
+	pending := state.sender(account)
+
+	if err := checkNonce(pending.nonce, header.Nonce); err != nil {
+		return &txError{code: v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_NONCE, err: err}
+	}
+
+	// the signer is only debited what its balance covers after its pending transactions
+	available := account.Balance - pending.debits
+	if err := checkFee(available, header.Fee); err != nil {
+		return err
+	}
+	debit := header.Fee
+	if amount, kind := spentAmount(transaction); amount > 0 {
+		if amount > available-header.Fee {
+			return newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INSUFFICIENT_FUNDS,
+				"insufficient funds: balance %d, %s %d", available-header.Fee, kind, amount)
+		}
+		debit += amount
+	}
+
+	pending.nonce = header.Nonce
+	pending.debits += debit
+	return nil
+}
+
+// spentAmount returns what a transaction body takes from the signer's balance on top of the fee,
+// and what for.
+func spentAmount(transaction *v1.Transaction) (uint64, string) {
+	switch body := transaction.Body.GetBody().(type) {
+	case *v1.TransactionBody_TokenTransfer:
+		return body.TokenTransfer.Amount, "transfer"
+	case *v1.TransactionBody_Bond:
+		return body.Bond.Amount, "bond"
+	case *v1.TransactionBody_Delegate:
+		return body.Delegate.Amount, "delegation"
+	case *v1.TransactionBody_SubmitProposal:
+		return body.SubmitProposal.InitialDeposit, "deposit"
+	case *v1.TransactionBody_Deposit:
+		return body.Deposit.Amount, "deposit"
+	}
+	return 0, ""
+}
@@ -0,0 +1,109 @@
+package app
+
+import (
+	"context"
+	"math"
+
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+)
+
+// Every block mints a reward on the issuance schedule of the mint params. The community pool gets
+// its share first, then the proposer gets its bonus share of what is left, and the rest is split
+// between the validators that signed the last commit by their power. Rewards are paid to the
+// validators' accounts; any remainder from rounding goes to the proposer, and the share of a
+// validator the state no longer knows goes to the community pool. The supply tracks the reward
+// along with the tokens slashing burns, so that it always accounts for every token there is.
+
+// minReductionInterval is the fewest blocks the reward can be reduced after.
+const minReductionInterval = 100
+
+// blockReward is what the issuance schedule mints for the block at height. The reward is kept in
+// the mint state and reduced once at the first height of each reduction interval.
+func (app *KVStoreApplication) blockReward(ctx context.Context, block *blockContext, params *v1.MintParams) (uint64, error) {
+	state, err := app.store.GetMintState(ctx, block.cache)
+	if err != nil {
+		return 0, err
+	}
+	height := uint64(block.height)
+	if params.ReductionInterval == 0 || params.ReductionBps == 0 || height <= 1 || (height-1)%params.ReductionInterval != 0 {
+		return state.BlockReward, nil
+	}
+	state.BlockReward, _ = mulDiv(state.BlockReward, uint64(basisPoints-params.ReductionBps), basisPoints, false)
+	if err := app.store.SetMintState(ctx, block.cache, state); err != nil {
+		return 0, err
+	}
+	return state.BlockReward, nil
+}
+
+// mintRewards mints the reward of the block being finalized and pays it out.
+func (app *KVStoreApplication) mintRewards(ctx context.Context, block *blockContext, proposerAddress []byte) error {
+	params, err := app.store.GetMintParams(ctx, block.cache)
+	if err != nil {
+		return err
+	}
+	supply, err := app.store.GetSupply(ctx, block.cache)
+	if err != nil {
+		return err
+	}
+	reward, err := app.blockReward(ctx, block, params)
+	if err != nil {
+		return err
+	}
+	reward = min(reward, math.MaxUint64-supply.Total)
+	if reward == 0 {
+		return nil
+	}
+
+	poolShare, _ := mulDiv(reward, uint64(params.CommunityPoolBps), basisPoints, false)
+	if err := app.fundCommunityPool(ctx, block.cache, poolShare); err != nil {
+		return err
+	}
+	validatorsReward := reward - poolShare
+
+	var signedPower int64
+	for _, vote := range block.lastCommit.Votes {
+		if vote.BlockIdFlag == cmtproto.BlockIDFlagCommit {
+			signedPower += vote.Validator.Power
+		}
+	}
+
+	signersReward := uint64(0)
+	if signedPower > 0 {
+		bonus, _ := mulDiv(validatorsReward, uint64(params.ProposerBonusBps), basisPoints, false)
+		signersReward = validatorsReward - bonus
+	}
+	paid := uint64(0)
+	for _, vote := range block.lastCommit.Votes {
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit || signersReward == 0 {
+			continue
+		}
+		share, _ := mulDiv(signersReward, uint64(vote.Validator.Power), uint64(signedPower), false)
+		if err := app.creditValidator(ctx, block, vote.Validator.Address, share); err != nil {
+			return err
+		}
+		paid += share
+	}
+	if err := app.creditValidator(ctx, block, proposerAddress, validatorsReward-paid); err != nil {
+		return err
+	}
+
+	supply.Total += reward
+	supply.Minted += reward
+	if err := app.store.SetSupply(ctx, block.cache, supply); err != nil {
+		return err
+	}
+	block.emit(&v1.RewardMintedEvent{Amount: reward, CommunityPool: poolShare})
+	return nil
+}
+
+// burn takes tokens slashed from a validator out of the supply.
+func (app *KVStoreApplication) burn(ctx context.Context, block *blockContext, amount uint64) error {
+	supply, err := app.store.GetSupply(ctx, block.cache)
+	if err != nil {
+		return err
+	}
+	supply.Total -= amount
+	supply.Burned += amount
+	return app.store.SetSupply(ctx, block.cache, supply)
+}
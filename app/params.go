@@ -0,0 +1,213 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/alecsavvy/mojave/store"
+	"github.com/cockroachdb/pebble"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// Params are read from the committed state wherever transactions are checked, and once at the start
+// of each block for the transactions it executes. A change made in a block therefore takes effect from
+// the next one, which is also the first block proposed and checked against it.
+
+// checkParams rejects params no transaction could be accepted under.
+func checkParams(params *v1.Params) error {
+	for _, param := range []struct {
+		name  string
+		value uint64
+	}{
+		{"max tx size", params.MaxTxSize},
+		{"max key value key size", params.MaxKeyValueKeySize},
+		{"max key value value size", params.MaxKeyValueValueSize},
+		{"max block watts", params.MaxBlockWatts},
+	} {
+		if param.value == 0 {
+			return fmt.Errorf("%s must be positive", param.name)
+		}
+	}
+	return nil
+}
+
+// getCommittedParams reads the params from committed state.
+func (app *KVStoreApplication) getCommittedParams(ctx context.Context) (*v1.Params, error) {
+	return app.store.GetParams(ctx, app.store.DB)
+}
+
+// checkParamsAdmin rejects transactions not signed by the params admin.
+func (tc *txContext) checkParamsAdmin() error {
+	admin, err := tc.store.GetParamsAdmin(tc.ctx, tc.cache)
+	if errors.Is(err, pebble.ErrNotFound) {
+		return newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_UNAUTHORIZED,
+			"genesis set no params admin, params cannot be changed by transaction")
+	}
+	if err != nil {
+		return err
+	}
+	if err := tc.chargeRead(len(admin)); err != nil {
+		return err
+	}
+
+	if !bytes.Equal(admin, tc.transaction.Header.FromPubkey) {
+		return newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_UNAUTHORIZED,
+			"params changes must be signed by the params admin")
+	}
+	return nil
+}
+
+func (app *KVStoreApplication) handleUpdateParams(tc *txContext, update *v1.UpdateParamsTransaction) (*v1.TransactionResultBody, error) {
+	if err := tc.meter.Consume(WattsParamsTx, "update params transaction"); err != nil {
+		return nil, err
+	}
+	if err := tc.checkParamsAdmin(); err != nil {
+		return nil, err
+	}
+
+	if err := app.updateParams(tc.ctx, tc.block, tc.cache, update); err != nil {
+		return nil, err
+	}
+	return &v1.TransactionResultBody{
+		Body: &v1.TransactionResultBody_UpdateParams{
+			UpdateParams: &v1.UpdateParamsResult{},
+		},
+	}, nil
+}
+
+// updateParams validates and applies every change in update to cache. A change to the consensus
+// params is also recorded on the block, to be returned to CometBFT when it is finalized.
+func (app *KVStoreApplication) updateParams(ctx context.Context, block *blockContext, cache *store.Cache, update *v1.UpdateParamsTransaction) error {
+	invalid := func(err error) error {
+		return &txError{code: v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST, err: err}
+	}
+
+	if update.Params != nil {
+		if err := checkParams(update.Params); err != nil {
+			return invalid(err)
+		}
+		if err := app.store.SetParams(ctx, cache, update.Params); err != nil {
+			return err
+		}
+	}
+
+	if update.StakingParams != nil {
+		if err := checkStakingParams(update.StakingParams); err != nil {
+			return invalid(err)
+		}
+		current, err := app.store.GetStakingParams(ctx, cache)
+		if err != nil {
+			return err
+		}
+		if update.StakingParams.TokensPerPower != current.TokensPerPower {
+			return invalid(errors.New("tokens per power cannot change"))
+		}
+		if err := app.store.SetStakingParams(ctx, cache, update.StakingParams); err != nil {
+			return err
+		}
+	}
+
+	if update.SlashingParams != nil {
+		if err := checkSlashingParams(update.SlashingParams); err != nil {
+			return invalid(err)
+		}
+		if err := app.store.SetSlashingParams(ctx, cache, update.SlashingParams); err != nil {
+			return err
+		}
+	}
+
+	if update.MintParams != nil {
+		if err := checkMintParams(update.MintParams); err != nil {
+			return invalid(err)
+		}
+		current, err := app.store.GetMintParams(ctx, cache)
+		if err != nil {
+			return err
+		}
+		// a new block reward restarts the schedule from it, other changes apply to the reward
+		// it has reached
+		if update.MintParams.BlockReward != current.BlockReward {
+			if err := app.store.SetMintState(ctx, cache, &v1.MintState{BlockReward: update.MintParams.BlockReward}); err != nil {
+				return err
+			}
+		}
+		if err := app.store.SetMintParams(ctx, cache, update.MintParams); err != nil {
+			return err
+		}
+	}
+
+	if update.GovParams != nil {
+		if err := checkGovParams(update.GovParams); err != nil {
+			return invalid(err)
+		}
+		if err := app.store.SetGovParams(ctx, cache, update.GovParams); err != nil {
+			return err
+		}
+	}
+
+	if update.ConsensusParams != nil {
+		consensusUpdate, err := app.updateConsensusParams(ctx, cache, update.ConsensusParams)
+		if err != nil {
+			return err
+		}
+		block.consensusParamUpdates = consensusUpdate
+	}
+
+	if update.StakingParams != nil || update.ConsensusParams != nil {
+		staking, err := app.store.GetStakingParams(ctx, cache)
+		if err != nil {
+			return err
+		}
+		consensus, err := app.store.GetConsensusParams(ctx, cache)
+		if err != nil {
+			return err
+		}
+		if err := checkEvidenceAge(staking, consensus); err != nil {
+			return invalid(err)
+		}
+	}
+	return nil
+}
+
+// updateConsensusParams applies a change to the consensus params to cache and returns the update
+// CometBFT has to make.
+func (app *KVStoreApplication) updateConsensusParams(ctx context.Context, cache *store.Cache, update *v1.ConsensusParamsUpdate) (*cmtproto.ConsensusParams, error) {
+	current, err := app.store.GetConsensusParams(ctx, cache)
+	if err != nil {
+		return nil, err
+	}
+	params := cmttypes.ConsensusParamsFromProto(*current)
+
+	if update.BlockMaxBytes != 0 {
+		params.Block.MaxBytes = update.BlockMaxBytes
+	}
+	if update.BlockMaxGas != 0 {
+		params.Block.MaxGas = update.BlockMaxGas
+	}
+	if update.EvidenceMaxAgeNumBlocks != 0 {
+		params.Evidence.MaxAgeNumBlocks = update.EvidenceMaxAgeNumBlocks
+	}
+	if update.EvidenceMaxBytes != 0 {
+		params.Evidence.MaxBytes = update.EvidenceMaxBytes
+	}
+	if err := params.ValidateBasic(); err != nil {
+		return nil, &txError{code: v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST, err: err}
+	}
+
+	updated := params.ToProto()
+	if err := app.store.SetConsensusParams(ctx, cache, &updated); err != nil {
+		return nil, err
+	}
+	return consensusParamUpdates(&updated), nil
+}
+
+// consensusParamUpdates is the update CometBFT has to make to take up params. It carries every
+// param the app changes, so that the last update made in a block includes the changes made
+// before it.
+func consensusParamUpdates(params *cmtproto.ConsensusParams) *cmtproto.ConsensusParams {
+	return &cmtproto.ConsensusParams{Block: params.Block, Evidence: params.Evidence, Version: params.Version}
+}
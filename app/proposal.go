@@ -0,0 +1,171 @@
+package app
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"fmt"
+
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/alecsavvy/mojave/utils"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// proposalTx is a transaction from the mempool that is valid to propose.
+type proposalTx struct {
+	tx          []byte
+	transaction *v1.Transaction
+}
+
+// senderQueue is a sender's valid transactions in nonce order. first is the position of the
+// sender's first transaction in the mempool, which breaks ties between equal fees.
+type senderQueue struct {
+	txs   []*proposalTx
+	first int
+}
+
+// proposalQueue orders senders by the fee of their next transaction, highest first.
+type proposalQueue []*senderQueue
+
+func (q proposalQueue) Len() int { return len(q) }
+
+func (q proposalQueue) Less(i, j int) bool {
+	fi, fj := q[i].txs[0].transaction.Header.Fee, q[j].txs[0].transaction.Header.Fee
+	if fi != fj {
+		return fi > fj
+	}
+	return q[i].first < q[j].first
+}
+
+func (q proposalQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *proposalQueue) Push(x any) { *q = append(*q, x.(*senderQueue)) }
+
+func (q *proposalQueue) Pop() any {
+	old := *q
+	last := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return last
+}
+
+// buildProposal picks the transactions of a block from the mempool's. Transactions that are
+// invalid against the committed state, or duplicated, are dropped. The rest are ordered by fee,
+// highest first, while each sender's transactions keep their nonce order, until the block holds
+// maxTxBytes or its transactions could use every watt of the block.
+func (app *KVStoreApplication) buildProposal(ctx context.Context, txs [][]byte, maxTxBytes int64) ([][]byte, error) {
+	params, err := app.getCommittedParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the block is checked as if it were a fresh mempool, so each sender's transactions must
+	// follow on from its committed nonce and fit its committed balance
+	state := newMempoolState()
+	seen := make(map[string]struct{}, len(txs))
+	senders := make(map[string]*senderQueue)
+	var queue proposalQueue
+
+	for i, tx := range txs {
+		hash := utils.Hash(tx)
+		if _, ok := seen[hash]; ok {
+			continue
+		}
+		seen[hash] = struct{}{}
+
+		// the mempool only holds transactions whose signature was verified by CheckTx
+		transaction, err := app.checkTx(ctx, params, state, tx, false)
+		var txErr *txError
+		if errors.As(err, &txErr) {
+			app.logger.Debugw("dropping transaction from proposal", "tx", hash, "err", err)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		sender := string(transaction.Header.FromPubkey)
+		senderTxs, ok := senders[sender]
+		if !ok {
+			senderTxs = &senderQueue{first: i}
+			senders[sender] = senderTxs
+			queue = append(queue, senderTxs)
+		}
+		senderTxs.txs = append(senderTxs.txs, &proposalTx{tx: tx, transaction: transaction})
+	}
+
+	heap.Init(&queue)
+	var (
+		proposal   [][]byte
+		totalBytes int64
+		totalWatts uint64
+	)
+	for queue.Len() > 0 {
+		senderTxs := queue[0]
+		next := senderTxs.txs[0]
+
+		// a transaction that does not fit leaves out the rest of its sender's, which would skip its nonce
+		size := cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{next.tx})
+		wattLimit := next.transaction.Header.WattLimit
+		if totalBytes+size > maxTxBytes || wattLimit > params.MaxBlockWatts-totalWatts {
+			heap.Pop(&queue)
+			continue
+		}
+
+		proposal = append(proposal, next.tx)
+		totalBytes += size
+		totalWatts += wattLimit
+
+		senderTxs.txs = senderTxs.txs[1:]
+		if len(senderTxs.txs) == 0 {
+			heap.Pop(&queue)
+		} else {
+			heap.Fix(&queue, 0)
+		}
+	}
+	return proposal, nil
+}
+
+// checkProposal rejects blocks an honest proposer would not have built: ones with a transaction
+// CheckTx rejects regardless of state, the same transaction twice, transactions that could use
+// more than the block's watts between them, or availability attestations that do not verify
+// against the block's last commit.
+func (app *KVStoreApplication) checkProposal(ctx context.Context, txs [][]byte, height int64, lastCommit abcitypes.CommitInfo) error {
+	start := 0
+	if len(txs) > 0 {
+		if availability := decodeAvailabilityTx(txs[0]); availability != nil {
+			if _, err := app.checkAvailability(ctx, availability, height, lastCommit); err != nil {
+				return fmt.Errorf("availability tx: %w", err)
+			}
+			start = 1
+		}
+	}
+
+	params, err := app.getCommittedParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]struct{}, len(txs))
+	var totalWatts uint64
+	for i := start; i < len(txs); i++ {
+		tx := txs[i]
+		hash := utils.Hash(tx)
+		if _, ok := seen[hash]; ok {
+			return fmt.Errorf("tx %d %s is duplicated", i, hash)
+		}
+		seen[hash] = struct{}{}
+
+		transaction, err := app.validateTx(params, tx, true)
+		if err != nil {
+			return fmt.Errorf("tx %d %s: %w", i, hash, err)
+		}
+
+		wattLimit := transaction.Header.WattLimit
+		if wattLimit > params.MaxBlockWatts-totalWatts {
+			return fmt.Errorf("tx %d %s: watt limits exceed max block watts %d", i, hash, params.MaxBlockWatts)
+		}
+		totalWatts += wattLimit
+	}
+	return nil
+}
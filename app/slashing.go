@@ -0,0 +1,221 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/cockroachdb/pebble"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+)
+
+// Validators are punished for the misbehavior CometBFT reports evidence of and for missing too
+// many of the last signed_blocks_window commits. Either way a share of the validator's bonded
+// tokens is burned, which every delegation to it shares in, and the validator is jailed: it has
+// no power until it unjails, which it can only do once the jail period is over. Tokens that
+// started unbonding from the validator at or after the height of its misbehavior were bonded
+// when it misbehaved, so the same share of them is burned too. Each piece of evidence is
+// recorded once it is handled, so evidence included again is not punished twice.
+
+// basisPoints is what a whole is in the params given in basis points.
+const basisPoints = 10_000
+
+// punishValidators handles the evidence and the last commit of the block being finalized, before
+// any of its transactions.
+func (app *KVStoreApplication) punishValidators(ctx context.Context, block *blockContext, misbehavior []abcitypes.Misbehavior) error {
+	params, err := app.store.GetSlashingParams(ctx, block.cache)
+	if err != nil {
+		return err
+	}
+
+	for _, evidence := range misbehavior {
+		validator, err := app.store.GetValidator(ctx, block.cache, evidence.Validator.Address)
+		if errors.Is(err, pebble.ErrNotFound) {
+			app.logger.Warnw("ignoring evidence against unknown validator", "validator", fmt.Sprintf("%X", evidence.Validator.Address))
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		punished, err := app.store.HasPunishedEvidence(ctx, block.cache, validator.Address, evidence.Height)
+		if err != nil {
+			return err
+		}
+		if punished {
+			app.logger.Warnw("ignoring evidence already punished", "validator", fmt.Sprintf("%X", validator.Address), "height", evidence.Height)
+			continue
+		}
+		if err := app.punish(ctx, block, params, validator, params.DoubleSignSlashBps, evidence.Height, evidence.Type.String()); err != nil {
+			return err
+		}
+		if err := app.store.SetPunishedEvidence(ctx, block.cache, &v1.PunishedEvidence{
+			ValidatorAddress: validator.Address,
+			Height:           evidence.Height,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return app.trackLiveness(ctx, block, params)
+}
+
+// trackLiveness records the validators that did not sign the last commit and punishes those that
+// missed more of the signed blocks window than its min_signed_bps allows. A validator's missed
+// blocks are only written when it misses one, so blocks every validator signs change no state.
+func (app *KVStoreApplication) trackLiveness(ctx context.Context, block *blockContext, params *v1.SlashingParams) error {
+	height := uint64(block.height - 1)
+	maxMissed := params.SignedBlocksWindow * uint64(basisPoints-params.MinSignedBps) / basisPoints
+
+	for _, vote := range block.lastCommit.Votes {
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagAbsent {
+			continue
+		}
+		validator, err := app.store.GetValidator(ctx, block.cache, vote.Validator.Address)
+		if errors.Is(err, pebble.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if validator.Jailed || validator.Removed {
+			continue
+		}
+
+		missed, err := app.store.GetMissedBlocks(ctx, block.cache, validator.Address)
+		if err != nil {
+			return err
+		}
+		inWindow := missed.Heights[:0]
+		for _, missedHeight := range missed.Heights {
+			if missedHeight+params.SignedBlocksWindow > height {
+				inWindow = append(inWindow, missedHeight)
+			}
+		}
+		missed.Heights = append(inWindow, height)
+
+		if uint64(len(missed.Heights)) > maxMissed {
+			if err := app.punish(ctx, block, params, validator, params.DowntimeSlashBps, block.height, "downtime"); err != nil {
+				return err
+			}
+			missed.Heights = nil
+		}
+		if err := app.store.SetMissedBlocks(ctx, block.cache, missed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// punish burns slashBps of a validator's bonded tokens and of the tokens unbonding from it since
+// infractionHeight, and jails it for the jail period. The last validators with power are never
+// punished, since a validator set with no power halts the chain.
+func (app *KVStoreApplication) punish(ctx context.Context, block *blockContext, params *v1.SlashingParams, validator *v1.ValidatorState, slashBps uint32, infractionHeight int64, reason string) error {
+	stakingParams, err := app.store.GetStakingParams(ctx, block.cache)
+	if err != nil {
+		return err
+	}
+	set, err := app.store.GetValidatorSet(ctx, block.cache)
+	if err != nil {
+		return err
+	}
+	var remainingPower int64
+	for _, v := range set.Validators {
+		if string(v.Address) != string(validator.Address) {
+			remainingPower += v.Power
+		}
+	}
+	if remainingPower == 0 {
+		app.logger.Warnw("not punishing the last validator with power", "validator", fmt.Sprintf("%X", validator.Address), "reason", reason)
+		return nil
+	}
+
+	burned, _ := mulDiv(validator.Tokens, uint64(slashBps), basisPoints, false)
+	validator.Tokens -= burned
+	validator.Jailed = true
+	validator.JailedUntil = max(validator.JailedUntil, uint64(block.height)+params.JailPeriod)
+	validator.Power = validatorPower(stakingParams, validator)
+	if err := app.store.SetValidator(ctx, block.cache, validator); err != nil {
+		return err
+	}
+	unbondingBurned, err := app.slashUnbonding(ctx, block, validator, slashBps, uint64(infractionHeight))
+	if err != nil {
+		return err
+	}
+	burned += unbondingBurned
+	if err := app.burn(ctx, block, burned); err != nil {
+		return err
+	}
+	block.emit(&v1.ValidatorSlashedEvent{
+		ValidatorPubkey:  validator.Pubkey,
+		Reason:           reason,
+		InfractionHeight: infractionHeight,
+		Burned:           burned,
+	})
+	block.emit(&v1.ValidatorJailedEvent{ValidatorPubkey: validator.Pubkey, JailedUntil: validator.JailedUntil})
+
+	app.logger.Infow("jailed validator", "validator", fmt.Sprintf("%X", validator.Address), "reason", reason,
+		"burned", burned, "jailed_until", validator.JailedUntil)
+	return nil
+}
+
+// slashUnbonding burns slashBps of the entries unbonding from a validator that were created at
+// or after infractionHeight, and returns the tokens burned.
+func (app *KVStoreApplication) slashUnbonding(ctx context.Context, block *blockContext, validator *v1.ValidatorState, slashBps uint32, infractionHeight uint64) (uint64, error) {
+	var burned uint64
+	err := app.store.EachUnbondingDelegator(ctx, block.cache, validator.Pubkey, func(delegator []byte) error {
+		queue, err := app.store.GetUnbonding(ctx, block.cache, delegator)
+		if err != nil {
+			return err
+		}
+		for _, entry := range queue.Entries {
+			if !bytes.Equal(entry.ValidatorPubkey, validator.Pubkey) || entry.CreationHeight < infractionHeight {
+				continue
+			}
+			slashed, _ := mulDiv(entry.Amount, uint64(slashBps), basisPoints, false)
+			entry.Amount -= slashed
+			burned += slashed
+		}
+		return app.store.SetUnbonding(ctx, block.cache, queue)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return burned, nil
+}
+
+// handleUnjail returns the signer's validator to the set with the power its bonded tokens give it.
+func (app *KVStoreApplication) handleUnjail(tc *txContext, unjail *v1.UnjailTransaction) (*v1.TransactionResultBody, error) {
+	if err := tc.meter.Consume(WattsStakingTx, "unjail transaction"); err != nil {
+		return nil, err
+	}
+
+	validator, err := tc.getValidator(tc.transaction.Header.FromPubkey)
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil, newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
+			"signer is not a validator")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !validator.Jailed {
+		return nil, newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
+			"validator %X is not jailed", validator.Address)
+	}
+	if uint64(tc.block.height) < validator.JailedUntil {
+		return nil, newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
+			"validator %X is jailed until height %d", validator.Address, validator.JailedUntil)
+	}
+
+	validator.Jailed = false
+	if err := tc.setValidator(validator); err != nil {
+		return nil, err
+	}
+	return &v1.TransactionResultBody{
+		Body: &v1.TransactionResultBody_Unjail{
+			Unjail: &v1.UnjailResult{},
+		},
+	}, nil
+}
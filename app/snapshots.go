@@ -0,0 +1,189 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/alecsavvy/mojave/store"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"google.golang.org/protobuf/proto"
+)
+
+// snapshotRestore is a snapshot accepted from a peer whose chunks are being applied.
+type snapshotRestore struct {
+	snapshot    *abcitypes.Snapshot
+	chunkHashes [][]byte
+	// appHash is the trusted app hash at the snapshot height, verified by CometBFT's light client.
+	appHash []byte
+	next    uint32
+}
+
+// maybeSnapshot takes a snapshot of the just committed state at every snapshot interval. The
+// checkpoint is taken before returning so it sees exactly this height, while chunking it is left
+// to the background.
+func (app *KVStoreApplication) maybeSnapshot() {
+	interval := app.config.SnapshotInterval
+	height := uint64(app.height)
+	if app.snapshots == nil || interval == 0 || height%interval != 0 {
+		return
+	}
+
+	// a failed snapshot only affects peers syncing from this node, so it must not halt the chain
+	if err := app.snapshots.Checkpoint(app.store.DB, height); err != nil {
+		app.logger.Errorw("failed to checkpoint snapshot", "height", height, "err", err)
+		return
+	}
+	go func() {
+		if err := app.snapshots.Export(height); err != nil {
+			app.logger.Errorw("failed to export snapshot", "height", height, "err", err)
+			return
+		}
+		app.logger.Infow("took snapshot", "height", height)
+	}()
+}
+
+func (app *KVStoreApplication) ListSnapshots(_ context.Context, snapshots *abcitypes.ListSnapshotsRequest) (*abcitypes.ListSnapshotsResponse, error) {
+	if app.snapshots == nil {
+		return &abcitypes.ListSnapshotsResponse{}, nil
+	}
+
+	list, err := app.snapshots.List()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &abcitypes.ListSnapshotsResponse{}
+	for _, snapshot := range list {
+		metadata, err := proto.Marshal(snapshot.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		hash := sha256.Sum256(metadata)
+		resp.Snapshots = append(resp.Snapshots, &abcitypes.Snapshot{
+			Height:   snapshot.Height,
+			Format:   store.SnapshotFormat,
+			Chunks:   uint32(len(snapshot.Metadata.ChunkHashes)),
+			Hash:     hash[:],
+			Metadata: metadata,
+		})
+	}
+	return resp, nil
+}
+
+func (app *KVStoreApplication) OfferSnapshot(_ context.Context, offer *abcitypes.OfferSnapshotRequest) (*abcitypes.OfferSnapshotResponse, error) {
+	// snapshots are only restored into an empty store
+	if app.appHash != nil {
+		return &abcitypes.OfferSnapshotResponse{Result: abcitypes.OFFER_SNAPSHOT_RESULT_ABORT}, nil
+	}
+
+	snapshot := offer.Snapshot
+	if snapshot == nil {
+		return &abcitypes.OfferSnapshotResponse{Result: abcitypes.OFFER_SNAPSHOT_RESULT_REJECT}, nil
+	}
+	if snapshot.Format != store.SnapshotFormat {
+		return &abcitypes.OfferSnapshotResponse{Result: abcitypes.OFFER_SNAPSHOT_RESULT_REJECT_FORMAT}, nil
+	}
+
+	// the hash covers the metadata, and the metadata the hash of every chunk
+	metadata := &v1.SnapshotMetadata{}
+	hash := sha256.Sum256(snapshot.Metadata)
+	if err := proto.Unmarshal(snapshot.Metadata, metadata); err != nil ||
+		!bytes.Equal(hash[:], snapshot.Hash) ||
+		snapshot.Chunks == 0 ||
+		len(metadata.ChunkHashes) != int(snapshot.Chunks) {
+		return &abcitypes.OfferSnapshotResponse{Result: abcitypes.OFFER_SNAPSHOT_RESULT_REJECT}, nil
+	}
+
+	if err := app.store.BeginRestore(); err != nil {
+		return nil, err
+	}
+	app.restore = &snapshotRestore{
+		snapshot:    snapshot,
+		chunkHashes: metadata.ChunkHashes,
+		appHash:     offer.AppHash,
+	}
+	app.logger.Infow("restoring snapshot", "height", snapshot.Height, "chunks", snapshot.Chunks)
+	return &abcitypes.OfferSnapshotResponse{Result: abcitypes.OFFER_SNAPSHOT_RESULT_ACCEPT}, nil
+}
+
+func (app *KVStoreApplication) LoadSnapshotChunk(_ context.Context, chunk *abcitypes.LoadSnapshotChunkRequest) (*abcitypes.LoadSnapshotChunkResponse, error) {
+	if app.snapshots == nil || chunk.Format != store.SnapshotFormat {
+		return &abcitypes.LoadSnapshotChunkResponse{}, nil
+	}
+
+	data, err := app.snapshots.LoadChunk(chunk.Height, chunk.Chunk)
+	if err != nil {
+		// a pruned snapshot has no chunks left, the peer will fetch from someone else
+		app.logger.Warnw("failed to load snapshot chunk", "height", chunk.Height, "chunk", chunk.Chunk, "err", err)
+		return &abcitypes.LoadSnapshotChunkResponse{}, nil
+	}
+	return &abcitypes.LoadSnapshotChunkResponse{Chunk: data}, nil
+}
+
+func (app *KVStoreApplication) ApplySnapshotChunk(ctx context.Context, chunk *abcitypes.ApplySnapshotChunkRequest) (*abcitypes.ApplySnapshotChunkResponse, error) {
+	restore := app.restore
+	if restore == nil {
+		return &abcitypes.ApplySnapshotChunkResponse{Result: abcitypes.APPLY_SNAPSHOT_CHUNK_RESULT_ABORT}, nil
+	}
+
+	// chunks are applied in order, anything else means the restore went wrong
+	if chunk.Index != restore.next {
+		return app.rejectSnapshot()
+	}
+
+	hash := sha256.Sum256(chunk.Chunk)
+	if !bytes.Equal(hash[:], restore.chunkHashes[chunk.Index]) {
+		app.logger.Warnw("snapshot chunk does not match its hash", "chunk", chunk.Index, "sender", chunk.Sender)
+		return &abcitypes.ApplySnapshotChunkResponse{
+			Result:        abcitypes.APPLY_SNAPSHOT_CHUNK_RESULT_RETRY,
+			RefetchChunks: []uint32{chunk.Index},
+			RejectSenders: []string{chunk.Sender},
+		}, nil
+	}
+
+	if err := app.store.RestoreChunk(chunk.Chunk); err != nil {
+		app.logger.Warnw("failed to restore snapshot chunk", "chunk", chunk.Index, "err", err)
+		return app.rejectSnapshot()
+	}
+
+	restore.next++
+	if restore.next < restore.snapshot.Chunks {
+		return &abcitypes.ApplySnapshotChunkResponse{Result: abcitypes.APPLY_SNAPSHOT_CHUNK_RESULT_ACCEPT}, nil
+	}
+
+	// with every chunk applied the restored state must be the trusted state at the snapshot height
+	height := int64(restore.snapshot.Height)
+	err := app.store.FinishRestore(ctx, height, restore.appHash)
+	if errors.Is(err, store.ErrRestoredStateMismatch) {
+		app.logger.Warnw("restored snapshot does not match the trusted app hash", "height", height, "err", err)
+		return app.rejectSnapshot()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	chainID, err := app.store.GetChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	app.chainID = chainID
+	app.height = height
+	app.appHash = restore.appHash
+	app.restore = nil
+	app.logger.Infow("restored snapshot", "height", height, "app_hash", fmt.Sprintf("%X", restore.appHash))
+	return &abcitypes.ApplySnapshotChunkResponse{Result: abcitypes.APPLY_SNAPSHOT_CHUNK_RESULT_ACCEPT}, nil
+}
+
+// rejectSnapshot discards a partially restored snapshot so another one can be offered.
+func (app *KVStoreApplication) rejectSnapshot() (*abcitypes.ApplySnapshotChunkResponse, error) {
+	app.restore = nil
+	if err := app.store.Reset(); err != nil {
+		return nil, err
+	}
+	return &abcitypes.ApplySnapshotChunkResponse{Result: abcitypes.APPLY_SNAPSHOT_CHUNK_RESULT_REJECT_SNAPSHOT}, nil
+}
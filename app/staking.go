@@ -0,0 +1,399 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/cockroachdb/pebble"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"google.golang.org/protobuf/proto"
+)
+
+// Accounts stake by bonding tokens from their balance to a validator: a validator's own account
+// bonds to itself and other accounts delegate to it. A validator's voting power is its bonded
+// tokens in units of tokens_per_power. Delegations hold shares of a validator's bonded tokens, so
+// that anything that changes the tokens changes every delegation in proportion. Unbonded tokens
+// are locked for the unbonding period before they return to the delegator's balance.
+
+// checkStakeAmount rejects bonding or unbonding nothing.
+func checkStakeAmount(amount uint64) error {
+	if amount == 0 {
+		return newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
+			"amount must be positive")
+	}
+	return nil
+}
+
+// mulDiv returns a*b/c rounded down, or up if roundUp is set, and whether the result fits in a uint64.
+func mulDiv(a, b, c uint64, roundUp bool) (uint64, bool) {
+	product := new(big.Int).Mul(new(big.Int).SetUint64(a), new(big.Int).SetUint64(b))
+	quotient, remainder := product.QuoRem(product, new(big.Int).SetUint64(c), new(big.Int))
+	if roundUp && remainder.Sign() != 0 {
+		quotient.Add(quotient, big.NewInt(1))
+	}
+	if !quotient.IsUint64() {
+		return 0, false
+	}
+	return quotient.Uint64(), true
+}
+
+// tokensForShares returns the bonded tokens shares of a validator are worth.
+func tokensForShares(validator *v1.ValidatorState, shares uint64) uint64 {
+	if validator.Shares == 0 {
+		return 0
+	}
+	// shares never exceed the validator's, so the result never exceeds its tokens
+	tokens, _ := mulDiv(shares, validator.Tokens, validator.Shares, false)
+	return tokens
+}
+
+// delegate adds amount to a validator's bonded tokens and returns the shares issued for it, at the
+// validator's current rate of tokens per share.
+func delegate(validator *v1.ValidatorState, amount uint64) (uint64, error) {
+	shares := amount
+	if validator.Shares > 0 {
+		if validator.Tokens == 0 {
+			return 0, fmt.Errorf("validator %X has no bonded tokens left to delegate to", validator.Address)
+		}
+		var ok bool
+		shares, ok = mulDiv(amount, validator.Shares, validator.Tokens, false)
+		if !ok {
+			return 0, fmt.Errorf("delegation of %d overflows the shares of validator %X", amount, validator.Address)
+		}
+		if shares == 0 {
+			return 0, fmt.Errorf("delegation of %d is too small to issue any shares of validator %X", amount, validator.Address)
+		}
+	}
+	if validator.Tokens > math.MaxUint64-amount || validator.Shares > math.MaxUint64-shares {
+		return 0, fmt.Errorf("delegation of %d overflows the bonded tokens of validator %X", amount, validator.Address)
+	}
+	validator.Tokens += amount
+	validator.Shares += shares
+	return shares, nil
+}
+
+// findDelegation returns the position of the delegation to validatorPubkey, or where it belongs.
+func findDelegation(delegations *v1.DelegatorState, validatorPubkey []byte) (int, bool) {
+	return slices.BinarySearchFunc(delegations.Delegations, validatorPubkey, func(d *v1.Delegation, pubkey []byte) int {
+		return bytes.Compare(d.ValidatorPubkey, pubkey)
+	})
+}
+
+// addShares adds shares to a delegator's delegation to validatorPubkey, creating it if needed.
+func addShares(delegations *v1.DelegatorState, validatorPubkey []byte, shares uint64) {
+	i, found := findDelegation(delegations, validatorPubkey)
+	if found {
+		delegations.Delegations[i].Shares += shares
+		return
+	}
+	delegations.Delegations = slices.Insert(delegations.Delegations, i, &v1.Delegation{ValidatorPubkey: validatorPubkey, Shares: shares})
+}
+
+func (tc *txContext) getStakingParams() (*v1.StakingParams, error) {
+	params, err := tc.store.GetStakingParams(tc.ctx, tc.cache)
+	if err != nil {
+		return nil, err
+	}
+	if err := tc.chargeRead(proto.Size(params)); err != nil {
+		return nil, err
+	}
+	return params, nil
+}
+
+func (tc *txContext) getDelegations(delegator []byte) (*v1.DelegatorState, error) {
+	delegations, err := tc.store.GetDelegations(tc.ctx, tc.cache, delegator)
+	if err != nil {
+		return nil, err
+	}
+	if err := tc.chargeRead(proto.Size(delegations)); err != nil {
+		return nil, err
+	}
+	return delegations, nil
+}
+
+func (tc *txContext) setDelegations(delegations *v1.DelegatorState) error {
+	if err := tc.chargeWrite(proto.Size(delegations)); err != nil {
+		return err
+	}
+	return tc.store.SetDelegations(tc.ctx, tc.cache, delegations)
+}
+
+// bond moves amount from the delegator's balance into the validator's bonded tokens.
+func (tc *txContext) bond(delegator []byte, validator *v1.ValidatorState, amount uint64) (*v1.TransactionResultBody, error) {
+	account, err := tc.getOrCreateAccount(delegator)
+	if err != nil {
+		return nil, err
+	}
+	if account.Balance < amount {
+		return nil, newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INSUFFICIENT_FUNDS,
+			"insufficient funds: balance %d, bond %d", account.Balance, amount)
+	}
+
+	shares, err := delegate(validator, amount)
+	if err != nil {
+		return nil, &txError{code: v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST, err: err}
+	}
+	account.Balance -= amount
+	if err := tc.updateAccount(account); err != nil {
+		return nil, err
+	}
+
+	delegations, err := tc.getDelegations(delegator)
+	if err != nil {
+		return nil, err
+	}
+	addShares(delegations, validator.Pubkey, shares)
+	if err := tc.setDelegations(delegations); err != nil {
+		return nil, err
+	}
+	if err := tc.setValidator(validator); err != nil {
+		return nil, err
+	}
+
+	return &v1.TransactionResultBody{
+		Body: &v1.TransactionResultBody_Delegation{
+			Delegation: &v1.DelegationResult{Shares: shares},
+		},
+	}, nil
+}
+
+// handleBond bonds to the signer's own validator. Once there is a validator admin, only validators
+// it admitted can bond; without one any account can become a validator this way.
+func (app *KVStoreApplication) handleBond(tc *txContext, bond *v1.BondTransaction) (*v1.TransactionResultBody, error) {
+	if err := tc.meter.Consume(WattsStakingTx, "bond transaction"); err != nil {
+		return nil, err
+	}
+	if err := checkStakeAmount(bond.Amount); err != nil {
+		return nil, err
+	}
+
+	signer := tc.transaction.Header.FromPubkey
+	validator, err := tc.getValidator(signer)
+	switch {
+	case errors.Is(err, pebble.ErrNotFound):
+		validator = &v1.ValidatorState{Address: ed25519.PubKey(signer).Address(), Pubkey: signer}
+		_, err := tc.store.GetValidatorAdmin(tc.ctx, tc.cache)
+		if err == nil {
+			return nil, newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_UNAUTHORIZED,
+				"validator %X has not been admitted by the validator admin", validator.Address)
+		}
+		if !errors.Is(err, pebble.ErrNotFound) {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	case validator.Removed:
+		return nil, newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
+			"validator %X has been removed from the set", validator.Address)
+	}
+	return tc.bond(signer, validator, bond.Amount)
+}
+
+func (app *KVStoreApplication) handleDelegate(tc *txContext, delegation *v1.DelegateTransaction) (*v1.TransactionResultBody, error) {
+	if err := tc.meter.Consume(WattsStakingTx, "delegate transaction"); err != nil {
+		return nil, err
+	}
+	if err := checkValidatorKey(delegation.ValidatorPubkey); err != nil {
+		return nil, err
+	}
+	if err := checkStakeAmount(delegation.Amount); err != nil {
+		return nil, err
+	}
+
+	validator, err := tc.getValidator(delegation.ValidatorPubkey)
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil, newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
+			"validator %X does not exist", ed25519.PubKey(delegation.ValidatorPubkey).Address())
+	}
+	if err != nil {
+		return nil, err
+	}
+	if validator.Removed {
+		return nil, newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
+			"validator %X has been removed from the set", validator.Address)
+	}
+	return tc.bond(tc.transaction.Header.FromPubkey, validator, delegation.Amount)
+}
+
+// handleUnbond takes tokens out of a validator's bonded tokens and queues them to return to the
+// delegator's balance once the unbonding period has passed.
+func (app *KVStoreApplication) handleUnbond(tc *txContext, unbond *v1.UnbondTransaction) (*v1.TransactionResultBody, error) {
+	if err := tc.meter.Consume(WattsStakingTx, "unbond transaction"); err != nil {
+		return nil, err
+	}
+	if err := checkValidatorKey(unbond.ValidatorPubkey); err != nil {
+		return nil, err
+	}
+	if err := checkStakeAmount(unbond.Amount); err != nil {
+		return nil, err
+	}
+
+	delegator := tc.transaction.Header.FromPubkey
+	address := ed25519.PubKey(unbond.ValidatorPubkey).Address()
+	delegations, err := tc.getDelegations(delegator)
+	if err != nil {
+		return nil, err
+	}
+	i, found := findDelegation(delegations, unbond.ValidatorPubkey)
+	if !found {
+		return nil, newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
+			"no delegation to validator %X", address)
+	}
+	delegation := delegations.Delegations[i]
+	validator, err := tc.getValidator(unbond.ValidatorPubkey)
+	if err != nil {
+		return nil, fmt.Errorf("get validator %X: %w", address, err)
+	}
+
+	// the shares are rounded up so that unbonding never takes more than the delegation is worth
+	shares, ok := mulDiv(unbond.Amount, validator.Shares, max(validator.Tokens, 1), true)
+	if validator.Tokens == 0 || !ok || shares > delegation.Shares {
+		return nil, newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INSUFFICIENT_FUNDS,
+			"insufficient bonded tokens: delegation %d, unbond %d", tokensForShares(validator, delegation.Shares), unbond.Amount)
+	}
+	delegation.Shares -= shares
+	if delegation.Shares == 0 {
+		delegations.Delegations = slices.Delete(delegations.Delegations, i, i+1)
+	}
+	validator.Tokens -= unbond.Amount
+	validator.Shares -= shares
+
+	params, err := tc.getStakingParams()
+	if err != nil {
+		return nil, err
+	}
+	completionHeight := uint64(tc.block.height) + params.UnbondingPeriod
+	if err := tc.queueUnbonding(delegator, &v1.UnbondingEntry{
+		ValidatorPubkey:  unbond.ValidatorPubkey,
+		Amount:           unbond.Amount,
+		CompletionHeight: completionHeight,
+		CreationHeight:   uint64(tc.block.height),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := tc.setDelegations(delegations); err != nil {
+		return nil, err
+	}
+	if err := tc.setValidator(validator); err != nil {
+		return nil, err
+	}
+
+	return &v1.TransactionResultBody{
+		Body: &v1.TransactionResultBody_Unbond{
+			Unbond: &v1.UnbondResult{CompletionHeight: completionHeight},
+		},
+	}, nil
+}
+
+// queueUnbonding adds an entry to the delegator's unbonding queue and records the delegator
+// under the height the entry completes at and under the validator it unbonds from. Each record
+// has its own key, so its cost does not grow with other delegators' unbonding.
+func (tc *txContext) queueUnbonding(delegator []byte, entry *v1.UnbondingEntry) error {
+	queue, err := tc.store.GetUnbonding(tc.ctx, tc.cache, delegator)
+	if err != nil {
+		return err
+	}
+	if err := tc.chargeRead(proto.Size(queue)); err != nil {
+		return err
+	}
+	// the unbonding period can change, so an entry may complete before those queued earlier.
+	// It goes after every entry completing by its height to keep the queue ordered.
+	i, _ := slices.BinarySearchFunc(queue.Entries, entry.CompletionHeight, func(e *v1.UnbondingEntry, height uint64) int {
+		if e.CompletionHeight <= height {
+			return -1
+		}
+		return 1
+	})
+	queue.Entries = slices.Insert(queue.Entries, i, entry)
+	if err := tc.chargeWrite(proto.Size(queue)); err != nil {
+		return err
+	}
+	if err := tc.store.SetUnbonding(tc.ctx, tc.cache, queue); err != nil {
+		return err
+	}
+
+	if err := tc.chargeWrite(len(delegator)); err != nil {
+		return err
+	}
+	if err := tc.store.SetUnbondingDelegator(tc.ctx, tc.cache, entry.ValidatorPubkey, delegator); err != nil {
+		return err
+	}
+	if err := tc.chargeWrite(len(delegator)); err != nil {
+		return err
+	}
+	return tc.store.SetUnbondingMaturity(tc.ctx, tc.cache, entry.CompletionHeight, delegator)
+}
+
+// completeUnbonding returns the tokens whose unbonding period ends with the block to their
+// delegators' balances.
+func (app *KVStoreApplication) completeUnbonding(ctx context.Context, block *blockContext) error {
+	height := uint64(block.height)
+	completed := 0
+	err := app.store.EachUnbondingMaturity(ctx, block.cache, height, func(maturity uint64, delegator []byte) error {
+		if err := app.store.DeleteUnbondingMaturity(ctx, block.cache, maturity, delegator); err != nil {
+			return err
+		}
+		completed++
+
+		queue, err := app.store.GetUnbonding(ctx, block.cache, delegator)
+		if err != nil {
+			return err
+		}
+		i := slices.IndexFunc(queue.Entries, func(entry *v1.UnbondingEntry) bool { return entry.CompletionHeight > height })
+		if i < 0 {
+			i = len(queue.Entries)
+		}
+		// an earlier record of the delegator at this height may have completed these entries
+		if i == 0 {
+			return nil
+		}
+		completedEntries := queue.Entries[:i]
+		queue.Entries = queue.Entries[i:]
+		if err := app.store.SetUnbonding(ctx, block.cache, queue); err != nil {
+			return err
+		}
+
+		var amount uint64
+		for _, entry := range completedEntries {
+			amount += entry.Amount
+			if err := app.dropUnbondingDelegator(ctx, block, queue, entry.ValidatorPubkey); err != nil {
+				return err
+			}
+		}
+
+		account, err := app.getOrCreateAccount(ctx, block, block.cache, delegator)
+		if err != nil {
+			return err
+		}
+		account.Balance += amount
+		if err := app.store.UpdateAccount(ctx, block.cache, account); err != nil {
+			return err
+		}
+		block.emit(&v1.UnbondingCompletedEvent{DelegatorPubkey: delegator, Amount: amount})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if completed > 0 {
+		app.logger.Debugw("completed unbonding", "height", height, "delegators", completed)
+	}
+	return nil
+}
+
+// dropUnbondingDelegator removes the delegator of queue from the delegators unbonding from a
+// validator once none of its entries unbond from it.
+func (app *KVStoreApplication) dropUnbondingDelegator(ctx context.Context, block *blockContext, queue *v1.UnbondingQueue, validator []byte) error {
+	if slices.ContainsFunc(queue.Entries, func(entry *v1.UnbondingEntry) bool { return bytes.Equal(entry.ValidatorPubkey, validator) }) {
+		return nil
+	}
+	return app.store.DeleteUnbondingDelegator(ctx, block.cache, validator, queue.DelegatorPubkey)
+}
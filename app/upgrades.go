@@ -0,0 +1,117 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/alecsavvy/mojave/store"
+	"github.com/cockroachdb/pebble"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+)
+
+// Software upgrades are scheduled by governance for a height. A binary runs the chain up to the
+// upgrade height, where FinalizeBlock refuses the block and consensus halts with the state of the
+// block before it committed. The binary that knows the upgrade is started in its place: CometBFT
+// replays the block at the upgrade height to it, and it runs the upgrade's migration before
+// executing the block. Each upgrade moves the state to the next app version, which CometBFT puts
+// in the header of every block from the one after the upgrade on.
+
+// Version is the release of the binary, reported in Info. Releases set it with
+// -ldflags "-X github.com/alecsavvy/mojave/app.Version=<version>".
+var Version = "dev"
+
+// genesisAppVersion is the app version of state that has not been through any upgrade.
+const genesisAppVersion uint64 = 1
+
+// Upgrade is a software upgrade the binary can run.
+type Upgrade struct {
+	// Name is the name governance schedules the upgrade by.
+	Name string
+	// Migrate moves the state to the layout the binary expects. It runs at the upgrade height,
+	// before the block there executes, and writes to cache like the block does. Keys it has to
+	// find by prefix can be iterated in s.DB, which holds the state of the block before.
+	Migrate func(ctx context.Context, s *store.Store, cache *store.Cache) error
+}
+
+// Upgrades are the upgrades the binary knows, in the order the chain runs them. The binary runs
+// the app version state reaches after the last of them.
+var Upgrades []Upgrade
+
+// RegisterUpgrade adds an upgrade after those the binary was built with.
+func (app *KVStoreApplication) RegisterUpgrade(upgrade Upgrade) {
+	app.upgrades = append(app.upgrades, upgrade)
+}
+
+// appVersion is the app version of the binary.
+func (app *KVStoreApplication) appVersion() uint64 {
+	return genesisAppVersion + uint64(len(app.upgrades))
+}
+
+// getAppVersion returns the app version of the state in r.
+func (app *KVStoreApplication) getAppVersion(ctx context.Context, r store.Reader) (uint64, error) {
+	version, err := app.store.GetAppVersion(ctx, r)
+	if errors.Is(err, pebble.ErrNotFound) {
+		return genesisAppVersion, nil
+	}
+	return version, err
+}
+
+// applyUpgrade runs the migration of the upgrade scheduled for the block being finalized, before
+// any of the block executes. It refuses to execute a block the binary cannot: one at the height
+// of an upgrade the binary does not know, or any block of state at another app version.
+func (app *KVStoreApplication) applyUpgrade(ctx context.Context, block *blockContext) error {
+	version, err := app.getAppVersion(ctx, block.cache)
+	if err != nil {
+		return err
+	}
+	plan, err := app.store.GetUpgradePlan(ctx, block.cache)
+	if errors.Is(err, pebble.ErrNotFound) {
+		plan = nil
+	} else if err != nil {
+		return err
+	}
+
+	if plan == nil || plan.Height != uint64(block.height) {
+		if version != app.appVersion() {
+			return fmt.Errorf("state is at app version %d, but this binary runs app version %d", version, app.appVersion())
+		}
+		return nil
+	}
+
+	if version == app.appVersion() {
+		app.logger.Errorw("upgrade needed, halting until a binary that knows the upgrade is started",
+			"upgrade", plan.Name, "height", plan.Height, "app_version", version)
+		return fmt.Errorf("upgrade %q is scheduled at height %d, and this binary at app version %d does not know it",
+			plan.Name, plan.Height, version)
+	}
+	upgrade := app.upgrades[len(app.upgrades)-1]
+	if version+1 != app.appVersion() || upgrade.Name != plan.Name {
+		return fmt.Errorf("upgrade %q at height %d takes state at app version %d to %d, but this binary runs upgrade %q to app version %d",
+			plan.Name, plan.Height, version, version+1, upgrade.Name, app.appVersion())
+	}
+
+	app.logger.Infow("running upgrade", "upgrade", plan.Name, "height", plan.Height, "app_version", app.appVersion())
+	if upgrade.Migrate != nil {
+		if err := upgrade.Migrate(ctx, app.store, block.cache); err != nil {
+			return fmt.Errorf("migrate state for upgrade %q: %w", plan.Name, err)
+		}
+	}
+	if err := app.store.SetAppVersion(ctx, block.cache, app.appVersion()); err != nil {
+		return err
+	}
+	if err := app.store.DeleteUpgradePlan(ctx, block.cache); err != nil {
+		return err
+	}
+
+	params, err := app.store.GetConsensusParams(ctx, block.cache)
+	if err != nil {
+		return err
+	}
+	params.Version = &cmtproto.VersionParams{App: app.appVersion()}
+	if err := app.store.SetConsensusParams(ctx, block.cache, params); err != nil {
+		return err
+	}
+	block.consensusParamUpdates = consensusParamUpdates(params)
+	return nil
+}
@@ -0,0 +1,238 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/cockroachdb/pebble"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"google.golang.org/protobuf/proto"
+)
+
+// validatorState converts a CometBFT validator update into the state stored for the validator.
+// Only ed25519 keys are supported since the consensus key also owns the validator's account.
+func validatorState(update abcitypes.ValidatorUpdate) (*v1.ValidatorState, error) {
+	if update.PubKeyType != ed25519.KeyType {
+		return nil, fmt.Errorf("unsupported validator key type %q", update.PubKeyType)
+	}
+	if len(update.PubKeyBytes) != ed25519.PubKeySize {
+		return nil, fmt.Errorf("invalid ed25519 validator key length %d", len(update.PubKeyBytes))
+	}
+
+	return &v1.ValidatorState{
+		Address: ed25519.PubKey(update.PubKeyBytes).Address(),
+		Pubkey:  update.PubKeyBytes,
+		Power:   update.Power,
+	}, nil
+}
+
+// checkValidatorKey rejects validator keys that are not ed25519 public keys.
+func checkValidatorKey(pubkey []byte) error {
+	if len(pubkey) != ed25519.PubKeySize {
+		return newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
+			"invalid ed25519 validator key length %d", len(pubkey))
+	}
+	return nil
+}
+
+// checkValidatorAdmin rejects validator set changes not signed by the validator admin set at genesis.
+func (tc *txContext) checkValidatorAdmin() error {
+	admin, err := tc.store.GetValidatorAdmin(tc.ctx, tc.cache)
+	if errors.Is(err, pebble.ErrNotFound) {
+		return newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_UNAUTHORIZED,
+			"genesis set no validator admin, validators join the set by bonding")
+	}
+	if err != nil {
+		return err
+	}
+	if err := tc.chargeRead(len(admin)); err != nil {
+		return err
+	}
+
+	if !bytes.Equal(admin, tc.transaction.Header.FromPubkey) {
+		return newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_UNAUTHORIZED,
+			"validator set changes must be signed by the validator admin")
+	}
+	return nil
+}
+
+// getValidator returns the validator with pubkey, or pebble.ErrNotFound if it has never been
+// admitted or bonded to.
+func (tc *txContext) getValidator(pubkey []byte) (*v1.ValidatorState, error) {
+	validator, err := tc.store.GetValidator(tc.ctx, tc.cache, ed25519.PubKey(pubkey).Address())
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			if err := tc.chargeRead(0); err != nil {
+				return nil, err
+			}
+		}
+		return nil, err
+	}
+	if err := tc.chargeRead(proto.Size(validator)); err != nil {
+		return nil, err
+	}
+	return validator, nil
+}
+
+// validatorPower is the voting power of a validator's bonded tokens. It is capped just past what
+// CometBFT allows so the total power check rejects it.
+func validatorPower(params *v1.StakingParams, validator *v1.ValidatorState) int64 {
+	if validator.Removed || validator.Jailed {
+		return 0
+	}
+	return int64(min(validator.Tokens/params.TokensPerPower, uint64(cmttypes.MaxTotalVotingPower)+1))
+}
+
+// setValidator writes a validator with the power its bonded tokens give it, refusing changes that
+// would leave the set with no power or more than CometBFT allows.
+func (tc *txContext) setValidator(validator *v1.ValidatorState) error {
+	params, err := tc.getStakingParams()
+	if err != nil {
+		return err
+	}
+	validator.Power = validatorPower(params, validator)
+
+	set, err := tc.store.GetValidatorSet(tc.ctx, tc.cache)
+	if err != nil {
+		return err
+	}
+	if err := tc.chargeRead(proto.Size(set)); err != nil {
+		return err
+	}
+
+	totalPower := validator.Power
+	for _, v := range set.Validators {
+		if !bytes.Equal(v.Address, validator.Address) {
+			totalPower += v.Power
+		}
+	}
+	if totalPower == 0 {
+		return newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
+			"the validator set cannot be left without power")
+	}
+	if totalPower > cmttypes.MaxTotalVotingPower {
+		return newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
+			"total validator power %d exceeds max %d", totalPower, cmttypes.MaxTotalVotingPower)
+	}
+
+	// the validator is written on its own and as part of the set
+	if err := tc.chargeWrite(proto.Size(validator)); err != nil {
+		return err
+	}
+	if err := tc.chargeWrite(proto.Size(set) + proto.Size(validator)); err != nil {
+		return err
+	}
+	return tc.store.SetValidator(tc.ctx, tc.cache, validator)
+}
+
+func validatorUpdateResult() *v1.TransactionResultBody {
+	return &v1.TransactionResultBody{
+		Body: &v1.TransactionResultBody_ValidatorUpdate{
+			ValidatorUpdate: &v1.ValidatorUpdateResult{},
+		},
+	}
+}
+
+// handleAddValidator admits a validator to the set. It joins with the power of the tokens bonded
+// to it, so a new validator only gets power once it bonds.
+func (app *KVStoreApplication) handleAddValidator(tc *txContext, add *v1.AddValidatorTransaction) (*v1.TransactionResultBody, error) {
+	if err := tc.meter.Consume(WattsValidatorUpdateTx, "add validator transaction"); err != nil {
+		return nil, err
+	}
+	if err := tc.checkValidatorAdmin(); err != nil {
+		return nil, err
+	}
+	if err := checkValidatorKey(add.Pubkey); err != nil {
+		return nil, err
+	}
+
+	validator, err := tc.getValidator(add.Pubkey)
+	switch {
+	case errors.Is(err, pebble.ErrNotFound):
+		validator = &v1.ValidatorState{Address: ed25519.PubKey(add.Pubkey).Address(), Pubkey: add.Pubkey}
+	case err != nil:
+		return nil, err
+	case !validator.Removed:
+		return nil, newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
+			"validator %X is already in the set", validator.Address)
+	}
+	validator.Removed = false
+	if err := tc.setValidator(validator); err != nil {
+		return nil, err
+	}
+	return validatorUpdateResult(), nil
+}
+
+// handleRemoveValidator removes a validator from the set. Its delegators keep their delegations
+// and can unbond them.
+func (app *KVStoreApplication) handleRemoveValidator(tc *txContext, remove *v1.RemoveValidatorTransaction) (*v1.TransactionResultBody, error) {
+	if err := tc.meter.Consume(WattsValidatorUpdateTx, "remove validator transaction"); err != nil {
+		return nil, err
+	}
+	if err := tc.checkValidatorAdmin(); err != nil {
+		return nil, err
+	}
+	if err := checkValidatorKey(remove.Pubkey); err != nil {
+		return nil, err
+	}
+
+	validator, err := tc.getValidator(remove.Pubkey)
+	if errors.Is(err, pebble.ErrNotFound) || (err == nil && validator.Removed) {
+		return nil, newTxError(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST,
+			"validator %X is not in the set", ed25519.PubKey(remove.Pubkey).Address())
+	}
+	if err != nil {
+		return nil, err
+	}
+	validator.Removed = true
+	if err := tc.setValidator(validator); err != nil {
+		return nil, err
+	}
+	return validatorUpdateResult(), nil
+}
+
+// validatorUpdates are the changes the block made to the validator set, ordered by address.
+// Diffing the set the block leaves against the committed one means a validator changed several
+// times in a block gets a single update, and one added and removed again gets none.
+func (app *KVStoreApplication) validatorUpdates(ctx context.Context, block *blockContext) ([]abcitypes.ValidatorUpdate, error) {
+	before, err := app.store.GetValidatorSet(ctx, app.store.DB)
+	if err != nil {
+		return nil, err
+	}
+	after, err := app.store.GetValidatorSet(ctx, block.cache)
+	if err != nil {
+		return nil, err
+	}
+
+	var updates []abcitypes.ValidatorUpdate
+	update := func(validator *v1.ValidatorState, power int64) {
+		updates = append(updates, abcitypes.ValidatorUpdate{
+			PubKeyType:  ed25519.KeyType,
+			PubKeyBytes: validator.Pubkey,
+			Power:       power,
+		})
+	}
+	i, j := 0, 0
+	for i < len(before.Validators) || j < len(after.Validators) {
+		switch {
+		case j == len(after.Validators) || (i < len(before.Validators) && bytes.Compare(before.Validators[i].Address, after.Validators[j].Address) < 0):
+			update(before.Validators[i], 0)
+			i++
+		case i == len(before.Validators) || bytes.Compare(before.Validators[i].Address, after.Validators[j].Address) > 0:
+			update(after.Validators[j], after.Validators[j].Power)
+			j++
+		default:
+			if before.Validators[i].Power != after.Validators[j].Power {
+				update(after.Validators[j], after.Validators[j].Power)
+			}
+			i++
+			j++
+		}
+	}
+	return updates, nil
+}
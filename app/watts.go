@@ -0,0 +1,76 @@
+package app
+
+import (
+	"fmt"
+)
+
+// Watt costs charged while executing a transaction. Store access is charged a flat amount
+// per operation plus a per-byte amount so that large values cost more than small ones.
+const (
+	WattsSignatureCheck uint64 = 1_000
+	WattsRead           uint64 = 100
+	WattsReadPerByte    uint64 = 1
+	WattsWrite          uint64 = 200
+	WattsWritePerByte   uint64 = 10
+
+	WattsKeyValueTx            uint64 = 500
+	WattsTokenTransferTx       uint64 = 500
+	WattsContentRegistrationTx uint64 = 500
+	WattsValidatorUpdateTx     uint64 = 1_000
+	WattsStakingTx             uint64 = 1_000
+	WattsParamsTx              uint64 = 1_000
+	WattsGovTx                 uint64 = 1_000
+)
+
+// OutOfWattsError is returned when a transaction exceeds its watt limit.
+type OutOfWattsError struct {
+	Limit      uint64
+	Descriptor string
+}
+
+func (e *OutOfWattsError) Error() string {
+	return fmt.Sprintf("out of watts: limit %d exceeded by %s", e.Limit, e.Descriptor)
+}
+
+// WattMeter tracks the watts used by a single transaction against its watt limit.
+type WattMeter struct {
+	limit uint64
+	used  uint64
+}
+
+func NewWattMeter(limit uint64) *WattMeter {
+	return &WattMeter{limit: limit}
+}
+
+// Consume charges amount watts to the meter. Once the limit is exceeded the meter
+// is pinned at the limit and every further call fails.
+func (m *WattMeter) Consume(amount uint64, descriptor string) error {
+	if amount > m.limit-m.used {
+		m.used = m.limit
+		return &OutOfWattsError{Limit: m.limit, Descriptor: descriptor}
+	}
+	m.used += amount
+	return nil
+}
+
+func (m *WattMeter) Used() uint64 {
+	return m.used
+}
+
+func (m *WattMeter) Limit() uint64 {
+	return m.limit
+}
+
+// checkWattLimit validates a transaction's watt limit against what a block can hold.
+func checkWattLimit(wattLimit uint64, maxBlockWatts uint64, blockWattsRemaining uint64) error {
+	if wattLimit == 0 {
+		return fmt.Errorf("watt limit must be set")
+	}
+	if wattLimit > maxBlockWatts {
+		return fmt.Errorf("watt limit %d exceeds max block watts %d", wattLimit, maxBlockWatts)
+	}
+	if wattLimit > blockWattsRemaining {
+		return fmt.Errorf("watt limit %d exceeds remaining block watts %d", wattLimit, blockWattsRemaining)
+	}
+	return nil
+}
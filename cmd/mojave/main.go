@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"log"
 	"os"
@@ -18,6 +19,7 @@ import (
 	"github.com/urfave/cli/v3"
 
 	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/types"
 	cmttime "github.com/cometbft/cometbft/types/time"
 )
@@ -29,6 +31,7 @@ func main() {
 		Commands: []*cli.Command{
 			runCmd,
 			testnetCmd,
+			genesisCmd,
 		},
 	}
 
@@ -43,6 +46,23 @@ var runCmd = &cli.Command{
 	Name:    "run",
 	Aliases: []string{"r"},
 	Usage:   "run the mojave node",
+	Flags: []cli.Flag{
+		&cli.Uint64Flag{
+			Name:  "snapshot-interval",
+			Value: config.DefaultAppConfig().SnapshotInterval,
+			Usage: "blocks between state sync snapshots, 0 disables them",
+		},
+		&cli.IntFlag{
+			Name:  "snapshot-keep-recent",
+			Value: config.DefaultAppConfig().SnapshotKeepRecent,
+			Usage: "number of recent state sync snapshots to keep",
+		},
+		&cli.Uint64Flag{
+			Name:  "state-retention",
+			Value: config.DefaultAppConfig().StateRetention,
+			Usage: "number of recent heights whose state can be queried, 0 keeps every height",
+		},
+	},
 	Action: func(ctx context.Context, c *cli.Command) error {
 		homeDir := os.TempDir() + "/mojave-dev-" + time.Now().Format("20060102150405")
 		cmtConfig := cfg.DefaultConfig()
@@ -52,7 +72,12 @@ var runCmd = &cli.Command{
 			return err
 		}
 
-		a, err := app.NewApp(cmtConfig)
+		appConfig := config.DefaultAppConfig()
+		appConfig.SnapshotInterval = c.Uint64("snapshot-interval")
+		appConfig.SnapshotKeepRecent = c.Int("snapshot-keep-recent")
+		appConfig.StateRetention = c.Uint64("state-retention")
+
+		a, err := app.NewApp(cmtConfig, appConfig)
 		if err != nil {
 			return fmt.Errorf("failed to create app: %w", err)
 		}
@@ -67,6 +92,40 @@ var runCmd = &cli.Command{
 	},
 }
 
+var genesisCmd = &cli.Command{
+	Name:  "genesis",
+	Usage: "edit a genesis file",
+	Commands: []*cli.Command{
+		{
+			Name:  "add-account",
+			Usage: "add an account with a balance to the app state of a genesis file",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     "genesis",
+					Usage:    "path of the genesis file",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     "pubkey",
+					Usage:    "hex encoded ed25519 public key of the account",
+					Required: true,
+				},
+				&cli.Uint64Flag{
+					Name:  "balance",
+					Usage: "balance of the account at genesis",
+				},
+			},
+			Action: func(ctx context.Context, c *cli.Command) error {
+				pubkey, err := hex.DecodeString(c.String("pubkey"))
+				if err != nil {
+					return fmt.Errorf("invalid pubkey: %w", err)
+				}
+				return config.AddGenesisAccount(c.String("genesis"), pubkey, c.Uint64("balance"))
+			},
+		},
+	},
+}
+
 var testnetCmd = &cli.Command{
 	Name:  "testnet",
 	Usage: "run a local multi-validator testnet",
@@ -94,7 +153,7 @@ var testnetCmd = &cli.Command{
 		type nodeInfo struct {
 			config *cfg.Config
 			nodeID string
-			pubKey types.GenesisValidator
+			pubKey crypto.PubKey
 		}
 		nodes := make([]nodeInfo, n)
 
@@ -116,7 +175,7 @@ var testnetCmd = &cli.Command{
 			nodes[i] = nodeInfo{
 				config: cmtConfig,
 				nodeID: string(nodeKey.ID()),
-				pubKey: types.GenesisValidator{Address: pubKey.Address(), PubKey: pubKey, Power: 10},
+				pubKey: pubKey,
 			}
 		}
 
@@ -135,14 +194,21 @@ var testnetCmd = &cli.Command{
 
 		// 3) Write configs and shared genesis
 		chainID := "mojave-testnet-" + time.Now().Format("20060102150405")
+		pubKeys := make([]crypto.PubKey, n)
+		for i := range n {
+			pubKeys[i] = nodes[i].pubKey
+		}
+		genesisState, validators := config.DevGenesis(pubKeys)
+		appState, err := config.MarshalAppState(genesisState)
+		if err != nil {
+			return err
+		}
 		genDoc := &types.GenesisDoc{
 			ChainID:         chainID,
 			GenesisTime:     cmttime.Now(),
-			ConsensusParams: types.DefaultConsensusParams(),
-			Validators:      make([]types.GenesisValidator, n),
-		}
-		for i := range n {
-			genDoc.Validators[i] = nodes[i].pubKey
+			ConsensusParams: config.DefaultConsensusParams(),
+			Validators:      validators,
+			AppState:        appState,
 		}
 
 		for i := range n {
@@ -159,7 +225,7 @@ var testnetCmd = &cli.Command{
 			nodeConfig := nodes[i].config
 			go func() {
 				defer wg.Done()
-				a, err := app.NewApp(nodeConfig)
+				a, err := app.NewApp(nodeConfig, config.DefaultAppConfig())
 				if err != nil {
 					log.Fatalf("failed to create app: %v", err)
 				}
@@ -0,0 +1,32 @@
+package config
+
+import (
+	"errors"
+)
+
+// AppConfig holds the settings of the mojave application itself, as opposed to CometBFT's.
+type AppConfig struct {
+	// SnapshotInterval is the number of blocks between state sync snapshots, 0 disables them.
+	SnapshotInterval uint64
+	// SnapshotKeepRecent is the number of most recent snapshots kept on disk.
+	SnapshotKeepRecent int
+	// StateRetention is the number of most recent heights whose state can be queried, 0 keeps every height.
+	StateRetention uint64
+}
+
+// DefaultAppConfig returns the settings a node runs with unless configured otherwise.
+func DefaultAppConfig() *AppConfig {
+	return &AppConfig{
+		SnapshotInterval:   1000,
+		SnapshotKeepRecent: 2,
+		StateRetention:     100_000,
+	}
+}
+
+// ValidateBasic checks the settings for values the app cannot run with.
+func (c *AppConfig) ValidateBasic() error {
+	if c.SnapshotInterval > 0 && c.SnapshotKeepRecent < 1 {
+		return errors.New("snapshot_keep_recent must be at least 1 when snapshots are enabled")
+	}
+	return nil
+}
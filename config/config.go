@@ -59,6 +59,16 @@ func InitValidatorOnly(config *cfg.Config) (*privval.FilePV, *p2p.NodeKey, crypt
 	return GenValidatorKeys(config)
 }
 
+// DefaultConsensusParams are CometBFT's defaults with vote extensions enabled from the first height,
+// as validators attest to the content they can serve in them. Evidence is accepted for as long as
+// the default unbonding period, so that tokens unbonding at the infraction can still be slashed.
+func DefaultConsensusParams() *types.ConsensusParams {
+	params := types.DefaultConsensusParams()
+	params.Feature.VoteExtensionsEnableHeight = 1
+	params.Evidence.MaxAgeNumBlocks = int64(DefaultStakingParams().UnbondingPeriod)
+	return params
+}
+
 // InitFilesWithConfig initializes a single-node directory: writes config, generates keys, and creates
 // a single-validator genesis. Returns (pv, nodeKey, genDoc, nil).
 func InitFilesWithConfig(config *cfg.Config) (*privval.FilePV, *p2p.NodeKey, *types.GenesisDoc, error) {
@@ -67,17 +77,20 @@ func InitFilesWithConfig(config *cfg.Config) (*privval.FilePV, *p2p.NodeKey, *ty
 		return nil, nil, nil, err
 	}
 
+	genesisState, validators := DevGenesis([]crypto.PubKey{pubKey})
+	appState, err := MarshalAppState(genesisState)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
 	genFile := config.GenesisFile()
 	r := rand.Intn(1000000)
 	genDoc := types.GenesisDoc{
 		ChainID:         fmt.Sprintf("mojave-dev-%v", r),
 		GenesisTime:     cmttime.Now(),
-		ConsensusParams: types.DefaultConsensusParams(),
-		Validators: []types.GenesisValidator{{
-			Address: pubKey.Address(),
-			PubKey:  pubKey,
-			Power:   10,
-		}},
+		ConsensusParams: DefaultConsensusParams(),
+		Validators:      validators,
+		AppState:        appState,
 	}
 	if err := genDoc.SaveAs(genFile); err != nil {
 		return nil, nil, nil, err
@@ -1,9 +1,13 @@
 package config
 
 import (
+	"bytes"
 	"testing"
 
 	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
 )
 
 func TestInitFilesWithConfig(t *testing.T) {
@@ -15,3 +19,25 @@ func TestInitFilesWithConfig(t *testing.T) {
 	}
 	t.Logf("Initialized CometBFT files under %s (inspect config/, genesis.json, etc.)", config.RootDir)
 }
+
+func TestAddGenesisAccount(t *testing.T) {
+	config := cfg.DefaultConfig()
+	config.SetRoot(t.TempDir())
+	_, _, _, err := InitFilesWithConfig(config)
+	require.NoError(t, err)
+
+	pubkey := bytes.Repeat([]byte{1}, ed25519.PubKeySize)
+	require.NoError(t, AddGenesisAccount(config.GenesisFile(), pubkey, 1000))
+	require.ErrorContains(t, AddGenesisAccount(config.GenesisFile(), pubkey, 1000), "already has an account")
+	require.ErrorContains(t, AddGenesisAccount(config.GenesisFile(), pubkey[:8], 1000), "invalid account pubkey length")
+
+	genDoc, err := types.GenesisDocFromFile(config.GenesisFile())
+	require.NoError(t, err)
+	state, err := UnmarshalAppState(genDoc.AppState)
+	require.NoError(t, err)
+	// the dev faucet is kept along with the dev params
+	require.Len(t, state.Accounts, 2)
+	require.Equal(t, pubkey, state.Accounts[1].Pubkey)
+	require.Equal(t, uint64(1000), state.Accounts[1].Balance)
+	require.Equal(t, DefaultMintParams().BlockReward, state.MintParams.BlockReward)
+}
@@ -0,0 +1,145 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/alecsavvy/mojave/utils"
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/types"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// DevFaucetSupply is the balance of the dev faucet at genesis.
+const DevFaucetSupply uint64 = 1_000_000_000_000_000
+
+// DevValidatorBond is what each validator of a dev chain or testnet has bonded at genesis.
+const DevValidatorBond uint64 = 10_000_000
+
+// DefaultParams are the params of a genesis that does not set them.
+func DefaultParams() *v1.Params {
+	return &v1.Params{
+		MaxTxSize:            128 << 10,
+		MaxKeyValueKeySize:   256,
+		MaxKeyValueValueSize: 64 << 10,
+		MaxBlockWatts:        100_000_000,
+	}
+}
+
+// DefaultStakingParams are the staking params of a genesis that does not set them.
+func DefaultStakingParams() *v1.StakingParams {
+	return &v1.StakingParams{
+		UnbondingPeriod: 1_000,
+		TokensPerPower:  1_000_000,
+	}
+}
+
+// DefaultSlashingParams are the slashing params of a genesis that does not set them.
+func DefaultSlashingParams() *v1.SlashingParams {
+	return &v1.SlashingParams{
+		SignedBlocksWindow: 100,
+		MinSignedBps:       5_000,
+		DowntimeSlashBps:   100,
+		DoubleSignSlashBps: 500,
+		JailPeriod:         100,
+	}
+}
+
+// DefaultMintParams are the mint params of a genesis that does not set them.
+func DefaultMintParams() *v1.MintParams {
+	return &v1.MintParams{
+		BlockReward:       1_000_000,
+		ReductionInterval: 1_000_000,
+		ReductionBps:      1_000,
+		ProposerBonusBps:  1_000,
+		CommunityPoolBps:  200,
+	}
+}
+
+// DefaultGovParams are the governance params of a genesis that does not set them.
+func DefaultGovParams() *v1.GovParams {
+	return &v1.GovParams{
+		MinDeposit:    10_000_000,
+		DepositPeriod: 1_000,
+		VotingPeriod:  1_000,
+		QuorumBps:     3_340,
+		ThresholdBps:  5_000,
+	}
+}
+
+// DevGenesis returns the app state of dev chains and testnets, with the dev admin key as validator
+// and params admin, DevFaucetSupply in the dev faucet and DevValidatorBond bonded to each validator, along
+// with the matching genesis validators.
+func DevGenesis(pubKeys []crypto.PubKey) (*v1.GenesisState, []types.GenesisValidator) {
+	params := DefaultStakingParams()
+	state := &v1.GenesisState{
+		ValidatorAdminPubkey: utils.DevAdminPubkey,
+		ParamsAdminPubkey:    utils.DevAdminPubkey,
+		Params:               DefaultParams(),
+		StakingParams:        params,
+		SlashingParams:       DefaultSlashingParams(),
+		MintParams:           DefaultMintParams(),
+		GovParams:            DefaultGovParams(),
+		Accounts:             []*v1.GenesisAccount{{Pubkey: utils.FaucetPubkey, Balance: DevFaucetSupply}},
+	}
+	validators := make([]types.GenesisValidator, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		state.Validators = append(state.Validators, &v1.GenesisValidator{Pubkey: pubKey.Bytes(), Bonded: DevValidatorBond})
+		validators[i] = types.GenesisValidator{
+			Address: pubKey.Address(),
+			PubKey:  pubKey,
+			Power:   int64(DevValidatorBond / params.TokensPerPower),
+		}
+	}
+	return state, validators
+}
+
+// MarshalAppState encodes genesis state as the app_state of a genesis file.
+func MarshalAppState(state *v1.GenesisState) (json.RawMessage, error) {
+	return protojson.Marshal(state)
+}
+
+// UnmarshalAppState decodes the app_state of a genesis file. A genesis without one has the empty
+// genesis state.
+func UnmarshalAppState(appState json.RawMessage) (*v1.GenesisState, error) {
+	state := &v1.GenesisState{}
+	if len(bytes.TrimSpace(appState)) == 0 {
+		return state, nil
+	}
+	if err := protojson.Unmarshal(appState, state); err != nil {
+		return nil, fmt.Errorf("parse genesis app state: %w", err)
+	}
+	return state, nil
+}
+
+// AddGenesisAccount adds an account with balance to the app state of the genesis file at
+// genesisFile. It fails if the genesis already has an account for pubkey.
+func AddGenesisAccount(genesisFile string, pubkey []byte, balance uint64) error {
+	if len(pubkey) != ed25519.PubKeySize {
+		return fmt.Errorf("invalid account pubkey length %d", len(pubkey))
+	}
+
+	genDoc, err := types.GenesisDocFromFile(genesisFile)
+	if err != nil {
+		return err
+	}
+	state, err := UnmarshalAppState(genDoc.AppState)
+	if err != nil {
+		return err
+	}
+	for _, account := range state.Accounts {
+		if bytes.Equal(account.Pubkey, pubkey) {
+			return fmt.Errorf("genesis already has an account for %x", pubkey)
+		}
+	}
+	state.Accounts = append(state.Accounts, &v1.GenesisAccount{Pubkey: pubkey, Balance: balance})
+
+	genDoc.AppState, err = MarshalAppState(state)
+	if err != nil {
+		return err
+	}
+	return genDoc.SaveAs(genesisFile)
+}
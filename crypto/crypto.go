@@ -1,25 +1,76 @@
 package crypto
 
 import (
+	"bytes"
 	"crypto/ed25519"
 	"crypto/sha256"
+	"encoding/binary"
 	"errors"
 
 	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
-	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/proto"
 )
 
-// SignTransaction signs a transaction with an Ed25519 private key.
-// Returns the signed transaction and the raw tx bytes. Protobuf marshalling is not deterministic,
-// so the same logical transaction can produce different bytes (and thus different signatures) across calls.
+// SignDomain is prepended to every transaction's sign bytes so that a transaction signature
+// can never be mistaken for a signature over any other kind of message.
+const SignDomain = "mojave/tx/v1"
+
+// CanonicalTransaction returns the canonical protobuf encoding of a transaction: fields in
+// ascending field number order, fields holding their zero value omitted, submessages that are
+// set encoded even when empty, and no unknown fields. The schema has no maps, so these rules
+// give exactly one encoding for every transaction in any protobuf implementation.
+func CanonicalTransaction(transaction *v1.Transaction) ([]byte, error) {
+	return proto.MarshalOptions{Deterministic: true}.Marshal(transaction)
+}
+
+// SignBytes returns the bytes a transaction signature covers:
+//
+//	SignDomain || 0x00 || uvarint(len(chain_id)) || chain_id || CanonicalTransaction(transaction)
+//
+// The chain ID is taken from the transaction header.
+func SignBytes(transaction *v1.Transaction) ([]byte, error) {
+	if transaction.Header == nil {
+		return nil, errors.New("transaction header is nil")
+	}
+
+	txBytes, err := CanonicalTransaction(transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	chainID := transaction.Header.ChainId
+	signBytes := make([]byte, 0, len(SignDomain)+1+binary.MaxVarintLen64+len(chainID)+len(txBytes))
+	signBytes = append(signBytes, SignDomain...)
+	signBytes = append(signBytes, 0x00)
+	signBytes = binary.AppendUvarint(signBytes, uint64(len(chainID)))
+	signBytes = append(signBytes, chainID...)
+	signBytes = append(signBytes, txBytes...)
+	return signBytes, nil
+}
+
+// SignDigest returns the sha256 of the transaction's sign bytes, which is the message passed to Ed25519.
+func SignDigest(transaction *v1.Transaction) ([]byte, error) {
+	signBytes, err := SignBytes(transaction)
+	if err != nil {
+		return nil, err
+	}
+	digest := sha256.Sum256(signBytes)
+	return digest[:], nil
+}
+
+// SignTransaction signs a transaction with an Ed25519 private key over its sign digest.
+// The signed transaction carries the canonical encoding of the transaction.
 func SignTransaction(privateKey ed25519.PrivateKey, transaction *v1.Transaction) (*v1.SignedTransaction, error) {
-	txBytes, err := proto.Marshal(transaction)
+	txBytes, err := CanonicalTransaction(transaction)
 	if err != nil {
 		return nil, err
 	}
 
-	hash := sha256.Sum256(txBytes)
-	signature := ed25519.Sign(privateKey, hash[:])
+	digest, err := SignDigest(transaction)
+	if err != nil {
+		return nil, err
+	}
+	signature := ed25519.Sign(privateKey, digest)
 
 	return &v1.SignedTransaction{
 		Transaction: txBytes,
@@ -27,26 +78,73 @@ func SignTransaction(privateKey ed25519.PrivateKey, transaction *v1.Transaction)
 	}, nil
 }
 
-// VerifyTransaction verifies an Ed25519 signature over the transaction bytes and unmarshals the transaction.
-func VerifyTransaction(signedTransaction *v1.SignedTransaction) (*v1.Transaction, error) {
-	var transaction v1.Transaction
-	if err := proto.Unmarshal(signedTransaction.Transaction, &transaction); err != nil {
+// ErrNonCanonical is returned for transactions that are not in their canonical encoding.
+var ErrNonCanonical = errors.New("transaction is not canonically encoded")
+
+// DecodeSignedTransaction unmarshals a signed transaction as broadcast. Only the deterministic
+// encoding is accepted, so that a transaction has exactly one encoding and one tx hash. Unknown
+// fields are dropped when decoding, so an encoding carrying them does not match either.
+func DecodeSignedTransaction(tx []byte) (*v1.SignedTransaction, error) {
+	var signedTransaction v1.SignedTransaction
+	if err := (proto.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(tx, &signedTransaction); err != nil {
+		return nil, err
+	}
+	canonical, err := proto.MarshalOptions{Deterministic: true}.Marshal(&signedTransaction)
+	if err != nil {
 		return nil, err
 	}
+	if !bytes.Equal(canonical, tx) {
+		return nil, ErrNonCanonical
+	}
+	return &signedTransaction, nil
+}
 
-	if transaction.Header == nil {
-		return nil, errors.New("transaction header is nil")
+// VerifyTransaction unmarshals the transaction and verifies its Ed25519 signature. The signature
+// is checked against the sign digest recomputed from the decoded transaction, which covers the
+// bytes the client sent because only the canonical encoding decodes.
+func VerifyTransaction(signedTransaction *v1.SignedTransaction) (*v1.Transaction, error) {
+	transaction, err := DecodeTransaction(signedTransaction)
+	if err != nil {
+		return nil, err
 	}
 
-	if len(transaction.Header.FromPubkey) == 0 {
-		return nil, errors.New("transaction from pubkey is empty")
+	digest, err := SignDigest(transaction)
+	if err != nil {
+		return nil, err
 	}
 
 	publicKey := ed25519.PublicKey(transaction.Header.FromPubkey)
-	hash := sha256.Sum256(signedTransaction.Transaction)
-	if !ed25519.Verify(publicKey, hash[:], signedTransaction.Signature) {
+	if !ed25519.Verify(publicKey, digest, signedTransaction.Signature) {
 		return nil, errors.New("signature verification failed")
 	}
 
+	return transaction, nil
+}
+
+// DecodeTransaction unmarshals the transaction without verifying its signature. It is only
+// for transactions whose signature has already been verified. Encodings other than
+// CanonicalTransaction, such as reordered or unknown fields, are rejected, since they would
+// give one signed transaction many tx hashes.
+func DecodeTransaction(signedTransaction *v1.SignedTransaction) (*v1.Transaction, error) {
+	var transaction v1.Transaction
+	if err := (proto.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(signedTransaction.Transaction, &transaction); err != nil {
+		return nil, err
+	}
+	canonical, err := CanonicalTransaction(&transaction)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(canonical, signedTransaction.Transaction) {
+		return nil, ErrNonCanonical
+	}
+
+	if transaction.Header == nil {
+		return nil, errors.New("transaction header is nil")
+	}
+
+	if len(transaction.Header.FromPubkey) != ed25519.PublicKeySize {
+		return nil, errors.New("transaction from pubkey is not a valid ed25519 public key")
+	}
+
 	return &transaction, nil
 }
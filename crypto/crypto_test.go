@@ -0,0 +1,118 @@
+package crypto
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"testing"
+
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+type signVector struct {
+	Name           string          `json:"name"`
+	PrivateKeySeed string          `json:"private_key_seed"`
+	Transaction    json.RawMessage `json:"transaction"`
+	SignBytes      string          `json:"sign_bytes"`
+	SignDigest     string          `json:"sign_digest"`
+	Signature      string          `json:"signature"`
+}
+
+func loadSignVectors(t *testing.T) []signVector {
+	data, err := os.ReadFile("testdata/sign_vectors.json")
+	require.NoError(t, err)
+
+	var vectors []signVector
+	require.NoError(t, json.Unmarshal(data, &vectors))
+	require.NotEmpty(t, vectors)
+	return vectors
+}
+
+func decodeHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
+
+func TestSignVectors(t *testing.T) {
+	for _, vector := range loadSignVectors(t) {
+		t.Run(vector.Name, func(t *testing.T) {
+			transaction := &v1.Transaction{}
+			require.NoError(t, protojson.Unmarshal(vector.Transaction, transaction))
+			privateKey := ed25519.NewKeyFromSeed(decodeHex(t, vector.PrivateKeySeed))
+
+			signBytes, err := SignBytes(transaction)
+			require.NoError(t, err)
+			require.Equal(t, vector.SignBytes, hex.EncodeToString(signBytes))
+
+			digest, err := SignDigest(transaction)
+			require.NoError(t, err)
+			require.Equal(t, vector.SignDigest, hex.EncodeToString(digest))
+
+			signedTransaction, err := SignTransaction(privateKey, transaction)
+			require.NoError(t, err)
+			require.Equal(t, vector.Signature, hex.EncodeToString(signedTransaction.Signature))
+
+			verified, err := VerifyTransaction(signedTransaction)
+			require.NoError(t, err)
+			require.Equal(t, transaction.Header.Nonce, verified.Header.Nonce)
+		})
+	}
+}
+
+func TestVerifyTransactionRejectsNonCanonicalEncoding(t *testing.T) {
+	vector := loadSignVectors(t)[0]
+	transaction := &v1.Transaction{}
+	require.NoError(t, protojson.Unmarshal(vector.Transaction, transaction))
+
+	canonical, err := CanonicalTransaction(transaction)
+	require.NoError(t, err)
+
+	// body before header plus an unknown field is a valid but non-canonical encoding
+	var header, body []byte
+	for b := canonical; len(b) > 0; {
+		num, typ, n := protowire.ConsumeTag(b)
+		m := protowire.ConsumeFieldValue(num, typ, b[n:])
+		if num == 1 {
+			header = b[:n+m]
+		} else {
+			body = b[:n+m]
+		}
+		b = b[n+m:]
+	}
+	reordered := append(append([]byte{}, body...), header...)
+	unknownField := protowire.AppendTag(append([]byte{}, canonical...), 99, protowire.VarintType)
+	unknownField = protowire.AppendVarint(unknownField, 1)
+
+	signature := decodeHex(t, vector.Signature)
+	for _, encoding := range [][]byte{reordered, unknownField} {
+		_, err = VerifyTransaction(&v1.SignedTransaction{Transaction: encoding, Signature: signature})
+		require.ErrorIs(t, err, ErrNonCanonical)
+	}
+	signedTransaction := &v1.SignedTransaction{Transaction: canonical, Signature: signature}
+	_, err = VerifyTransaction(signedTransaction)
+	require.NoError(t, err)
+
+	// the signed transaction as broadcast has one encoding too
+	tx, err := proto.Marshal(signedTransaction)
+	require.NoError(t, err)
+	_, err = DecodeSignedTransaction(tx)
+	require.NoError(t, err)
+	tx = protowire.AppendTag(tx, 99, protowire.VarintType)
+	tx = protowire.AppendVarint(tx, 1)
+	_, err = DecodeSignedTransaction(tx)
+	require.ErrorIs(t, err, ErrNonCanonical)
+
+	// the same signature does not cover the transaction on another chain
+	transaction.Header.ChainId = "mojave-dev-1"
+	otherChain, err := CanonicalTransaction(transaction)
+	require.NoError(t, err)
+	signedTransaction.Transaction = otherChain
+	_, err = VerifyTransaction(signedTransaction)
+	require.ErrorContains(t, err, "signature verification failed")
+}
@@ -21,6 +21,7 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// AccountCreatedEvent is emitted when an account is first written.
 type AccountCreatedEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Pubkey        []byte                 `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
@@ -66,9 +67,12 @@ func (x *AccountCreatedEvent) GetPubkey() []byte {
 }
 
 type AccountState struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pubkey        []byte                 `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
-	Balance       uint64                 `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Pubkey  []byte                 `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Balance uint64                 `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	// nonce is the sequence number of the last transaction executed by this
+	// account. The next transaction must carry nonce + 1.
+	Nonce         uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -117,6 +121,13 @@ func (x *AccountState) GetBalance() uint64 {
 	return 0
 }
 
+func (x *AccountState) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
 type AccountStateQuery struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Pubkey        []byte                 `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
@@ -167,10 +178,11 @@ const file_mojave_v1_account_proto_rawDesc = "" +
 	"\n" +
 	"\x17mojave/v1/account.proto\x12\tmojave.v1\"-\n" +
 	"\x13AccountCreatedEvent\x12\x16\n" +
-	"\x06pubkey\x18\x01 \x01(\fR\x06pubkey\"@\n" +
+	"\x06pubkey\x18\x01 \x01(\fR\x06pubkey\"V\n" +
 	"\fAccountState\x12\x16\n" +
 	"\x06pubkey\x18\x01 \x01(\fR\x06pubkey\x12\x18\n" +
-	"\abalance\x18\x02 \x01(\x04R\abalance\"+\n" +
+	"\abalance\x18\x02 \x01(\x04R\abalance\x12\x14\n" +
+	"\x05nonce\x18\x03 \x01(\x04R\x05nonce\"+\n" +
 	"\x11AccountStateQuery\x12\x16\n" +
 	"\x06pubkey\x18\x01 \x01(\fR\x06pubkeyB+Z)github.com/alecsavvy/mojave/gen/mojave/v1b\x06proto3"
 
@@ -0,0 +1,529 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: mojave/v1/content.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ContentRegistrationTransaction registers a content item by the sha256 hash
+// of its bytes, so validators start attesting to whether they can serve it.
+type ContentRegistrationTransaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentHash   []byte                 `protobuf:"bytes,1,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContentRegistrationTransaction) Reset() {
+	*x = ContentRegistrationTransaction{}
+	mi := &file_mojave_v1_content_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContentRegistrationTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentRegistrationTransaction) ProtoMessage() {}
+
+func (x *ContentRegistrationTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_content_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentRegistrationTransaction.ProtoReflect.Descriptor instead.
+func (*ContentRegistrationTransaction) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_content_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ContentRegistrationTransaction) GetContentHash() []byte {
+	if x != nil {
+		return x.ContentHash
+	}
+	return nil
+}
+
+type ContentRegistrationResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContentRegistrationResult) Reset() {
+	*x = ContentRegistrationResult{}
+	mi := &file_mojave_v1_content_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContentRegistrationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentRegistrationResult) ProtoMessage() {}
+
+func (x *ContentRegistrationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_content_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentRegistrationResult.ProtoReflect.Descriptor instead.
+func (*ContentRegistrationResult) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_content_proto_rawDescGZIP(), []int{1}
+}
+
+type ContentState struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ContentHash []byte                 `protobuf:"bytes,1,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	// owner_pubkey is the account that registered the content.
+	OwnerPubkey      []byte `protobuf:"bytes,2,opt,name=owner_pubkey,json=ownerPubkey,proto3" json:"owner_pubkey,omitempty"`
+	RegisteredHeight uint64 `protobuf:"varint,3,opt,name=registered_height,json=registeredHeight,proto3" json:"registered_height,omitempty"`
+	// availability_bps is the share, in basis points, of the voting power whose
+	// validators last attested to being able to serve the content.
+	AvailabilityBps uint32 `protobuf:"varint,4,opt,name=availability_bps,json=availabilityBps,proto3" json:"availability_bps,omitempty"`
+	// availability_height is the height of the votes that last changed
+	// availability_bps. It is recomputed from the votes of every height.
+	AvailabilityHeight uint64 `protobuf:"varint,5,opt,name=availability_height,json=availabilityHeight,proto3" json:"availability_height,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ContentState) Reset() {
+	*x = ContentState{}
+	mi := &file_mojave_v1_content_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContentState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentState) ProtoMessage() {}
+
+func (x *ContentState) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_content_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentState.ProtoReflect.Descriptor instead.
+func (*ContentState) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_content_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ContentState) GetContentHash() []byte {
+	if x != nil {
+		return x.ContentHash
+	}
+	return nil
+}
+
+func (x *ContentState) GetOwnerPubkey() []byte {
+	if x != nil {
+		return x.OwnerPubkey
+	}
+	return nil
+}
+
+func (x *ContentState) GetRegisteredHeight() uint64 {
+	if x != nil {
+		return x.RegisteredHeight
+	}
+	return 0
+}
+
+func (x *ContentState) GetAvailabilityBps() uint32 {
+	if x != nil {
+		return x.AvailabilityBps
+	}
+	return 0
+}
+
+func (x *ContentState) GetAvailabilityHeight() uint64 {
+	if x != nil {
+		return x.AvailabilityHeight
+	}
+	return 0
+}
+
+type ContentQuery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentHash   []byte                 `protobuf:"bytes,1,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContentQuery) Reset() {
+	*x = ContentQuery{}
+	mi := &file_mojave_v1_content_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContentQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentQuery) ProtoMessage() {}
+
+func (x *ContentQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_content_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentQuery.ProtoReflect.Descriptor instead.
+func (*ContentQuery) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_content_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ContentQuery) GetContentHash() []byte {
+	if x != nil {
+		return x.ContentHash
+	}
+	return nil
+}
+
+// AvailabilityAttestation is the vote extension in which a validator attests
+// to the registered content it can currently serve.
+type AvailabilityAttestation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentHashes [][]byte               `protobuf:"bytes,1,rep,name=content_hashes,json=contentHashes,proto3" json:"content_hashes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AvailabilityAttestation) Reset() {
+	*x = AvailabilityAttestation{}
+	mi := &file_mojave_v1_content_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvailabilityAttestation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailabilityAttestation) ProtoMessage() {}
+
+func (x *AvailabilityAttestation) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_content_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailabilityAttestation.ProtoReflect.Descriptor instead.
+func (*AvailabilityAttestation) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_content_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AvailabilityAttestation) GetContentHashes() [][]byte {
+	if x != nil {
+		return x.ContentHashes
+	}
+	return nil
+}
+
+// SignedAttestation is a validator's vote extension as it appears in the
+// commit, signed by CometBFT with the validator's consensus key.
+type SignedAttestation struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ValidatorAddress []byte                 `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// extension is a marshaled AvailabilityAttestation.
+	Extension          []byte `protobuf:"bytes,2,opt,name=extension,proto3" json:"extension,omitempty"`
+	ExtensionSignature []byte `protobuf:"bytes,3,opt,name=extension_signature,json=extensionSignature,proto3" json:"extension_signature,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SignedAttestation) Reset() {
+	*x = SignedAttestation{}
+	mi := &file_mojave_v1_content_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignedAttestation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignedAttestation) ProtoMessage() {}
+
+func (x *SignedAttestation) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_content_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignedAttestation.ProtoReflect.Descriptor instead.
+func (*SignedAttestation) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_content_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SignedAttestation) GetValidatorAddress() []byte {
+	if x != nil {
+		return x.ValidatorAddress
+	}
+	return nil
+}
+
+func (x *SignedAttestation) GetExtension() []byte {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+func (x *SignedAttestation) GetExtensionSignature() []byte {
+	if x != nil {
+		return x.ExtensionSignature
+	}
+	return nil
+}
+
+// AvailabilityTransaction is added by a proposer to the start of its block. It
+// carries the attestations of the precommits for the previous height.
+type AvailabilityTransaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Height        int64                  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round         int32                  `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Attestations  []*SignedAttestation   `protobuf:"bytes,3,rep,name=attestations,proto3" json:"attestations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AvailabilityTransaction) Reset() {
+	*x = AvailabilityTransaction{}
+	mi := &file_mojave_v1_content_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvailabilityTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailabilityTransaction) ProtoMessage() {}
+
+func (x *AvailabilityTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_content_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailabilityTransaction.ProtoReflect.Descriptor instead.
+func (*AvailabilityTransaction) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_content_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AvailabilityTransaction) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *AvailabilityTransaction) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *AvailabilityTransaction) GetAttestations() []*SignedAttestation {
+	if x != nil {
+		return x.Attestations
+	}
+	return nil
+}
+
+type AvailabilityResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attestations  uint32                 `protobuf:"varint,1,opt,name=attestations,proto3" json:"attestations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AvailabilityResult) Reset() {
+	*x = AvailabilityResult{}
+	mi := &file_mojave_v1_content_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvailabilityResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailabilityResult) ProtoMessage() {}
+
+func (x *AvailabilityResult) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_content_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailabilityResult.ProtoReflect.Descriptor instead.
+func (*AvailabilityResult) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_content_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AvailabilityResult) GetAttestations() uint32 {
+	if x != nil {
+		return x.Attestations
+	}
+	return 0
+}
+
+var File_mojave_v1_content_proto protoreflect.FileDescriptor
+
+const file_mojave_v1_content_proto_rawDesc = "" +
+	"\n" +
+	"\x17mojave/v1/content.proto\x12\tmojave.v1\"C\n" +
+	"\x1eContentRegistrationTransaction\x12!\n" +
+	"\fcontent_hash\x18\x01 \x01(\fR\vcontentHash\"\x1b\n" +
+	"\x19ContentRegistrationResult\"\xdd\x01\n" +
+	"\fContentState\x12!\n" +
+	"\fcontent_hash\x18\x01 \x01(\fR\vcontentHash\x12!\n" +
+	"\fowner_pubkey\x18\x02 \x01(\fR\vownerPubkey\x12+\n" +
+	"\x11registered_height\x18\x03 \x01(\x04R\x10registeredHeight\x12)\n" +
+	"\x10availability_bps\x18\x04 \x01(\rR\x0favailabilityBps\x12/\n" +
+	"\x13availability_height\x18\x05 \x01(\x04R\x12availabilityHeight\"1\n" +
+	"\fContentQuery\x12!\n" +
+	"\fcontent_hash\x18\x01 \x01(\fR\vcontentHash\"@\n" +
+	"\x17AvailabilityAttestation\x12%\n" +
+	"\x0econtent_hashes\x18\x01 \x03(\fR\rcontentHashes\"\x8f\x01\n" +
+	"\x11SignedAttestation\x12+\n" +
+	"\x11validator_address\x18\x01 \x01(\fR\x10validatorAddress\x12\x1c\n" +
+	"\textension\x18\x02 \x01(\fR\textension\x12/\n" +
+	"\x13extension_signature\x18\x03 \x01(\fR\x12extensionSignature\"\x89\x01\n" +
+	"\x17AvailabilityTransaction\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x03R\x06height\x12\x14\n" +
+	"\x05round\x18\x02 \x01(\x05R\x05round\x12@\n" +
+	"\fattestations\x18\x03 \x03(\v2\x1c.mojave.v1.SignedAttestationR\fattestations\"8\n" +
+	"\x12AvailabilityResult\x12\"\n" +
+	"\fattestations\x18\x01 \x01(\rR\fattestationsB+Z)github.com/alecsavvy/mojave/gen/mojave/v1b\x06proto3"
+
+var (
+	file_mojave_v1_content_proto_rawDescOnce sync.Once
+	file_mojave_v1_content_proto_rawDescData []byte
+)
+
+func file_mojave_v1_content_proto_rawDescGZIP() []byte {
+	file_mojave_v1_content_proto_rawDescOnce.Do(func() {
+		file_mojave_v1_content_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_mojave_v1_content_proto_rawDesc), len(file_mojave_v1_content_proto_rawDesc)))
+	})
+	return file_mojave_v1_content_proto_rawDescData
+}
+
+var file_mojave_v1_content_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_mojave_v1_content_proto_goTypes = []any{
+	(*ContentRegistrationTransaction)(nil), // 0: mojave.v1.ContentRegistrationTransaction
+	(*ContentRegistrationResult)(nil),      // 1: mojave.v1.ContentRegistrationResult
+	(*ContentState)(nil),                   // 2: mojave.v1.ContentState
+	(*ContentQuery)(nil),                   // 3: mojave.v1.ContentQuery
+	(*AvailabilityAttestation)(nil),        // 4: mojave.v1.AvailabilityAttestation
+	(*SignedAttestation)(nil),              // 5: mojave.v1.SignedAttestation
+	(*AvailabilityTransaction)(nil),        // 6: mojave.v1.AvailabilityTransaction
+	(*AvailabilityResult)(nil),             // 7: mojave.v1.AvailabilityResult
+}
+var file_mojave_v1_content_proto_depIdxs = []int32{
+	5, // 0: mojave.v1.AvailabilityTransaction.attestations:type_name -> mojave.v1.SignedAttestation
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_mojave_v1_content_proto_init() }
+func file_mojave_v1_content_proto_init() {
+	if File_mojave_v1_content_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mojave_v1_content_proto_rawDesc), len(file_mojave_v1_content_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mojave_v1_content_proto_goTypes,
+		DependencyIndexes: file_mojave_v1_content_proto_depIdxs,
+		MessageInfos:      file_mojave_v1_content_proto_msgTypes,
+	}.Build()
+	File_mojave_v1_content_proto = out.File
+	file_mojave_v1_content_proto_goTypes = nil
+	file_mojave_v1_content_proto_depIdxs = nil
+}
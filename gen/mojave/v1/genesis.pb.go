@@ -0,0 +1,355 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: mojave/v1/genesis.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GenesisState is the app_state of the genesis file, encoded as protojson.
+type GenesisState struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// validator_admin_pubkey is the key that admits validators to the set and removes them.
+	// Without one any account can become a validator by bonding.
+	ValidatorAdminPubkey []byte `protobuf:"bytes,1,opt,name=validator_admin_pubkey,json=validatorAdminPubkey,proto3" json:"validator_admin_pubkey,omitempty"`
+	// staking_params default to DefaultStakingParams when unset.
+	StakingParams *StakingParams `protobuf:"bytes,2,opt,name=staking_params,json=stakingParams,proto3" json:"staking_params,omitempty"`
+	// validators are bonded at genesis with tokens minted for them, and make up the initial
+	// validator set. When there are none the validators of the genesis file are bonded
+	// with the tokens their power requires.
+	Validators []*GenesisValidator `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators,omitempty"`
+	// slashing_params default to DefaultSlashingParams when unset.
+	SlashingParams *SlashingParams `protobuf:"bytes,4,opt,name=slashing_params,json=slashingParams,proto3" json:"slashing_params,omitempty"`
+	// mint_params default to DefaultMintParams when unset.
+	MintParams *MintParams `protobuf:"bytes,5,opt,name=mint_params,json=mintParams,proto3" json:"mint_params,omitempty"`
+	// accounts are the balances at genesis. Together with the tokens bonded to the genesis
+	// validators they make up the initial supply.
+	Accounts []*GenesisAccount `protobuf:"bytes,6,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// key_values are set in the key value store at genesis.
+	KeyValues []*KeyValueState `protobuf:"bytes,7,rep,name=key_values,json=keyValues,proto3" json:"key_values,omitempty"`
+	// params default to DefaultParams when unset.
+	Params *Params `protobuf:"bytes,8,opt,name=params,proto3" json:"params,omitempty"`
+	// params_admin_pubkey is the key that can change params. Without one params never change.
+	ParamsAdminPubkey []byte `protobuf:"bytes,9,opt,name=params_admin_pubkey,json=paramsAdminPubkey,proto3" json:"params_admin_pubkey,omitempty"`
+	// gov_params default to DefaultGovParams when unset.
+	GovParams     *GovParams `protobuf:"bytes,10,opt,name=gov_params,json=govParams,proto3" json:"gov_params,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenesisState) Reset() {
+	*x = GenesisState{}
+	mi := &file_mojave_v1_genesis_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenesisState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisState) ProtoMessage() {}
+
+func (x *GenesisState) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_genesis_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenesisState.ProtoReflect.Descriptor instead.
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_genesis_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GenesisState) GetValidatorAdminPubkey() []byte {
+	if x != nil {
+		return x.ValidatorAdminPubkey
+	}
+	return nil
+}
+
+func (x *GenesisState) GetStakingParams() *StakingParams {
+	if x != nil {
+		return x.StakingParams
+	}
+	return nil
+}
+
+func (x *GenesisState) GetValidators() []*GenesisValidator {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+func (x *GenesisState) GetSlashingParams() *SlashingParams {
+	if x != nil {
+		return x.SlashingParams
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMintParams() *MintParams {
+	if x != nil {
+		return x.MintParams
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAccounts() []*GenesisAccount {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *GenesisState) GetKeyValues() []*KeyValueState {
+	if x != nil {
+		return x.KeyValues
+	}
+	return nil
+}
+
+func (x *GenesisState) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *GenesisState) GetParamsAdminPubkey() []byte {
+	if x != nil {
+		return x.ParamsAdminPubkey
+	}
+	return nil
+}
+
+func (x *GenesisState) GetGovParams() *GovParams {
+	if x != nil {
+		return x.GovParams
+	}
+	return nil
+}
+
+type GenesisAccount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pubkey        []byte                 `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Balance       uint64                 `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenesisAccount) Reset() {
+	*x = GenesisAccount{}
+	mi := &file_mojave_v1_genesis_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenesisAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisAccount) ProtoMessage() {}
+
+func (x *GenesisAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_genesis_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenesisAccount.ProtoReflect.Descriptor instead.
+func (*GenesisAccount) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_genesis_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GenesisAccount) GetPubkey() []byte {
+	if x != nil {
+		return x.Pubkey
+	}
+	return nil
+}
+
+func (x *GenesisAccount) GetBalance() uint64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+type GenesisValidator struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pubkey        []byte                 `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Bonded        uint64                 `protobuf:"varint,2,opt,name=bonded,proto3" json:"bonded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenesisValidator) Reset() {
+	*x = GenesisValidator{}
+	mi := &file_mojave_v1_genesis_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenesisValidator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisValidator) ProtoMessage() {}
+
+func (x *GenesisValidator) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_genesis_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenesisValidator.ProtoReflect.Descriptor instead.
+func (*GenesisValidator) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_genesis_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GenesisValidator) GetPubkey() []byte {
+	if x != nil {
+		return x.Pubkey
+	}
+	return nil
+}
+
+func (x *GenesisValidator) GetBonded() uint64 {
+	if x != nil {
+		return x.Bonded
+	}
+	return 0
+}
+
+var File_mojave_v1_genesis_proto protoreflect.FileDescriptor
+
+const file_mojave_v1_genesis_proto_rawDesc = "" +
+	"\n" +
+	"\x17mojave/v1/genesis.proto\x12\tmojave.v1\x1a\x12mojave/v1/kv.proto\x1a\x14mojave/v1/mint.proto\x1a\x16mojave/v1/params.proto\x1a\x18mojave/v1/slashing.proto\x1a\x17mojave/v1/staking.proto\"\xbe\x04\n" +
+	"\fGenesisState\x124\n" +
+	"\x16validator_admin_pubkey\x18\x01 \x01(\fR\x14validatorAdminPubkey\x12?\n" +
+	"\x0estaking_params\x18\x02 \x01(\v2\x18.mojave.v1.StakingParamsR\rstakingParams\x12;\n" +
+	"\n" +
+	"validators\x18\x03 \x03(\v2\x1b.mojave.v1.GenesisValidatorR\n" +
+	"validators\x12B\n" +
+	"\x0fslashing_params\x18\x04 \x01(\v2\x19.mojave.v1.SlashingParamsR\x0eslashingParams\x126\n" +
+	"\vmint_params\x18\x05 \x01(\v2\x15.mojave.v1.MintParamsR\n" +
+	"mintParams\x125\n" +
+	"\baccounts\x18\x06 \x03(\v2\x19.mojave.v1.GenesisAccountR\baccounts\x127\n" +
+	"\n" +
+	"key_values\x18\a \x03(\v2\x18.mojave.v1.KeyValueStateR\tkeyValues\x12)\n" +
+	"\x06params\x18\b \x01(\v2\x11.mojave.v1.ParamsR\x06params\x12.\n" +
+	"\x13params_admin_pubkey\x18\t \x01(\fR\x11paramsAdminPubkey\x123\n" +
+	"\n" +
+	"gov_params\x18\n" +
+	" \x01(\v2\x14.mojave.v1.GovParamsR\tgovParams\"B\n" +
+	"\x0eGenesisAccount\x12\x16\n" +
+	"\x06pubkey\x18\x01 \x01(\fR\x06pubkey\x12\x18\n" +
+	"\abalance\x18\x02 \x01(\x04R\abalance\"B\n" +
+	"\x10GenesisValidator\x12\x16\n" +
+	"\x06pubkey\x18\x01 \x01(\fR\x06pubkey\x12\x16\n" +
+	"\x06bonded\x18\x02 \x01(\x04R\x06bondedB+Z)github.com/alecsavvy/mojave/gen/mojave/v1b\x06proto3"
+
+var (
+	file_mojave_v1_genesis_proto_rawDescOnce sync.Once
+	file_mojave_v1_genesis_proto_rawDescData []byte
+)
+
+func file_mojave_v1_genesis_proto_rawDescGZIP() []byte {
+	file_mojave_v1_genesis_proto_rawDescOnce.Do(func() {
+		file_mojave_v1_genesis_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_mojave_v1_genesis_proto_rawDesc), len(file_mojave_v1_genesis_proto_rawDesc)))
+	})
+	return file_mojave_v1_genesis_proto_rawDescData
+}
+
+var file_mojave_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_mojave_v1_genesis_proto_goTypes = []any{
+	(*GenesisState)(nil),     // 0: mojave.v1.GenesisState
+	(*GenesisAccount)(nil),   // 1: mojave.v1.GenesisAccount
+	(*GenesisValidator)(nil), // 2: mojave.v1.GenesisValidator
+	(*StakingParams)(nil),    // 3: mojave.v1.StakingParams
+	(*SlashingParams)(nil),   // 4: mojave.v1.SlashingParams
+	(*MintParams)(nil),       // 5: mojave.v1.MintParams
+	(*KeyValueState)(nil),    // 6: mojave.v1.KeyValueState
+	(*Params)(nil),           // 7: mojave.v1.Params
+	(*GovParams)(nil),        // 8: mojave.v1.GovParams
+}
+var file_mojave_v1_genesis_proto_depIdxs = []int32{
+	3, // 0: mojave.v1.GenesisState.staking_params:type_name -> mojave.v1.StakingParams
+	2, // 1: mojave.v1.GenesisState.validators:type_name -> mojave.v1.GenesisValidator
+	4, // 2: mojave.v1.GenesisState.slashing_params:type_name -> mojave.v1.SlashingParams
+	5, // 3: mojave.v1.GenesisState.mint_params:type_name -> mojave.v1.MintParams
+	1, // 4: mojave.v1.GenesisState.accounts:type_name -> mojave.v1.GenesisAccount
+	6, // 5: mojave.v1.GenesisState.key_values:type_name -> mojave.v1.KeyValueState
+	7, // 6: mojave.v1.GenesisState.params:type_name -> mojave.v1.Params
+	8, // 7: mojave.v1.GenesisState.gov_params:type_name -> mojave.v1.GovParams
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_mojave_v1_genesis_proto_init() }
+func file_mojave_v1_genesis_proto_init() {
+	if File_mojave_v1_genesis_proto != nil {
+		return
+	}
+	file_mojave_v1_kv_proto_init()
+	file_mojave_v1_mint_proto_init()
+	file_mojave_v1_params_proto_init()
+	file_mojave_v1_slashing_proto_init()
+	file_mojave_v1_staking_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mojave_v1_genesis_proto_rawDesc), len(file_mojave_v1_genesis_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mojave_v1_genesis_proto_goTypes,
+		DependencyIndexes: file_mojave_v1_genesis_proto_depIdxs,
+		MessageInfos:      file_mojave_v1_genesis_proto_msgTypes,
+	}.Build()
+	File_mojave_v1_genesis_proto = out.File
+	file_mojave_v1_genesis_proto_goTypes = nil
+	file_mojave_v1_genesis_proto_depIdxs = nil
+}
@@ -0,0 +1,1535 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: mojave/v1/gov.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ProposalStatus int32
+
+const (
+	ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED    ProposalStatus = 0
+	ProposalStatus_PROPOSAL_STATUS_DEPOSIT_PERIOD ProposalStatus = 1
+	ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD  ProposalStatus = 2
+	ProposalStatus_PROPOSAL_STATUS_PASSED         ProposalStatus = 3
+	ProposalStatus_PROPOSAL_STATUS_REJECTED       ProposalStatus = 4
+	// PROPOSAL_STATUS_FAILED is a proposal that passed but whose content could not be executed.
+	ProposalStatus_PROPOSAL_STATUS_FAILED ProposalStatus = 5
+	// PROPOSAL_STATUS_EXPIRED is a proposal that did not reach the min deposit in time.
+	ProposalStatus_PROPOSAL_STATUS_EXPIRED ProposalStatus = 6
+)
+
+// Enum value maps for ProposalStatus.
+var (
+	ProposalStatus_name = map[int32]string{
+		0: "PROPOSAL_STATUS_UNSPECIFIED",
+		1: "PROPOSAL_STATUS_DEPOSIT_PERIOD",
+		2: "PROPOSAL_STATUS_VOTING_PERIOD",
+		3: "PROPOSAL_STATUS_PASSED",
+		4: "PROPOSAL_STATUS_REJECTED",
+		5: "PROPOSAL_STATUS_FAILED",
+		6: "PROPOSAL_STATUS_EXPIRED",
+	}
+	ProposalStatus_value = map[string]int32{
+		"PROPOSAL_STATUS_UNSPECIFIED":    0,
+		"PROPOSAL_STATUS_DEPOSIT_PERIOD": 1,
+		"PROPOSAL_STATUS_VOTING_PERIOD":  2,
+		"PROPOSAL_STATUS_PASSED":         3,
+		"PROPOSAL_STATUS_REJECTED":       4,
+		"PROPOSAL_STATUS_FAILED":         5,
+		"PROPOSAL_STATUS_EXPIRED":        6,
+	}
+)
+
+func (x ProposalStatus) Enum() *ProposalStatus {
+	p := new(ProposalStatus)
+	*p = x
+	return p
+}
+
+func (x ProposalStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProposalStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_mojave_v1_gov_proto_enumTypes[0].Descriptor()
+}
+
+func (ProposalStatus) Type() protoreflect.EnumType {
+	return &file_mojave_v1_gov_proto_enumTypes[0]
+}
+
+func (x ProposalStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProposalStatus.Descriptor instead.
+func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
+	return file_mojave_v1_gov_proto_rawDescGZIP(), []int{0}
+}
+
+type VoteOption int32
+
+const (
+	VoteOption_VOTE_OPTION_UNSPECIFIED VoteOption = 0
+	VoteOption_VOTE_OPTION_YES         VoteOption = 1
+	VoteOption_VOTE_OPTION_NO          VoteOption = 2
+	VoteOption_VOTE_OPTION_ABSTAIN     VoteOption = 3
+)
+
+// Enum value maps for VoteOption.
+var (
+	VoteOption_name = map[int32]string{
+		0: "VOTE_OPTION_UNSPECIFIED",
+		1: "VOTE_OPTION_YES",
+		2: "VOTE_OPTION_NO",
+		3: "VOTE_OPTION_ABSTAIN",
+	}
+	VoteOption_value = map[string]int32{
+		"VOTE_OPTION_UNSPECIFIED": 0,
+		"VOTE_OPTION_YES":         1,
+		"VOTE_OPTION_NO":          2,
+		"VOTE_OPTION_ABSTAIN":     3,
+	}
+)
+
+func (x VoteOption) Enum() *VoteOption {
+	p := new(VoteOption)
+	*p = x
+	return p
+}
+
+func (x VoteOption) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VoteOption) Descriptor() protoreflect.EnumDescriptor {
+	return file_mojave_v1_gov_proto_enumTypes[1].Descriptor()
+}
+
+func (VoteOption) Type() protoreflect.EnumType {
+	return &file_mojave_v1_gov_proto_enumTypes[1]
+}
+
+func (x VoteOption) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VoteOption.Descriptor instead.
+func (VoteOption) EnumDescriptor() ([]byte, []int) {
+	return file_mojave_v1_gov_proto_rawDescGZIP(), []int{1}
+}
+
+// CommunityPoolSpend pays tokens from the community pool to an account.
+type CommunityPoolSpend struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RecipientPubkey []byte                 `protobuf:"bytes,1,opt,name=recipient_pubkey,json=recipientPubkey,proto3" json:"recipient_pubkey,omitempty"`
+	Amount          uint64                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CommunityPoolSpend) Reset() {
+	*x = CommunityPoolSpend{}
+	mi := &file_mojave_v1_gov_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommunityPoolSpend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommunityPoolSpend) ProtoMessage() {}
+
+func (x *CommunityPoolSpend) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_gov_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommunityPoolSpend.ProtoReflect.Descriptor instead.
+func (*CommunityPoolSpend) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_gov_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CommunityPoolSpend) GetRecipientPubkey() []byte {
+	if x != nil {
+		return x.RecipientPubkey
+	}
+	return nil
+}
+
+func (x *CommunityPoolSpend) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// UpgradePlan schedules a software upgrade. The chain halts at height until a binary that
+// knows the upgrade named name takes over.
+type UpgradePlan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Height        uint64                 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpgradePlan) Reset() {
+	*x = UpgradePlan{}
+	mi := &file_mojave_v1_gov_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpgradePlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradePlan) ProtoMessage() {}
+
+func (x *UpgradePlan) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_gov_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradePlan.ProtoReflect.Descriptor instead.
+func (*UpgradePlan) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_gov_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpgradePlan) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpgradePlan) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// ProposalContent is what a proposal does once it passes.
+type ProposalContent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Content:
+	//
+	//	*ProposalContent_UpdateParams
+	//	*ProposalContent_CommunityPoolSpend
+	//	*ProposalContent_Upgrade
+	Content       isProposalContent_Content `protobuf_oneof:"content"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProposalContent) Reset() {
+	*x = ProposalContent{}
+	mi := &file_mojave_v1_gov_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposalContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposalContent) ProtoMessage() {}
+
+func (x *ProposalContent) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_gov_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposalContent.ProtoReflect.Descriptor instead.
+func (*ProposalContent) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_gov_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProposalContent) GetContent() isProposalContent_Content {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ProposalContent) GetUpdateParams() *UpdateParamsTransaction {
+	if x != nil {
+		if x, ok := x.Content.(*ProposalContent_UpdateParams); ok {
+			return x.UpdateParams
+		}
+	}
+	return nil
+}
+
+func (x *ProposalContent) GetCommunityPoolSpend() *CommunityPoolSpend {
+	if x != nil {
+		if x, ok := x.Content.(*ProposalContent_CommunityPoolSpend); ok {
+			return x.CommunityPoolSpend
+		}
+	}
+	return nil
+}
+
+func (x *ProposalContent) GetUpgrade() *UpgradePlan {
+	if x != nil {
+		if x, ok := x.Content.(*ProposalContent_Upgrade); ok {
+			return x.Upgrade
+		}
+	}
+	return nil
+}
+
+type isProposalContent_Content interface {
+	isProposalContent_Content()
+}
+
+type ProposalContent_UpdateParams struct {
+	UpdateParams *UpdateParamsTransaction `protobuf:"bytes,1,opt,name=update_params,json=updateParams,proto3,oneof"`
+}
+
+type ProposalContent_CommunityPoolSpend struct {
+	CommunityPoolSpend *CommunityPoolSpend `protobuf:"bytes,2,opt,name=community_pool_spend,json=communityPoolSpend,proto3,oneof"`
+}
+
+type ProposalContent_Upgrade struct {
+	Upgrade *UpgradePlan `protobuf:"bytes,3,opt,name=upgrade,proto3,oneof"`
+}
+
+func (*ProposalContent_UpdateParams) isProposalContent_Content() {}
+
+func (*ProposalContent_CommunityPoolSpend) isProposalContent_Content() {}
+
+func (*ProposalContent_Upgrade) isProposalContent_Content() {}
+
+// TallyResult is the bonded tokens behind each vote option when voting ended.
+type TallyResult struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Yes     uint64                 `protobuf:"varint,1,opt,name=yes,proto3" json:"yes,omitempty"`
+	No      uint64                 `protobuf:"varint,2,opt,name=no,proto3" json:"no,omitempty"`
+	Abstain uint64                 `protobuf:"varint,3,opt,name=abstain,proto3" json:"abstain,omitempty"`
+	// total_bonded is the tokens bonded to the active validators, which the quorum is a share of.
+	TotalBonded   uint64 `protobuf:"varint,4,opt,name=total_bonded,json=totalBonded,proto3" json:"total_bonded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TallyResult) Reset() {
+	*x = TallyResult{}
+	mi := &file_mojave_v1_gov_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TallyResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TallyResult) ProtoMessage() {}
+
+func (x *TallyResult) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_gov_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TallyResult.ProtoReflect.Descriptor instead.
+func (*TallyResult) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_gov_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TallyResult) GetYes() uint64 {
+	if x != nil {
+		return x.Yes
+	}
+	return 0
+}
+
+func (x *TallyResult) GetNo() uint64 {
+	if x != nil {
+		return x.No
+	}
+	return 0
+}
+
+func (x *TallyResult) GetAbstain() uint64 {
+	if x != nil {
+		return x.Abstain
+	}
+	return 0
+}
+
+func (x *TallyResult) GetTotalBonded() uint64 {
+	if x != nil {
+		return x.TotalBonded
+	}
+	return 0
+}
+
+type Proposal struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProposerPubkey   []byte                 `protobuf:"bytes,2,opt,name=proposer_pubkey,json=proposerPubkey,proto3" json:"proposer_pubkey,omitempty"`
+	Title            string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Content          *ProposalContent       `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Status           ProposalStatus         `protobuf:"varint,5,opt,name=status,proto3,enum=mojave.v1.ProposalStatus" json:"status,omitempty"`
+	SubmitHeight     uint64                 `protobuf:"varint,6,opt,name=submit_height,json=submitHeight,proto3" json:"submit_height,omitempty"`
+	DepositEndHeight uint64                 `protobuf:"varint,7,opt,name=deposit_end_height,json=depositEndHeight,proto3" json:"deposit_end_height,omitempty"`
+	// voting_end_height is set once the proposal reaches the min deposit.
+	VotingEndHeight uint64 `protobuf:"varint,8,opt,name=voting_end_height,json=votingEndHeight,proto3" json:"voting_end_height,omitempty"`
+	TotalDeposit    uint64 `protobuf:"varint,9,opt,name=total_deposit,json=totalDeposit,proto3" json:"total_deposit,omitempty"`
+	// tally is set once voting ends.
+	Tally *TallyResult `protobuf:"bytes,10,opt,name=tally,proto3" json:"tally,omitempty"`
+	// failure is why a passed proposal could not be executed.
+	Failure       string `protobuf:"bytes,11,opt,name=failure,proto3" json:"failure,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Proposal) Reset() {
+	*x = Proposal{}
+	mi := &file_mojave_v1_gov_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Proposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Proposal) ProtoMessage() {}
+
+func (x *Proposal) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_gov_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Proposal.ProtoReflect.Descriptor instead.
+func (*Proposal) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_gov_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Proposal) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Proposal) GetProposerPubkey() []byte {
+	if x != nil {
+		return x.ProposerPubkey
+	}
+	return nil
+}
+
+func (x *Proposal) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Proposal) GetContent() *ProposalContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *Proposal) GetStatus() ProposalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
+}
+
+func (x *Proposal) GetSubmitHeight() uint64 {
+	if x != nil {
+		return x.SubmitHeight
+	}
+	return 0
+}
+
+func (x *Proposal) GetDepositEndHeight() uint64 {
+	if x != nil {
+		return x.DepositEndHeight
+	}
+	return 0
+}
+
+func (x *Proposal) GetVotingEndHeight() uint64 {
+	if x != nil {
+		return x.VotingEndHeight
+	}
+	return 0
+}
+
+func (x *Proposal) GetTotalDeposit() uint64 {
+	if x != nil {
+		return x.TotalDeposit
+	}
+	return 0
+}
+
+func (x *Proposal) GetTally() *TallyResult {
+	if x != nil {
+		return x.Tally
+	}
+	return nil
+}
+
+func (x *Proposal) GetFailure() string {
+	if x != nil {
+		return x.Failure
+	}
+	return ""
+}
+
+// Deposit is what one depositor has deposited on a proposal.
+type Deposit struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DepositorPubkey []byte                 `protobuf:"bytes,1,opt,name=depositor_pubkey,json=depositorPubkey,proto3" json:"depositor_pubkey,omitempty"`
+	Amount          uint64                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	ProposalId      uint64                 `protobuf:"varint,3,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Deposit) Reset() {
+	*x = Deposit{}
+	mi := &file_mojave_v1_gov_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Deposit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deposit) ProtoMessage() {}
+
+func (x *Deposit) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_gov_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Deposit.ProtoReflect.Descriptor instead.
+func (*Deposit) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_gov_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Deposit) GetDepositorPubkey() []byte {
+	if x != nil {
+		return x.DepositorPubkey
+	}
+	return nil
+}
+
+func (x *Deposit) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Deposit) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+// Vote is one voter's vote on a proposal.
+type Vote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VoterPubkey   []byte                 `protobuf:"bytes,1,opt,name=voter_pubkey,json=voterPubkey,proto3" json:"voter_pubkey,omitempty"`
+	Option        VoteOption             `protobuf:"varint,2,opt,name=option,proto3,enum=mojave.v1.VoteOption" json:"option,omitempty"`
+	ProposalId    uint64                 `protobuf:"varint,3,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Vote) Reset() {
+	*x = Vote{}
+	mi := &file_mojave_v1_gov_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Vote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vote) ProtoMessage() {}
+
+func (x *Vote) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_gov_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vote.ProtoReflect.Descriptor instead.
+func (*Vote) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_gov_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Vote) GetVoterPubkey() []byte {
+	if x != nil {
+		return x.VoterPubkey
+	}
+	return nil
+}
+
+func (x *Vote) GetOption() VoteOption {
+	if x != nil {
+		return x.Option
+	}
+	return VoteOption_VOTE_OPTION_UNSPECIFIED
+}
+
+func (x *Vote) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+// ProposalQueue lists the proposals whose deposit or voting period ends at a height.
+type ProposalQueue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProposalIds   []uint64               `protobuf:"varint,1,rep,packed,name=proposal_ids,json=proposalIds,proto3" json:"proposal_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProposalQueue) Reset() {
+	*x = ProposalQueue{}
+	mi := &file_mojave_v1_gov_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposalQueue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposalQueue) ProtoMessage() {}
+
+func (x *ProposalQueue) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_gov_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposalQueue.ProtoReflect.Descriptor instead.
+func (*ProposalQueue) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_gov_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ProposalQueue) GetProposalIds() []uint64 {
+	if x != nil {
+		return x.ProposalIds
+	}
+	return nil
+}
+
+// ProposalEndedEvent is emitted when a proposal's deposit or voting period ends with status.
+type ProposalEndedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProposalId    uint64                 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Status        ProposalStatus         `protobuf:"varint,2,opt,name=status,proto3,enum=mojave.v1.ProposalStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProposalEndedEvent) Reset() {
+	*x = ProposalEndedEvent{}
+	mi := &file_mojave_v1_gov_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposalEndedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposalEndedEvent) ProtoMessage() {}
+
+func (x *ProposalEndedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_gov_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposalEndedEvent.ProtoReflect.Descriptor instead.
+func (*ProposalEndedEvent) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_gov_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ProposalEndedEvent) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+func (x *ProposalEndedEvent) GetStatus() ProposalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
+}
+
+// CommunityPool holds the tokens governance can spend.
+type CommunityPool struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Balance       uint64                 `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommunityPool) Reset() {
+	*x = CommunityPool{}
+	mi := &file_mojave_v1_gov_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommunityPool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommunityPool) ProtoMessage() {}
+
+func (x *CommunityPool) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_gov_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommunityPool.ProtoReflect.Descriptor instead.
+func (*CommunityPool) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_gov_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CommunityPool) GetBalance() uint64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+// SubmitProposalTransaction submits a proposal along with the signer's first deposit on it.
+type SubmitProposalTransaction struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Title          string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Content        *ProposalContent       `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	InitialDeposit uint64                 `protobuf:"varint,3,opt,name=initial_deposit,json=initialDeposit,proto3" json:"initial_deposit,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SubmitProposalTransaction) Reset() {
+	*x = SubmitProposalTransaction{}
+	mi := &file_mojave_v1_gov_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitProposalTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitProposalTransaction) ProtoMessage() {}
+
+func (x *SubmitProposalTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_gov_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitProposalTransaction.ProtoReflect.Descriptor instead.
+func (*SubmitProposalTransaction) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_gov_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SubmitProposalTransaction) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SubmitProposalTransaction) GetContent() *ProposalContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *SubmitProposalTransaction) GetInitialDeposit() uint64 {
+	if x != nil {
+		return x.InitialDeposit
+	}
+	return 0
+}
+
+// DepositTransaction adds to the deposit of a proposal in its deposit period.
+type DepositTransaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProposalId    uint64                 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Amount        uint64                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepositTransaction) Reset() {
+	*x = DepositTransaction{}
+	mi := &file_mojave_v1_gov_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepositTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositTransaction) ProtoMessage() {}
+
+func (x *DepositTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_gov_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositTransaction.ProtoReflect.Descriptor instead.
+func (*DepositTransaction) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_gov_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DepositTransaction) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+func (x *DepositTransaction) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// VoteTransaction casts or changes the signer's vote on a proposal in its voting period. Only
+// signers with tokens bonded to an active validator can vote, and the vote is weighted by the
+// tokens they have bonded to active validators when voting ends.
+type VoteTransaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProposalId    uint64                 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Option        VoteOption             `protobuf:"varint,2,opt,name=option,proto3,enum=mojave.v1.VoteOption" json:"option,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoteTransaction) Reset() {
+	*x = VoteTransaction{}
+	mi := &file_mojave_v1_gov_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoteTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteTransaction) ProtoMessage() {}
+
+func (x *VoteTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_gov_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteTransaction.ProtoReflect.Descriptor instead.
+func (*VoteTransaction) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_gov_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *VoteTransaction) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+func (x *VoteTransaction) GetOption() VoteOption {
+	if x != nil {
+		return x.Option
+	}
+	return VoteOption_VOTE_OPTION_UNSPECIFIED
+}
+
+type SubmitProposalResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProposalId    uint64                 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitProposalResult) Reset() {
+	*x = SubmitProposalResult{}
+	mi := &file_mojave_v1_gov_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitProposalResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitProposalResult) ProtoMessage() {}
+
+func (x *SubmitProposalResult) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_gov_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitProposalResult.ProtoReflect.Descriptor instead.
+func (*SubmitProposalResult) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_gov_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SubmitProposalResult) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+type DepositResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepositResult) Reset() {
+	*x = DepositResult{}
+	mi := &file_mojave_v1_gov_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepositResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositResult) ProtoMessage() {}
+
+func (x *DepositResult) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_gov_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositResult.ProtoReflect.Descriptor instead.
+func (*DepositResult) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_gov_proto_rawDescGZIP(), []int{14}
+}
+
+type VoteResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoteResult) Reset() {
+	*x = VoteResult{}
+	mi := &file_mojave_v1_gov_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoteResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteResult) ProtoMessage() {}
+
+func (x *VoteResult) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_gov_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteResult.ProtoReflect.Descriptor instead.
+func (*VoteResult) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_gov_proto_rawDescGZIP(), []int{15}
+}
+
+type ProposalQuery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProposalId    uint64                 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProposalQuery) Reset() {
+	*x = ProposalQuery{}
+	mi := &file_mojave_v1_gov_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposalQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposalQuery) ProtoMessage() {}
+
+func (x *ProposalQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_gov_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposalQuery.ProtoReflect.Descriptor instead.
+func (*ProposalQuery) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_gov_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ProposalQuery) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+type ProposalDepositQuery struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProposalId      uint64                 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	DepositorPubkey []byte                 `protobuf:"bytes,2,opt,name=depositor_pubkey,json=depositorPubkey,proto3" json:"depositor_pubkey,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ProposalDepositQuery) Reset() {
+	*x = ProposalDepositQuery{}
+	mi := &file_mojave_v1_gov_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposalDepositQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposalDepositQuery) ProtoMessage() {}
+
+func (x *ProposalDepositQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_gov_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposalDepositQuery.ProtoReflect.Descriptor instead.
+func (*ProposalDepositQuery) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_gov_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ProposalDepositQuery) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+func (x *ProposalDepositQuery) GetDepositorPubkey() []byte {
+	if x != nil {
+		return x.DepositorPubkey
+	}
+	return nil
+}
+
+type ProposalVoteQuery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProposalId    uint64                 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	VoterPubkey   []byte                 `protobuf:"bytes,2,opt,name=voter_pubkey,json=voterPubkey,proto3" json:"voter_pubkey,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProposalVoteQuery) Reset() {
+	*x = ProposalVoteQuery{}
+	mi := &file_mojave_v1_gov_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposalVoteQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposalVoteQuery) ProtoMessage() {}
+
+func (x *ProposalVoteQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_gov_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposalVoteQuery.ProtoReflect.Descriptor instead.
+func (*ProposalVoteQuery) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_gov_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ProposalVoteQuery) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+func (x *ProposalVoteQuery) GetVoterPubkey() []byte {
+	if x != nil {
+		return x.VoterPubkey
+	}
+	return nil
+}
+
+type CommunityPoolQuery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommunityPoolQuery) Reset() {
+	*x = CommunityPoolQuery{}
+	mi := &file_mojave_v1_gov_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommunityPoolQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommunityPoolQuery) ProtoMessage() {}
+
+func (x *CommunityPoolQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_gov_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommunityPoolQuery.ProtoReflect.Descriptor instead.
+func (*CommunityPoolQuery) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_gov_proto_rawDescGZIP(), []int{19}
+}
+
+type GovParamsQuery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GovParamsQuery) Reset() {
+	*x = GovParamsQuery{}
+	mi := &file_mojave_v1_gov_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GovParamsQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GovParamsQuery) ProtoMessage() {}
+
+func (x *GovParamsQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_gov_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GovParamsQuery.ProtoReflect.Descriptor instead.
+func (*GovParamsQuery) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_gov_proto_rawDescGZIP(), []int{20}
+}
+
+type UpgradePlanQuery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpgradePlanQuery) Reset() {
+	*x = UpgradePlanQuery{}
+	mi := &file_mojave_v1_gov_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpgradePlanQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradePlanQuery) ProtoMessage() {}
+
+func (x *UpgradePlanQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_gov_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradePlanQuery.ProtoReflect.Descriptor instead.
+func (*UpgradePlanQuery) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_gov_proto_rawDescGZIP(), []int{21}
+}
+
+var File_mojave_v1_gov_proto protoreflect.FileDescriptor
+
+const file_mojave_v1_gov_proto_rawDesc = "" +
+	"\n" +
+	"\x13mojave/v1/gov.proto\x12\tmojave.v1\x1a\x16mojave/v1/params.proto\"W\n" +
+	"\x12CommunityPoolSpend\x12)\n" +
+	"\x10recipient_pubkey\x18\x01 \x01(\fR\x0frecipientPubkey\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x04R\x06amount\"9\n" +
+	"\vUpgradePlan\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06height\x18\x02 \x01(\x04R\x06height\"\xee\x01\n" +
+	"\x0fProposalContent\x12I\n" +
+	"\rupdate_params\x18\x01 \x01(\v2\".mojave.v1.UpdateParamsTransactionH\x00R\fupdateParams\x12Q\n" +
+	"\x14community_pool_spend\x18\x02 \x01(\v2\x1d.mojave.v1.CommunityPoolSpendH\x00R\x12communityPoolSpend\x122\n" +
+	"\aupgrade\x18\x03 \x01(\v2\x16.mojave.v1.UpgradePlanH\x00R\aupgradeB\t\n" +
+	"\acontent\"l\n" +
+	"\vTallyResult\x12\x10\n" +
+	"\x03yes\x18\x01 \x01(\x04R\x03yes\x12\x0e\n" +
+	"\x02no\x18\x02 \x01(\x04R\x02no\x12\x18\n" +
+	"\aabstain\x18\x03 \x01(\x04R\aabstain\x12!\n" +
+	"\ftotal_bonded\x18\x04 \x01(\x04R\vtotalBonded\"\xae\x03\n" +
+	"\bProposal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12'\n" +
+	"\x0fproposer_pubkey\x18\x02 \x01(\fR\x0eproposerPubkey\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x124\n" +
+	"\acontent\x18\x04 \x01(\v2\x1a.mojave.v1.ProposalContentR\acontent\x121\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x19.mojave.v1.ProposalStatusR\x06status\x12#\n" +
+	"\rsubmit_height\x18\x06 \x01(\x04R\fsubmitHeight\x12,\n" +
+	"\x12deposit_end_height\x18\a \x01(\x04R\x10depositEndHeight\x12*\n" +
+	"\x11voting_end_height\x18\b \x01(\x04R\x0fvotingEndHeight\x12#\n" +
+	"\rtotal_deposit\x18\t \x01(\x04R\ftotalDeposit\x12,\n" +
+	"\x05tally\x18\n" +
+	" \x01(\v2\x16.mojave.v1.TallyResultR\x05tally\x12\x18\n" +
+	"\afailure\x18\v \x01(\tR\afailure\"m\n" +
+	"\aDeposit\x12)\n" +
+	"\x10depositor_pubkey\x18\x01 \x01(\fR\x0fdepositorPubkey\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x04R\x06amount\x12\x1f\n" +
+	"\vproposal_id\x18\x03 \x01(\x04R\n" +
+	"proposalId\"y\n" +
+	"\x04Vote\x12!\n" +
+	"\fvoter_pubkey\x18\x01 \x01(\fR\vvoterPubkey\x12-\n" +
+	"\x06option\x18\x02 \x01(\x0e2\x15.mojave.v1.VoteOptionR\x06option\x12\x1f\n" +
+	"\vproposal_id\x18\x03 \x01(\x04R\n" +
+	"proposalId\"2\n" +
+	"\rProposalQueue\x12!\n" +
+	"\fproposal_ids\x18\x01 \x03(\x04R\vproposalIds\"h\n" +
+	"\x12ProposalEndedEvent\x12\x1f\n" +
+	"\vproposal_id\x18\x01 \x01(\x04R\n" +
+	"proposalId\x121\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x19.mojave.v1.ProposalStatusR\x06status\")\n" +
+	"\rCommunityPool\x12\x18\n" +
+	"\abalance\x18\x01 \x01(\x04R\abalance\"\x90\x01\n" +
+	"\x19SubmitProposalTransaction\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x124\n" +
+	"\acontent\x18\x02 \x01(\v2\x1a.mojave.v1.ProposalContentR\acontent\x12'\n" +
+	"\x0finitial_deposit\x18\x03 \x01(\x04R\x0einitialDeposit\"M\n" +
+	"\x12DepositTransaction\x12\x1f\n" +
+	"\vproposal_id\x18\x01 \x01(\x04R\n" +
+	"proposalId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x04R\x06amount\"a\n" +
+	"\x0fVoteTransaction\x12\x1f\n" +
+	"\vproposal_id\x18\x01 \x01(\x04R\n" +
+	"proposalId\x12-\n" +
+	"\x06option\x18\x02 \x01(\x0e2\x15.mojave.v1.VoteOptionR\x06option\"7\n" +
+	"\x14SubmitProposalResult\x12\x1f\n" +
+	"\vproposal_id\x18\x01 \x01(\x04R\n" +
+	"proposalId\"\x0f\n" +
+	"\rDepositResult\"\f\n" +
+	"\n" +
+	"VoteResult\"0\n" +
+	"\rProposalQuery\x12\x1f\n" +
+	"\vproposal_id\x18\x01 \x01(\x04R\n" +
+	"proposalId\"b\n" +
+	"\x14ProposalDepositQuery\x12\x1f\n" +
+	"\vproposal_id\x18\x01 \x01(\x04R\n" +
+	"proposalId\x12)\n" +
+	"\x10depositor_pubkey\x18\x02 \x01(\fR\x0fdepositorPubkey\"W\n" +
+	"\x11ProposalVoteQuery\x12\x1f\n" +
+	"\vproposal_id\x18\x01 \x01(\x04R\n" +
+	"proposalId\x12!\n" +
+	"\fvoter_pubkey\x18\x02 \x01(\fR\vvoterPubkey\"\x14\n" +
+	"\x12CommunityPoolQuery\"\x10\n" +
+	"\x0eGovParamsQuery\"\x12\n" +
+	"\x10UpgradePlanQuery*\xeb\x01\n" +
+	"\x0eProposalStatus\x12\x1f\n" +
+	"\x1bPROPOSAL_STATUS_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1ePROPOSAL_STATUS_DEPOSIT_PERIOD\x10\x01\x12!\n" +
+	"\x1dPROPOSAL_STATUS_VOTING_PERIOD\x10\x02\x12\x1a\n" +
+	"\x16PROPOSAL_STATUS_PASSED\x10\x03\x12\x1c\n" +
+	"\x18PROPOSAL_STATUS_REJECTED\x10\x04\x12\x1a\n" +
+	"\x16PROPOSAL_STATUS_FAILED\x10\x05\x12\x1b\n" +
+	"\x17PROPOSAL_STATUS_EXPIRED\x10\x06*k\n" +
+	"\n" +
+	"VoteOption\x12\x1b\n" +
+	"\x17VOTE_OPTION_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fVOTE_OPTION_YES\x10\x01\x12\x12\n" +
+	"\x0eVOTE_OPTION_NO\x10\x02\x12\x17\n" +
+	"\x13VOTE_OPTION_ABSTAIN\x10\x03B+Z)github.com/alecsavvy/mojave/gen/mojave/v1b\x06proto3"
+
+var (
+	file_mojave_v1_gov_proto_rawDescOnce sync.Once
+	file_mojave_v1_gov_proto_rawDescData []byte
+)
+
+func file_mojave_v1_gov_proto_rawDescGZIP() []byte {
+	file_mojave_v1_gov_proto_rawDescOnce.Do(func() {
+		file_mojave_v1_gov_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_mojave_v1_gov_proto_rawDesc), len(file_mojave_v1_gov_proto_rawDesc)))
+	})
+	return file_mojave_v1_gov_proto_rawDescData
+}
+
+var file_mojave_v1_gov_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_mojave_v1_gov_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_mojave_v1_gov_proto_goTypes = []any{
+	(ProposalStatus)(0),               // 0: mojave.v1.ProposalStatus
+	(VoteOption)(0),                   // 1: mojave.v1.VoteOption
+	(*CommunityPoolSpend)(nil),        // 2: mojave.v1.CommunityPoolSpend
+	(*UpgradePlan)(nil),               // 3: mojave.v1.UpgradePlan
+	(*ProposalContent)(nil),           // 4: mojave.v1.ProposalContent
+	(*TallyResult)(nil),               // 5: mojave.v1.TallyResult
+	(*Proposal)(nil),                  // 6: mojave.v1.Proposal
+	(*Deposit)(nil),                   // 7: mojave.v1.Deposit
+	(*Vote)(nil),                      // 8: mojave.v1.Vote
+	(*ProposalQueue)(nil),             // 9: mojave.v1.ProposalQueue
+	(*ProposalEndedEvent)(nil),        // 10: mojave.v1.ProposalEndedEvent
+	(*CommunityPool)(nil),             // 11: mojave.v1.CommunityPool
+	(*SubmitProposalTransaction)(nil), // 12: mojave.v1.SubmitProposalTransaction
+	(*DepositTransaction)(nil),        // 13: mojave.v1.DepositTransaction
+	(*VoteTransaction)(nil),           // 14: mojave.v1.VoteTransaction
+	(*SubmitProposalResult)(nil),      // 15: mojave.v1.SubmitProposalResult
+	(*DepositResult)(nil),             // 16: mojave.v1.DepositResult
+	(*VoteResult)(nil),                // 17: mojave.v1.VoteResult
+	(*ProposalQuery)(nil),             // 18: mojave.v1.ProposalQuery
+	(*ProposalDepositQuery)(nil),      // 19: mojave.v1.ProposalDepositQuery
+	(*ProposalVoteQuery)(nil),         // 20: mojave.v1.ProposalVoteQuery
+	(*CommunityPoolQuery)(nil),        // 21: mojave.v1.CommunityPoolQuery
+	(*GovParamsQuery)(nil),            // 22: mojave.v1.GovParamsQuery
+	(*UpgradePlanQuery)(nil),          // 23: mojave.v1.UpgradePlanQuery
+	(*UpdateParamsTransaction)(nil),   // 24: mojave.v1.UpdateParamsTransaction
+}
+var file_mojave_v1_gov_proto_depIdxs = []int32{
+	24, // 0: mojave.v1.ProposalContent.update_params:type_name -> mojave.v1.UpdateParamsTransaction
+	2,  // 1: mojave.v1.ProposalContent.community_pool_spend:type_name -> mojave.v1.CommunityPoolSpend
+	3,  // 2: mojave.v1.ProposalContent.upgrade:type_name -> mojave.v1.UpgradePlan
+	4,  // 3: mojave.v1.Proposal.content:type_name -> mojave.v1.ProposalContent
+	0,  // 4: mojave.v1.Proposal.status:type_name -> mojave.v1.ProposalStatus
+	5,  // 5: mojave.v1.Proposal.tally:type_name -> mojave.v1.TallyResult
+	1,  // 6: mojave.v1.Vote.option:type_name -> mojave.v1.VoteOption
+	0,  // 7: mojave.v1.ProposalEndedEvent.status:type_name -> mojave.v1.ProposalStatus
+	4,  // 8: mojave.v1.SubmitProposalTransaction.content:type_name -> mojave.v1.ProposalContent
+	1,  // 9: mojave.v1.VoteTransaction.option:type_name -> mojave.v1.VoteOption
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_mojave_v1_gov_proto_init() }
+func file_mojave_v1_gov_proto_init() {
+	if File_mojave_v1_gov_proto != nil {
+		return
+	}
+	file_mojave_v1_params_proto_init()
+	file_mojave_v1_gov_proto_msgTypes[2].OneofWrappers = []any{
+		(*ProposalContent_UpdateParams)(nil),
+		(*ProposalContent_CommunityPoolSpend)(nil),
+		(*ProposalContent_Upgrade)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mojave_v1_gov_proto_rawDesc), len(file_mojave_v1_gov_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   22,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mojave_v1_gov_proto_goTypes,
+		DependencyIndexes: file_mojave_v1_gov_proto_depIdxs,
+		EnumInfos:         file_mojave_v1_gov_proto_enumTypes,
+		MessageInfos:      file_mojave_v1_gov_proto_msgTypes,
+	}.Build()
+	File_mojave_v1_gov_proto = out.File
+	file_mojave_v1_gov_proto_goTypes = nil
+	file_mojave_v1_gov_proto_depIdxs = nil
+}
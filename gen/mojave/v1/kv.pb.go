@@ -205,6 +205,59 @@ func (*KeyValueResult) Descriptor() ([]byte, []int) {
 	return file_mojave_v1_kv_proto_rawDescGZIP(), []int{3}
 }
 
+// KeyValueSetEvent is emitted when a key value transaction sets key.
+type KeyValueSetEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Setter        []byte                 `protobuf:"bytes,2,opt,name=setter,proto3" json:"setter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeyValueSetEvent) Reset() {
+	*x = KeyValueSetEvent{}
+	mi := &file_mojave_v1_kv_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyValueSetEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyValueSetEvent) ProtoMessage() {}
+
+func (x *KeyValueSetEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_kv_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyValueSetEvent.ProtoReflect.Descriptor instead.
+func (*KeyValueSetEvent) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_kv_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *KeyValueSetEvent) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *KeyValueSetEvent) GetSetter() []byte {
+	if x != nil {
+		return x.Setter
+	}
+	return nil
+}
+
 var File_mojave_v1_kv_proto protoreflect.FileDescriptor
 
 const file_mojave_v1_kv_proto_rawDesc = "" +
@@ -218,7 +271,10 @@ const file_mojave_v1_kv_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\tR\x05value\"!\n" +
 	"\rKeyValueQuery\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\"\x10\n" +
-	"\x0eKeyValueResultB+Z)github.com/alecsavvy/mojave/gen/mojave/v1b\x06proto3"
+	"\x0eKeyValueResult\"<\n" +
+	"\x10KeyValueSetEvent\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x16\n" +
+	"\x06setter\x18\x02 \x01(\fR\x06setterB+Z)github.com/alecsavvy/mojave/gen/mojave/v1b\x06proto3"
 
 var (
 	file_mojave_v1_kv_proto_rawDescOnce sync.Once
@@ -232,12 +288,13 @@ func file_mojave_v1_kv_proto_rawDescGZIP() []byte {
 	return file_mojave_v1_kv_proto_rawDescData
 }
 
-var file_mojave_v1_kv_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_mojave_v1_kv_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_mojave_v1_kv_proto_goTypes = []any{
 	(*KeyValueTransaction)(nil), // 0: mojave.v1.KeyValueTransaction
 	(*KeyValueState)(nil),       // 1: mojave.v1.KeyValueState
 	(*KeyValueQuery)(nil),       // 2: mojave.v1.KeyValueQuery
 	(*KeyValueResult)(nil),      // 3: mojave.v1.KeyValueResult
+	(*KeyValueSetEvent)(nil),    // 4: mojave.v1.KeyValueSetEvent
 }
 var file_mojave_v1_kv_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -258,7 +315,7 @@ func file_mojave_v1_kv_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mojave_v1_kv_proto_rawDesc), len(file_mojave_v1_kv_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
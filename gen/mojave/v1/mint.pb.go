@@ -0,0 +1,416 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: mojave/v1/mint.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MintParams are the issuance schedule. Every block mints a reward, which starts at
+// block_reward and is reduced by reduction_bps every reduction_interval blocks.
+type MintParams struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	BlockReward       uint64                 `protobuf:"varint,1,opt,name=block_reward,json=blockReward,proto3" json:"block_reward,omitempty"`
+	ReductionInterval uint64                 `protobuf:"varint,2,opt,name=reduction_interval,json=reductionInterval,proto3" json:"reduction_interval,omitempty"`
+	ReductionBps      uint32                 `protobuf:"varint,3,opt,name=reduction_bps,json=reductionBps,proto3" json:"reduction_bps,omitempty"`
+	// proposer_bonus_bps is the share of the reward, in basis points, that goes to the block's
+	// proposer. The rest is split between the validators that signed the last commit by power.
+	ProposerBonusBps uint32 `protobuf:"varint,4,opt,name=proposer_bonus_bps,json=proposerBonusBps,proto3" json:"proposer_bonus_bps,omitempty"`
+	// community_pool_bps is the share of the reward, in basis points, that goes to the community
+	// pool before the rest is paid to validators.
+	CommunityPoolBps uint32 `protobuf:"varint,5,opt,name=community_pool_bps,json=communityPoolBps,proto3" json:"community_pool_bps,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MintParams) Reset() {
+	*x = MintParams{}
+	mi := &file_mojave_v1_mint_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MintParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintParams) ProtoMessage() {}
+
+func (x *MintParams) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_mint_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintParams.ProtoReflect.Descriptor instead.
+func (*MintParams) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_mint_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MintParams) GetBlockReward() uint64 {
+	if x != nil {
+		return x.BlockReward
+	}
+	return 0
+}
+
+func (x *MintParams) GetReductionInterval() uint64 {
+	if x != nil {
+		return x.ReductionInterval
+	}
+	return 0
+}
+
+func (x *MintParams) GetReductionBps() uint32 {
+	if x != nil {
+		return x.ReductionBps
+	}
+	return 0
+}
+
+func (x *MintParams) GetProposerBonusBps() uint32 {
+	if x != nil {
+		return x.ProposerBonusBps
+	}
+	return 0
+}
+
+func (x *MintParams) GetCommunityPoolBps() uint32 {
+	if x != nil {
+		return x.CommunityPoolBps
+	}
+	return 0
+}
+
+// MintState is where the issuance schedule stands. block_reward is what the next block mints
+// before any reduction due at its height.
+type MintState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlockReward   uint64                 `protobuf:"varint,1,opt,name=block_reward,json=blockReward,proto3" json:"block_reward,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MintState) Reset() {
+	*x = MintState{}
+	mi := &file_mojave_v1_mint_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MintState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintState) ProtoMessage() {}
+
+func (x *MintState) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_mint_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintState.ProtoReflect.Descriptor instead.
+func (*MintState) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_mint_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MintState) GetBlockReward() uint64 {
+	if x != nil {
+		return x.BlockReward
+	}
+	return 0
+}
+
+// RewardMintedEvent is emitted when a block mints its reward, of which community_pool goes to
+// the community pool and the rest to validators.
+type RewardMintedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        uint64                 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	CommunityPool uint64                 `protobuf:"varint,2,opt,name=community_pool,json=communityPool,proto3" json:"community_pool,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RewardMintedEvent) Reset() {
+	*x = RewardMintedEvent{}
+	mi := &file_mojave_v1_mint_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RewardMintedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardMintedEvent) ProtoMessage() {}
+
+func (x *RewardMintedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_mint_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardMintedEvent.ProtoReflect.Descriptor instead.
+func (*RewardMintedEvent) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_mint_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RewardMintedEvent) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RewardMintedEvent) GetCommunityPool() uint64 {
+	if x != nil {
+		return x.CommunityPool
+	}
+	return 0
+}
+
+// Supply accounts for every token there is: those in balances, bonded, unbonding, deposited on
+// proposals and in the community pool.
+type Supply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Total uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	// minted are the tokens minted as block rewards since genesis, and burned those slashed.
+	Minted        uint64 `protobuf:"varint,2,opt,name=minted,proto3" json:"minted,omitempty"`
+	Burned        uint64 `protobuf:"varint,3,opt,name=burned,proto3" json:"burned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Supply) Reset() {
+	*x = Supply{}
+	mi := &file_mojave_v1_mint_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Supply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Supply) ProtoMessage() {}
+
+func (x *Supply) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_mint_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Supply.ProtoReflect.Descriptor instead.
+func (*Supply) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_mint_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Supply) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Supply) GetMinted() uint64 {
+	if x != nil {
+		return x.Minted
+	}
+	return 0
+}
+
+func (x *Supply) GetBurned() uint64 {
+	if x != nil {
+		return x.Burned
+	}
+	return 0
+}
+
+type SupplyQuery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SupplyQuery) Reset() {
+	*x = SupplyQuery{}
+	mi := &file_mojave_v1_mint_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SupplyQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupplyQuery) ProtoMessage() {}
+
+func (x *SupplyQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_mint_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupplyQuery.ProtoReflect.Descriptor instead.
+func (*SupplyQuery) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_mint_proto_rawDescGZIP(), []int{4}
+}
+
+type MintParamsQuery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MintParamsQuery) Reset() {
+	*x = MintParamsQuery{}
+	mi := &file_mojave_v1_mint_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MintParamsQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintParamsQuery) ProtoMessage() {}
+
+func (x *MintParamsQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_mint_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintParamsQuery.ProtoReflect.Descriptor instead.
+func (*MintParamsQuery) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_mint_proto_rawDescGZIP(), []int{5}
+}
+
+var File_mojave_v1_mint_proto protoreflect.FileDescriptor
+
+const file_mojave_v1_mint_proto_rawDesc = "" +
+	"\n" +
+	"\x14mojave/v1/mint.proto\x12\tmojave.v1\"\xdf\x01\n" +
+	"\n" +
+	"MintParams\x12!\n" +
+	"\fblock_reward\x18\x01 \x01(\x04R\vblockReward\x12-\n" +
+	"\x12reduction_interval\x18\x02 \x01(\x04R\x11reductionInterval\x12#\n" +
+	"\rreduction_bps\x18\x03 \x01(\rR\freductionBps\x12,\n" +
+	"\x12proposer_bonus_bps\x18\x04 \x01(\rR\x10proposerBonusBps\x12,\n" +
+	"\x12community_pool_bps\x18\x05 \x01(\rR\x10communityPoolBps\".\n" +
+	"\tMintState\x12!\n" +
+	"\fblock_reward\x18\x01 \x01(\x04R\vblockReward\"R\n" +
+	"\x11RewardMintedEvent\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x04R\x06amount\x12%\n" +
+	"\x0ecommunity_pool\x18\x02 \x01(\x04R\rcommunityPool\"N\n" +
+	"\x06Supply\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x04R\x05total\x12\x16\n" +
+	"\x06minted\x18\x02 \x01(\x04R\x06minted\x12\x16\n" +
+	"\x06burned\x18\x03 \x01(\x04R\x06burned\"\r\n" +
+	"\vSupplyQuery\"\x11\n" +
+	"\x0fMintParamsQueryB+Z)github.com/alecsavvy/mojave/gen/mojave/v1b\x06proto3"
+
+var (
+	file_mojave_v1_mint_proto_rawDescOnce sync.Once
+	file_mojave_v1_mint_proto_rawDescData []byte
+)
+
+func file_mojave_v1_mint_proto_rawDescGZIP() []byte {
+	file_mojave_v1_mint_proto_rawDescOnce.Do(func() {
+		file_mojave_v1_mint_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_mojave_v1_mint_proto_rawDesc), len(file_mojave_v1_mint_proto_rawDesc)))
+	})
+	return file_mojave_v1_mint_proto_rawDescData
+}
+
+var file_mojave_v1_mint_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_mojave_v1_mint_proto_goTypes = []any{
+	(*MintParams)(nil),        // 0: mojave.v1.MintParams
+	(*MintState)(nil),         // 1: mojave.v1.MintState
+	(*RewardMintedEvent)(nil), // 2: mojave.v1.RewardMintedEvent
+	(*Supply)(nil),            // 3: mojave.v1.Supply
+	(*SupplyQuery)(nil),       // 4: mojave.v1.SupplyQuery
+	(*MintParamsQuery)(nil),   // 5: mojave.v1.MintParamsQuery
+}
+var file_mojave_v1_mint_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_mojave_v1_mint_proto_init() }
+func file_mojave_v1_mint_proto_init() {
+	if File_mojave_v1_mint_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mojave_v1_mint_proto_rawDesc), len(file_mojave_v1_mint_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mojave_v1_mint_proto_goTypes,
+		DependencyIndexes: file_mojave_v1_mint_proto_depIdxs,
+		MessageInfos:      file_mojave_v1_mint_proto_msgTypes,
+	}.Build()
+	File_mojave_v1_mint_proto = out.File
+	file_mojave_v1_mint_proto_goTypes = nil
+	file_mojave_v1_mint_proto_depIdxs = nil
+}
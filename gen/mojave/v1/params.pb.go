@@ -0,0 +1,522 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: mojave/v1/params.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Params are the limits transactions are checked against. Changes apply from the block after
+// the one they are made in, so that a block is always built and checked against the params it
+// executes with.
+type Params struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// max_tx_size is the size of the largest encoded transaction accepted into the mempool or a
+	// block.
+	MaxTxSize            uint64 `protobuf:"varint,1,opt,name=max_tx_size,json=maxTxSize,proto3" json:"max_tx_size,omitempty"`
+	MaxKeyValueKeySize   uint64 `protobuf:"varint,2,opt,name=max_key_value_key_size,json=maxKeyValueKeySize,proto3" json:"max_key_value_key_size,omitempty"`
+	MaxKeyValueValueSize uint64 `protobuf:"varint,3,opt,name=max_key_value_value_size,json=maxKeyValueValueSize,proto3" json:"max_key_value_value_size,omitempty"`
+	// max_block_watts is the total number of watts all transactions in a block may use.
+	MaxBlockWatts uint64 `protobuf:"varint,4,opt,name=max_block_watts,json=maxBlockWatts,proto3" json:"max_block_watts,omitempty"`
+	// min_fee is the smallest fee a transaction may pay.
+	MinFee        uint64 `protobuf:"varint,5,opt,name=min_fee,json=minFee,proto3" json:"min_fee,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	mi := &file_mojave_v1_params_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_params_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_params_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetMaxTxSize() uint64 {
+	if x != nil {
+		return x.MaxTxSize
+	}
+	return 0
+}
+
+func (x *Params) GetMaxKeyValueKeySize() uint64 {
+	if x != nil {
+		return x.MaxKeyValueKeySize
+	}
+	return 0
+}
+
+func (x *Params) GetMaxKeyValueValueSize() uint64 {
+	if x != nil {
+		return x.MaxKeyValueValueSize
+	}
+	return 0
+}
+
+func (x *Params) GetMaxBlockWatts() uint64 {
+	if x != nil {
+		return x.MaxBlockWatts
+	}
+	return 0
+}
+
+func (x *Params) GetMinFee() uint64 {
+	if x != nil {
+		return x.MinFee
+	}
+	return 0
+}
+
+// GovParams are the governance params. They are defined here rather than with the rest of
+// governance, whose proposals carry params updates.
+type GovParams struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// min_deposit is what a proposal's deposits must add up to for it to be voted on.
+	MinDeposit uint64 `protobuf:"varint,1,opt,name=min_deposit,json=minDeposit,proto3" json:"min_deposit,omitempty"`
+	// deposit_period is the number of blocks a proposal has to reach the min deposit.
+	DepositPeriod uint64 `protobuf:"varint,2,opt,name=deposit_period,json=depositPeriod,proto3" json:"deposit_period,omitempty"`
+	// voting_period is the number of blocks a proposal is voted on for.
+	VotingPeriod uint64 `protobuf:"varint,3,opt,name=voting_period,json=votingPeriod,proto3" json:"voting_period,omitempty"`
+	// quorum_bps is the share of the bonded tokens, in basis points, that must vote for the
+	// tally to count.
+	QuorumBps uint32 `protobuf:"varint,4,opt,name=quorum_bps,json=quorumBps,proto3" json:"quorum_bps,omitempty"`
+	// threshold_bps is the share of the yes and no votes, in basis points, that yes must exceed
+	// for a proposal to pass.
+	ThresholdBps  uint32 `protobuf:"varint,5,opt,name=threshold_bps,json=thresholdBps,proto3" json:"threshold_bps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GovParams) Reset() {
+	*x = GovParams{}
+	mi := &file_mojave_v1_params_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GovParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GovParams) ProtoMessage() {}
+
+func (x *GovParams) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_params_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GovParams.ProtoReflect.Descriptor instead.
+func (*GovParams) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_params_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GovParams) GetMinDeposit() uint64 {
+	if x != nil {
+		return x.MinDeposit
+	}
+	return 0
+}
+
+func (x *GovParams) GetDepositPeriod() uint64 {
+	if x != nil {
+		return x.DepositPeriod
+	}
+	return 0
+}
+
+func (x *GovParams) GetVotingPeriod() uint64 {
+	if x != nil {
+		return x.VotingPeriod
+	}
+	return 0
+}
+
+func (x *GovParams) GetQuorumBps() uint32 {
+	if x != nil {
+		return x.QuorumBps
+	}
+	return 0
+}
+
+func (x *GovParams) GetThresholdBps() uint32 {
+	if x != nil {
+		return x.ThresholdBps
+	}
+	return 0
+}
+
+// ConsensusParamsUpdate changes the CometBFT consensus params. Fields left at 0 keep their
+// current value.
+type ConsensusParamsUpdate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// block_max_bytes and block_max_gas may be -1 to lift the limit.
+	BlockMaxBytes           int64 `protobuf:"varint,1,opt,name=block_max_bytes,json=blockMaxBytes,proto3" json:"block_max_bytes,omitempty"`
+	BlockMaxGas             int64 `protobuf:"varint,2,opt,name=block_max_gas,json=blockMaxGas,proto3" json:"block_max_gas,omitempty"`
+	EvidenceMaxAgeNumBlocks int64 `protobuf:"varint,3,opt,name=evidence_max_age_num_blocks,json=evidenceMaxAgeNumBlocks,proto3" json:"evidence_max_age_num_blocks,omitempty"`
+	EvidenceMaxBytes        int64 `protobuf:"varint,4,opt,name=evidence_max_bytes,json=evidenceMaxBytes,proto3" json:"evidence_max_bytes,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *ConsensusParamsUpdate) Reset() {
+	*x = ConsensusParamsUpdate{}
+	mi := &file_mojave_v1_params_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsensusParamsUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsensusParamsUpdate) ProtoMessage() {}
+
+func (x *ConsensusParamsUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_params_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsensusParamsUpdate.ProtoReflect.Descriptor instead.
+func (*ConsensusParamsUpdate) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_params_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ConsensusParamsUpdate) GetBlockMaxBytes() int64 {
+	if x != nil {
+		return x.BlockMaxBytes
+	}
+	return 0
+}
+
+func (x *ConsensusParamsUpdate) GetBlockMaxGas() int64 {
+	if x != nil {
+		return x.BlockMaxGas
+	}
+	return 0
+}
+
+func (x *ConsensusParamsUpdate) GetEvidenceMaxAgeNumBlocks() int64 {
+	if x != nil {
+		return x.EvidenceMaxAgeNumBlocks
+	}
+	return 0
+}
+
+func (x *ConsensusParamsUpdate) GetEvidenceMaxBytes() int64 {
+	if x != nil {
+		return x.EvidenceMaxBytes
+	}
+	return 0
+}
+
+// UpdateParamsTransaction replaces the params that are set and changes the consensus params.
+// It must be signed by the params admin, or be the content of a governance proposal.
+type UpdateParamsTransaction struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Params *Params                `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// staking_params may change the unbonding period but not the tokens per power, which every
+	// validator's power is derived from.
+	StakingParams   *StakingParams         `protobuf:"bytes,2,opt,name=staking_params,json=stakingParams,proto3" json:"staking_params,omitempty"`
+	SlashingParams  *SlashingParams        `protobuf:"bytes,3,opt,name=slashing_params,json=slashingParams,proto3" json:"slashing_params,omitempty"`
+	MintParams      *MintParams            `protobuf:"bytes,4,opt,name=mint_params,json=mintParams,proto3" json:"mint_params,omitempty"`
+	ConsensusParams *ConsensusParamsUpdate `protobuf:"bytes,5,opt,name=consensus_params,json=consensusParams,proto3" json:"consensus_params,omitempty"`
+	GovParams       *GovParams             `protobuf:"bytes,6,opt,name=gov_params,json=govParams,proto3" json:"gov_params,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateParamsTransaction) Reset() {
+	*x = UpdateParamsTransaction{}
+	mi := &file_mojave_v1_params_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateParamsTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateParamsTransaction) ProtoMessage() {}
+
+func (x *UpdateParamsTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_params_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateParamsTransaction.ProtoReflect.Descriptor instead.
+func (*UpdateParamsTransaction) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_params_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateParamsTransaction) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *UpdateParamsTransaction) GetStakingParams() *StakingParams {
+	if x != nil {
+		return x.StakingParams
+	}
+	return nil
+}
+
+func (x *UpdateParamsTransaction) GetSlashingParams() *SlashingParams {
+	if x != nil {
+		return x.SlashingParams
+	}
+	return nil
+}
+
+func (x *UpdateParamsTransaction) GetMintParams() *MintParams {
+	if x != nil {
+		return x.MintParams
+	}
+	return nil
+}
+
+func (x *UpdateParamsTransaction) GetConsensusParams() *ConsensusParamsUpdate {
+	if x != nil {
+		return x.ConsensusParams
+	}
+	return nil
+}
+
+func (x *UpdateParamsTransaction) GetGovParams() *GovParams {
+	if x != nil {
+		return x.GovParams
+	}
+	return nil
+}
+
+type UpdateParamsResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateParamsResult) Reset() {
+	*x = UpdateParamsResult{}
+	mi := &file_mojave_v1_params_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateParamsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateParamsResult) ProtoMessage() {}
+
+func (x *UpdateParamsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_params_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateParamsResult.ProtoReflect.Descriptor instead.
+func (*UpdateParamsResult) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_params_proto_rawDescGZIP(), []int{4}
+}
+
+type ParamsQuery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParamsQuery) Reset() {
+	*x = ParamsQuery{}
+	mi := &file_mojave_v1_params_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParamsQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParamsQuery) ProtoMessage() {}
+
+func (x *ParamsQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_params_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParamsQuery.ProtoReflect.Descriptor instead.
+func (*ParamsQuery) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_params_proto_rawDescGZIP(), []int{5}
+}
+
+var File_mojave_v1_params_proto protoreflect.FileDescriptor
+
+const file_mojave_v1_params_proto_rawDesc = "" +
+	"\n" +
+	"\x16mojave/v1/params.proto\x12\tmojave.v1\x1a\x14mojave/v1/mint.proto\x1a\x18mojave/v1/slashing.proto\x1a\x17mojave/v1/staking.proto\"\xd5\x01\n" +
+	"\x06Params\x12\x1e\n" +
+	"\vmax_tx_size\x18\x01 \x01(\x04R\tmaxTxSize\x122\n" +
+	"\x16max_key_value_key_size\x18\x02 \x01(\x04R\x12maxKeyValueKeySize\x126\n" +
+	"\x18max_key_value_value_size\x18\x03 \x01(\x04R\x14maxKeyValueValueSize\x12&\n" +
+	"\x0fmax_block_watts\x18\x04 \x01(\x04R\rmaxBlockWatts\x12\x17\n" +
+	"\amin_fee\x18\x05 \x01(\x04R\x06minFee\"\xbc\x01\n" +
+	"\tGovParams\x12\x1f\n" +
+	"\vmin_deposit\x18\x01 \x01(\x04R\n" +
+	"minDeposit\x12%\n" +
+	"\x0edeposit_period\x18\x02 \x01(\x04R\rdepositPeriod\x12#\n" +
+	"\rvoting_period\x18\x03 \x01(\x04R\fvotingPeriod\x12\x1d\n" +
+	"\n" +
+	"quorum_bps\x18\x04 \x01(\rR\tquorumBps\x12#\n" +
+	"\rthreshold_bps\x18\x05 \x01(\rR\fthresholdBps\"\xcf\x01\n" +
+	"\x15ConsensusParamsUpdate\x12&\n" +
+	"\x0fblock_max_bytes\x18\x01 \x01(\x03R\rblockMaxBytes\x12\"\n" +
+	"\rblock_max_gas\x18\x02 \x01(\x03R\vblockMaxGas\x12<\n" +
+	"\x1bevidence_max_age_num_blocks\x18\x03 \x01(\x03R\x17evidenceMaxAgeNumBlocks\x12,\n" +
+	"\x12evidence_max_bytes\x18\x04 \x01(\x03R\x10evidenceMaxBytes\"\x83\x03\n" +
+	"\x17UpdateParamsTransaction\x12)\n" +
+	"\x06params\x18\x01 \x01(\v2\x11.mojave.v1.ParamsR\x06params\x12?\n" +
+	"\x0estaking_params\x18\x02 \x01(\v2\x18.mojave.v1.StakingParamsR\rstakingParams\x12B\n" +
+	"\x0fslashing_params\x18\x03 \x01(\v2\x19.mojave.v1.SlashingParamsR\x0eslashingParams\x126\n" +
+	"\vmint_params\x18\x04 \x01(\v2\x15.mojave.v1.MintParamsR\n" +
+	"mintParams\x12K\n" +
+	"\x10consensus_params\x18\x05 \x01(\v2 .mojave.v1.ConsensusParamsUpdateR\x0fconsensusParams\x123\n" +
+	"\n" +
+	"gov_params\x18\x06 \x01(\v2\x14.mojave.v1.GovParamsR\tgovParams\"\x14\n" +
+	"\x12UpdateParamsResult\"\r\n" +
+	"\vParamsQueryB+Z)github.com/alecsavvy/mojave/gen/mojave/v1b\x06proto3"
+
+var (
+	file_mojave_v1_params_proto_rawDescOnce sync.Once
+	file_mojave_v1_params_proto_rawDescData []byte
+)
+
+func file_mojave_v1_params_proto_rawDescGZIP() []byte {
+	file_mojave_v1_params_proto_rawDescOnce.Do(func() {
+		file_mojave_v1_params_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_mojave_v1_params_proto_rawDesc), len(file_mojave_v1_params_proto_rawDesc)))
+	})
+	return file_mojave_v1_params_proto_rawDescData
+}
+
+var file_mojave_v1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_mojave_v1_params_proto_goTypes = []any{
+	(*Params)(nil),                  // 0: mojave.v1.Params
+	(*GovParams)(nil),               // 1: mojave.v1.GovParams
+	(*ConsensusParamsUpdate)(nil),   // 2: mojave.v1.ConsensusParamsUpdate
+	(*UpdateParamsTransaction)(nil), // 3: mojave.v1.UpdateParamsTransaction
+	(*UpdateParamsResult)(nil),      // 4: mojave.v1.UpdateParamsResult
+	(*ParamsQuery)(nil),             // 5: mojave.v1.ParamsQuery
+	(*StakingParams)(nil),           // 6: mojave.v1.StakingParams
+	(*SlashingParams)(nil),          // 7: mojave.v1.SlashingParams
+	(*MintParams)(nil),              // 8: mojave.v1.MintParams
+}
+var file_mojave_v1_params_proto_depIdxs = []int32{
+	0, // 0: mojave.v1.UpdateParamsTransaction.params:type_name -> mojave.v1.Params
+	6, // 1: mojave.v1.UpdateParamsTransaction.staking_params:type_name -> mojave.v1.StakingParams
+	7, // 2: mojave.v1.UpdateParamsTransaction.slashing_params:type_name -> mojave.v1.SlashingParams
+	8, // 3: mojave.v1.UpdateParamsTransaction.mint_params:type_name -> mojave.v1.MintParams
+	2, // 4: mojave.v1.UpdateParamsTransaction.consensus_params:type_name -> mojave.v1.ConsensusParamsUpdate
+	1, // 5: mojave.v1.UpdateParamsTransaction.gov_params:type_name -> mojave.v1.GovParams
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_mojave_v1_params_proto_init() }
+func file_mojave_v1_params_proto_init() {
+	if File_mojave_v1_params_proto != nil {
+		return
+	}
+	file_mojave_v1_mint_proto_init()
+	file_mojave_v1_slashing_proto_init()
+	file_mojave_v1_staking_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mojave_v1_params_proto_rawDesc), len(file_mojave_v1_params_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mojave_v1_params_proto_goTypes,
+		DependencyIndexes: file_mojave_v1_params_proto_depIdxs,
+		MessageInfos:      file_mojave_v1_params_proto_msgTypes,
+	}.Build()
+	File_mojave_v1_params_proto = out.File
+	file_mojave_v1_params_proto_goTypes = nil
+	file_mojave_v1_params_proto_depIdxs = nil
+}
@@ -0,0 +1,165 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: mojave/v1/proof.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// StateProof proves the value of a store key, or its absence, against the state root.
+type StateProof struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   []byte                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// value is the stored value of the key, empty when proving absence.
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// siblings are the hashes beside the key's path through the tree, root first.
+	Siblings [][]byte `protobuf:"bytes,3,rep,name=siblings,proto3" json:"siblings,omitempty"`
+	// leaf_path and leaf_value_hash describe the leaf the key's path ends at. The key
+	// exists when leaf_path is sha256(key); both are empty when the path ends at an
+	// empty subtree.
+	LeafPath      []byte `protobuf:"bytes,4,opt,name=leaf_path,json=leafPath,proto3" json:"leaf_path,omitempty"`
+	LeafValueHash []byte `protobuf:"bytes,5,opt,name=leaf_value_hash,json=leafValueHash,proto3" json:"leaf_value_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StateProof) Reset() {
+	*x = StateProof{}
+	mi := &file_mojave_v1_proof_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StateProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateProof) ProtoMessage() {}
+
+func (x *StateProof) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_proof_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateProof.ProtoReflect.Descriptor instead.
+func (*StateProof) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_proof_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StateProof) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *StateProof) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *StateProof) GetSiblings() [][]byte {
+	if x != nil {
+		return x.Siblings
+	}
+	return nil
+}
+
+func (x *StateProof) GetLeafPath() []byte {
+	if x != nil {
+		return x.LeafPath
+	}
+	return nil
+}
+
+func (x *StateProof) GetLeafValueHash() []byte {
+	if x != nil {
+		return x.LeafValueHash
+	}
+	return nil
+}
+
+var File_mojave_v1_proof_proto protoreflect.FileDescriptor
+
+const file_mojave_v1_proof_proto_rawDesc = "" +
+	"\n" +
+	"\x15mojave/v1/proof.proto\x12\tmojave.v1\"\x95\x01\n" +
+	"\n" +
+	"StateProof\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\fR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value\x12\x1a\n" +
+	"\bsiblings\x18\x03 \x03(\fR\bsiblings\x12\x1b\n" +
+	"\tleaf_path\x18\x04 \x01(\fR\bleafPath\x12&\n" +
+	"\x0fleaf_value_hash\x18\x05 \x01(\fR\rleafValueHashB+Z)github.com/alecsavvy/mojave/gen/mojave/v1b\x06proto3"
+
+var (
+	file_mojave_v1_proof_proto_rawDescOnce sync.Once
+	file_mojave_v1_proof_proto_rawDescData []byte
+)
+
+func file_mojave_v1_proof_proto_rawDescGZIP() []byte {
+	file_mojave_v1_proof_proto_rawDescOnce.Do(func() {
+		file_mojave_v1_proof_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_mojave_v1_proof_proto_rawDesc), len(file_mojave_v1_proof_proto_rawDesc)))
+	})
+	return file_mojave_v1_proof_proto_rawDescData
+}
+
+var file_mojave_v1_proof_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_mojave_v1_proof_proto_goTypes = []any{
+	(*StateProof)(nil), // 0: mojave.v1.StateProof
+}
+var file_mojave_v1_proof_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_mojave_v1_proof_proto_init() }
+func file_mojave_v1_proof_proto_init() {
+	if File_mojave_v1_proof_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mojave_v1_proof_proto_rawDesc), len(file_mojave_v1_proof_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mojave_v1_proof_proto_goTypes,
+		DependencyIndexes: file_mojave_v1_proof_proto_depIdxs,
+		MessageInfos:      file_mojave_v1_proof_proto_msgTypes,
+	}.Build()
+	File_mojave_v1_proof_proto = out.File
+	file_mojave_v1_proof_proto_goTypes = nil
+	file_mojave_v1_proof_proto_depIdxs = nil
+}
@@ -21,12 +21,81 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// QueryErrorCode is reported as the code of an ABCI query response that has no value.
+type QueryErrorCode int32
+
+const (
+	QueryErrorCode_QUERY_ERROR_CODE_UNSPECIFIED    QueryErrorCode = 0
+	QueryErrorCode_QUERY_ERROR_CODE_NOT_FOUND      QueryErrorCode = 1
+	QueryErrorCode_QUERY_ERROR_CODE_INVALID_HEIGHT QueryErrorCode = 2
+	QueryErrorCode_QUERY_ERROR_CODE_PRUNED_HEIGHT  QueryErrorCode = 3
+)
+
+// Enum value maps for QueryErrorCode.
+var (
+	QueryErrorCode_name = map[int32]string{
+		0: "QUERY_ERROR_CODE_UNSPECIFIED",
+		1: "QUERY_ERROR_CODE_NOT_FOUND",
+		2: "QUERY_ERROR_CODE_INVALID_HEIGHT",
+		3: "QUERY_ERROR_CODE_PRUNED_HEIGHT",
+	}
+	QueryErrorCode_value = map[string]int32{
+		"QUERY_ERROR_CODE_UNSPECIFIED":    0,
+		"QUERY_ERROR_CODE_NOT_FOUND":      1,
+		"QUERY_ERROR_CODE_INVALID_HEIGHT": 2,
+		"QUERY_ERROR_CODE_PRUNED_HEIGHT":  3,
+	}
+)
+
+func (x QueryErrorCode) Enum() *QueryErrorCode {
+	p := new(QueryErrorCode)
+	*p = x
+	return p
+}
+
+func (x QueryErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QueryErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_mojave_v1_query_proto_enumTypes[0].Descriptor()
+}
+
+func (QueryErrorCode) Type() protoreflect.EnumType {
+	return &file_mojave_v1_query_proto_enumTypes[0]
+}
+
+func (x QueryErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QueryErrorCode.Descriptor instead.
+func (QueryErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_mojave_v1_query_proto_rawDescGZIP(), []int{0}
+}
+
 type Query struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Query:
 	//
 	//	*Query_KeyValue
 	//	*Query_Account
+	//	*Query_Content
+	//	*Query_Validators
+	//	*Query_Delegations
+	//	*Query_Unbonding
+	//	*Query_StakingParams
+	//	*Query_MissedBlocks
+	//	*Query_SlashingParams
+	//	*Query_Supply
+	//	*Query_MintParams
+	//	*Query_Params
+	//	*Query_Proposal
+	//	*Query_CommunityPool
+	//	*Query_GovParams
+	//	*Query_UpgradePlan
+	//	*Query_ProposalDeposit
+	//	*Query_ProposalVote
 	Query         isQuery_Query `protobuf_oneof:"query"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -87,6 +156,150 @@ func (x *Query) GetAccount() *AccountStateQuery {
 	return nil
 }
 
+func (x *Query) GetContent() *ContentQuery {
+	if x != nil {
+		if x, ok := x.Query.(*Query_Content); ok {
+			return x.Content
+		}
+	}
+	return nil
+}
+
+func (x *Query) GetValidators() *ValidatorSetQuery {
+	if x != nil {
+		if x, ok := x.Query.(*Query_Validators); ok {
+			return x.Validators
+		}
+	}
+	return nil
+}
+
+func (x *Query) GetDelegations() *DelegationsQuery {
+	if x != nil {
+		if x, ok := x.Query.(*Query_Delegations); ok {
+			return x.Delegations
+		}
+	}
+	return nil
+}
+
+func (x *Query) GetUnbonding() *UnbondingQuery {
+	if x != nil {
+		if x, ok := x.Query.(*Query_Unbonding); ok {
+			return x.Unbonding
+		}
+	}
+	return nil
+}
+
+func (x *Query) GetStakingParams() *StakingParamsQuery {
+	if x != nil {
+		if x, ok := x.Query.(*Query_StakingParams); ok {
+			return x.StakingParams
+		}
+	}
+	return nil
+}
+
+func (x *Query) GetMissedBlocks() *MissedBlocksQuery {
+	if x != nil {
+		if x, ok := x.Query.(*Query_MissedBlocks); ok {
+			return x.MissedBlocks
+		}
+	}
+	return nil
+}
+
+func (x *Query) GetSlashingParams() *SlashingParamsQuery {
+	if x != nil {
+		if x, ok := x.Query.(*Query_SlashingParams); ok {
+			return x.SlashingParams
+		}
+	}
+	return nil
+}
+
+func (x *Query) GetSupply() *SupplyQuery {
+	if x != nil {
+		if x, ok := x.Query.(*Query_Supply); ok {
+			return x.Supply
+		}
+	}
+	return nil
+}
+
+func (x *Query) GetMintParams() *MintParamsQuery {
+	if x != nil {
+		if x, ok := x.Query.(*Query_MintParams); ok {
+			return x.MintParams
+		}
+	}
+	return nil
+}
+
+func (x *Query) GetParams() *ParamsQuery {
+	if x != nil {
+		if x, ok := x.Query.(*Query_Params); ok {
+			return x.Params
+		}
+	}
+	return nil
+}
+
+func (x *Query) GetProposal() *ProposalQuery {
+	if x != nil {
+		if x, ok := x.Query.(*Query_Proposal); ok {
+			return x.Proposal
+		}
+	}
+	return nil
+}
+
+func (x *Query) GetCommunityPool() *CommunityPoolQuery {
+	if x != nil {
+		if x, ok := x.Query.(*Query_CommunityPool); ok {
+			return x.CommunityPool
+		}
+	}
+	return nil
+}
+
+func (x *Query) GetGovParams() *GovParamsQuery {
+	if x != nil {
+		if x, ok := x.Query.(*Query_GovParams); ok {
+			return x.GovParams
+		}
+	}
+	return nil
+}
+
+func (x *Query) GetUpgradePlan() *UpgradePlanQuery {
+	if x != nil {
+		if x, ok := x.Query.(*Query_UpgradePlan); ok {
+			return x.UpgradePlan
+		}
+	}
+	return nil
+}
+
+func (x *Query) GetProposalDeposit() *ProposalDepositQuery {
+	if x != nil {
+		if x, ok := x.Query.(*Query_ProposalDeposit); ok {
+			return x.ProposalDeposit
+		}
+	}
+	return nil
+}
+
+func (x *Query) GetProposalVote() *ProposalVoteQuery {
+	if x != nil {
+		if x, ok := x.Query.(*Query_ProposalVote); ok {
+			return x.ProposalVote
+		}
+	}
+	return nil
+}
+
 type isQuery_Query interface {
 	isQuery_Query()
 }
@@ -99,16 +312,128 @@ type Query_Account struct {
 	Account *AccountStateQuery `protobuf:"bytes,2,opt,name=account,proto3,oneof"`
 }
 
+type Query_Content struct {
+	Content *ContentQuery `protobuf:"bytes,3,opt,name=content,proto3,oneof"`
+}
+
+type Query_Validators struct {
+	Validators *ValidatorSetQuery `protobuf:"bytes,4,opt,name=validators,proto3,oneof"`
+}
+
+type Query_Delegations struct {
+	Delegations *DelegationsQuery `protobuf:"bytes,5,opt,name=delegations,proto3,oneof"`
+}
+
+type Query_Unbonding struct {
+	Unbonding *UnbondingQuery `protobuf:"bytes,6,opt,name=unbonding,proto3,oneof"`
+}
+
+type Query_StakingParams struct {
+	StakingParams *StakingParamsQuery `protobuf:"bytes,7,opt,name=staking_params,json=stakingParams,proto3,oneof"`
+}
+
+type Query_MissedBlocks struct {
+	MissedBlocks *MissedBlocksQuery `protobuf:"bytes,8,opt,name=missed_blocks,json=missedBlocks,proto3,oneof"`
+}
+
+type Query_SlashingParams struct {
+	SlashingParams *SlashingParamsQuery `protobuf:"bytes,9,opt,name=slashing_params,json=slashingParams,proto3,oneof"`
+}
+
+type Query_Supply struct {
+	Supply *SupplyQuery `protobuf:"bytes,10,opt,name=supply,proto3,oneof"`
+}
+
+type Query_MintParams struct {
+	MintParams *MintParamsQuery `protobuf:"bytes,11,opt,name=mint_params,json=mintParams,proto3,oneof"`
+}
+
+type Query_Params struct {
+	Params *ParamsQuery `protobuf:"bytes,12,opt,name=params,proto3,oneof"`
+}
+
+type Query_Proposal struct {
+	Proposal *ProposalQuery `protobuf:"bytes,13,opt,name=proposal,proto3,oneof"`
+}
+
+type Query_CommunityPool struct {
+	CommunityPool *CommunityPoolQuery `protobuf:"bytes,16,opt,name=community_pool,json=communityPool,proto3,oneof"`
+}
+
+type Query_GovParams struct {
+	GovParams *GovParamsQuery `protobuf:"bytes,17,opt,name=gov_params,json=govParams,proto3,oneof"`
+}
+
+type Query_UpgradePlan struct {
+	UpgradePlan *UpgradePlanQuery `protobuf:"bytes,18,opt,name=upgrade_plan,json=upgradePlan,proto3,oneof"`
+}
+
+type Query_ProposalDeposit struct {
+	ProposalDeposit *ProposalDepositQuery `protobuf:"bytes,19,opt,name=proposal_deposit,json=proposalDeposit,proto3,oneof"`
+}
+
+type Query_ProposalVote struct {
+	ProposalVote *ProposalVoteQuery `protobuf:"bytes,20,opt,name=proposal_vote,json=proposalVote,proto3,oneof"`
+}
+
 func (*Query_KeyValue) isQuery_Query() {}
 
 func (*Query_Account) isQuery_Query() {}
 
+func (*Query_Content) isQuery_Query() {}
+
+func (*Query_Validators) isQuery_Query() {}
+
+func (*Query_Delegations) isQuery_Query() {}
+
+func (*Query_Unbonding) isQuery_Query() {}
+
+func (*Query_StakingParams) isQuery_Query() {}
+
+func (*Query_MissedBlocks) isQuery_Query() {}
+
+func (*Query_SlashingParams) isQuery_Query() {}
+
+func (*Query_Supply) isQuery_Query() {}
+
+func (*Query_MintParams) isQuery_Query() {}
+
+func (*Query_Params) isQuery_Query() {}
+
+func (*Query_Proposal) isQuery_Query() {}
+
+func (*Query_CommunityPool) isQuery_Query() {}
+
+func (*Query_GovParams) isQuery_Query() {}
+
+func (*Query_UpgradePlan) isQuery_Query() {}
+
+func (*Query_ProposalDeposit) isQuery_Query() {}
+
+func (*Query_ProposalVote) isQuery_Query() {}
+
 type QueryResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Response:
 	//
 	//	*QueryResponse_KeyValue
 	//	*QueryResponse_Account
+	//	*QueryResponse_Content
+	//	*QueryResponse_Validators
+	//	*QueryResponse_Delegations
+	//	*QueryResponse_Unbonding
+	//	*QueryResponse_StakingParams
+	//	*QueryResponse_MissedBlocks
+	//	*QueryResponse_SlashingParams
+	//	*QueryResponse_Supply
+	//	*QueryResponse_MintParams
+	//	*QueryResponse_Params
+	//	*QueryResponse_Proposal
+	//	*QueryResponse_CommunityPool
+	//	*QueryResponse_GovParams
+	//	*QueryResponse_UpgradePlan
+	//	*QueryResponse_ProposalDeposit
+	//	*QueryResponse_ProposalVote
 	Response      isQueryResponse_Response `protobuf_oneof:"response"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -169,6 +494,150 @@ func (x *QueryResponse) GetAccount() *AccountState {
 	return nil
 }
 
+func (x *QueryResponse) GetContent() *ContentState {
+	if x != nil {
+		if x, ok := x.Response.(*QueryResponse_Content); ok {
+			return x.Content
+		}
+	}
+	return nil
+}
+
+func (x *QueryResponse) GetValidators() *ValidatorSet {
+	if x != nil {
+		if x, ok := x.Response.(*QueryResponse_Validators); ok {
+			return x.Validators
+		}
+	}
+	return nil
+}
+
+func (x *QueryResponse) GetDelegations() *DelegatorState {
+	if x != nil {
+		if x, ok := x.Response.(*QueryResponse_Delegations); ok {
+			return x.Delegations
+		}
+	}
+	return nil
+}
+
+func (x *QueryResponse) GetUnbonding() *UnbondingQueue {
+	if x != nil {
+		if x, ok := x.Response.(*QueryResponse_Unbonding); ok {
+			return x.Unbonding
+		}
+	}
+	return nil
+}
+
+func (x *QueryResponse) GetStakingParams() *StakingParams {
+	if x != nil {
+		if x, ok := x.Response.(*QueryResponse_StakingParams); ok {
+			return x.StakingParams
+		}
+	}
+	return nil
+}
+
+func (x *QueryResponse) GetMissedBlocks() *MissedBlocks {
+	if x != nil {
+		if x, ok := x.Response.(*QueryResponse_MissedBlocks); ok {
+			return x.MissedBlocks
+		}
+	}
+	return nil
+}
+
+func (x *QueryResponse) GetSlashingParams() *SlashingParams {
+	if x != nil {
+		if x, ok := x.Response.(*QueryResponse_SlashingParams); ok {
+			return x.SlashingParams
+		}
+	}
+	return nil
+}
+
+func (x *QueryResponse) GetSupply() *Supply {
+	if x != nil {
+		if x, ok := x.Response.(*QueryResponse_Supply); ok {
+			return x.Supply
+		}
+	}
+	return nil
+}
+
+func (x *QueryResponse) GetMintParams() *MintParams {
+	if x != nil {
+		if x, ok := x.Response.(*QueryResponse_MintParams); ok {
+			return x.MintParams
+		}
+	}
+	return nil
+}
+
+func (x *QueryResponse) GetParams() *Params {
+	if x != nil {
+		if x, ok := x.Response.(*QueryResponse_Params); ok {
+			return x.Params
+		}
+	}
+	return nil
+}
+
+func (x *QueryResponse) GetProposal() *Proposal {
+	if x != nil {
+		if x, ok := x.Response.(*QueryResponse_Proposal); ok {
+			return x.Proposal
+		}
+	}
+	return nil
+}
+
+func (x *QueryResponse) GetCommunityPool() *CommunityPool {
+	if x != nil {
+		if x, ok := x.Response.(*QueryResponse_CommunityPool); ok {
+			return x.CommunityPool
+		}
+	}
+	return nil
+}
+
+func (x *QueryResponse) GetGovParams() *GovParams {
+	if x != nil {
+		if x, ok := x.Response.(*QueryResponse_GovParams); ok {
+			return x.GovParams
+		}
+	}
+	return nil
+}
+
+func (x *QueryResponse) GetUpgradePlan() *UpgradePlan {
+	if x != nil {
+		if x, ok := x.Response.(*QueryResponse_UpgradePlan); ok {
+			return x.UpgradePlan
+		}
+	}
+	return nil
+}
+
+func (x *QueryResponse) GetProposalDeposit() *Deposit {
+	if x != nil {
+		if x, ok := x.Response.(*QueryResponse_ProposalDeposit); ok {
+			return x.ProposalDeposit
+		}
+	}
+	return nil
+}
+
+func (x *QueryResponse) GetProposalVote() *Vote {
+	if x != nil {
+		if x, ok := x.Response.(*QueryResponse_ProposalVote); ok {
+			return x.ProposalVote
+		}
+	}
+	return nil
+}
+
 type isQueryResponse_Response interface {
 	isQueryResponse_Response()
 }
@@ -181,24 +650,167 @@ type QueryResponse_Account struct {
 	Account *AccountState `protobuf:"bytes,2,opt,name=account,proto3,oneof"`
 }
 
+type QueryResponse_Content struct {
+	Content *ContentState `protobuf:"bytes,3,opt,name=content,proto3,oneof"`
+}
+
+type QueryResponse_Validators struct {
+	Validators *ValidatorSet `protobuf:"bytes,4,opt,name=validators,proto3,oneof"`
+}
+
+type QueryResponse_Delegations struct {
+	Delegations *DelegatorState `protobuf:"bytes,5,opt,name=delegations,proto3,oneof"`
+}
+
+type QueryResponse_Unbonding struct {
+	Unbonding *UnbondingQueue `protobuf:"bytes,6,opt,name=unbonding,proto3,oneof"`
+}
+
+type QueryResponse_StakingParams struct {
+	StakingParams *StakingParams `protobuf:"bytes,7,opt,name=staking_params,json=stakingParams,proto3,oneof"`
+}
+
+type QueryResponse_MissedBlocks struct {
+	MissedBlocks *MissedBlocks `protobuf:"bytes,8,opt,name=missed_blocks,json=missedBlocks,proto3,oneof"`
+}
+
+type QueryResponse_SlashingParams struct {
+	SlashingParams *SlashingParams `protobuf:"bytes,9,opt,name=slashing_params,json=slashingParams,proto3,oneof"`
+}
+
+type QueryResponse_Supply struct {
+	Supply *Supply `protobuf:"bytes,10,opt,name=supply,proto3,oneof"`
+}
+
+type QueryResponse_MintParams struct {
+	MintParams *MintParams `protobuf:"bytes,11,opt,name=mint_params,json=mintParams,proto3,oneof"`
+}
+
+type QueryResponse_Params struct {
+	Params *Params `protobuf:"bytes,12,opt,name=params,proto3,oneof"`
+}
+
+type QueryResponse_Proposal struct {
+	Proposal *Proposal `protobuf:"bytes,13,opt,name=proposal,proto3,oneof"`
+}
+
+type QueryResponse_CommunityPool struct {
+	CommunityPool *CommunityPool `protobuf:"bytes,16,opt,name=community_pool,json=communityPool,proto3,oneof"`
+}
+
+type QueryResponse_GovParams struct {
+	GovParams *GovParams `protobuf:"bytes,17,opt,name=gov_params,json=govParams,proto3,oneof"`
+}
+
+type QueryResponse_UpgradePlan struct {
+	UpgradePlan *UpgradePlan `protobuf:"bytes,18,opt,name=upgrade_plan,json=upgradePlan,proto3,oneof"`
+}
+
+type QueryResponse_ProposalDeposit struct {
+	ProposalDeposit *Deposit `protobuf:"bytes,19,opt,name=proposal_deposit,json=proposalDeposit,proto3,oneof"`
+}
+
+type QueryResponse_ProposalVote struct {
+	ProposalVote *Vote `protobuf:"bytes,20,opt,name=proposal_vote,json=proposalVote,proto3,oneof"`
+}
+
 func (*QueryResponse_KeyValue) isQueryResponse_Response() {}
 
 func (*QueryResponse_Account) isQueryResponse_Response() {}
 
+func (*QueryResponse_Content) isQueryResponse_Response() {}
+
+func (*QueryResponse_Validators) isQueryResponse_Response() {}
+
+func (*QueryResponse_Delegations) isQueryResponse_Response() {}
+
+func (*QueryResponse_Unbonding) isQueryResponse_Response() {}
+
+func (*QueryResponse_StakingParams) isQueryResponse_Response() {}
+
+func (*QueryResponse_MissedBlocks) isQueryResponse_Response() {}
+
+func (*QueryResponse_SlashingParams) isQueryResponse_Response() {}
+
+func (*QueryResponse_Supply) isQueryResponse_Response() {}
+
+func (*QueryResponse_MintParams) isQueryResponse_Response() {}
+
+func (*QueryResponse_Params) isQueryResponse_Response() {}
+
+func (*QueryResponse_Proposal) isQueryResponse_Response() {}
+
+func (*QueryResponse_CommunityPool) isQueryResponse_Response() {}
+
+func (*QueryResponse_GovParams) isQueryResponse_Response() {}
+
+func (*QueryResponse_UpgradePlan) isQueryResponse_Response() {}
+
+func (*QueryResponse_ProposalDeposit) isQueryResponse_Response() {}
+
+func (*QueryResponse_ProposalVote) isQueryResponse_Response() {}
+
 var File_mojave_v1_query_proto protoreflect.FileDescriptor
 
 const file_mojave_v1_query_proto_rawDesc = "" +
 	"\n" +
-	"\x15mojave/v1/query.proto\x12\tmojave.v1\x1a\x17mojave/v1/account.proto\x1a\x12mojave/v1/kv.proto\"\x83\x01\n" +
+	"\x15mojave/v1/query.proto\x12\tmojave.v1\x1a\x17mojave/v1/account.proto\x1a\x17mojave/v1/content.proto\x1a\x13mojave/v1/gov.proto\x1a\x12mojave/v1/kv.proto\x1a\x14mojave/v1/mint.proto\x1a\x16mojave/v1/params.proto\x1a\x18mojave/v1/slashing.proto\x1a\x17mojave/v1/staking.proto\x1a\x19mojave/v1/validator.proto\"\x8c\t\n" +
 	"\x05Query\x127\n" +
 	"\tkey_value\x18\x01 \x01(\v2\x18.mojave.v1.KeyValueQueryH\x00R\bkeyValue\x128\n" +
-	"\aaccount\x18\x02 \x01(\v2\x1c.mojave.v1.AccountStateQueryH\x00R\aaccountB\a\n" +
-	"\x05query\"\x89\x01\n" +
+	"\aaccount\x18\x02 \x01(\v2\x1c.mojave.v1.AccountStateQueryH\x00R\aaccount\x123\n" +
+	"\acontent\x18\x03 \x01(\v2\x17.mojave.v1.ContentQueryH\x00R\acontent\x12>\n" +
+	"\n" +
+	"validators\x18\x04 \x01(\v2\x1c.mojave.v1.ValidatorSetQueryH\x00R\n" +
+	"validators\x12?\n" +
+	"\vdelegations\x18\x05 \x01(\v2\x1b.mojave.v1.DelegationsQueryH\x00R\vdelegations\x129\n" +
+	"\tunbonding\x18\x06 \x01(\v2\x19.mojave.v1.UnbondingQueryH\x00R\tunbonding\x12F\n" +
+	"\x0estaking_params\x18\a \x01(\v2\x1d.mojave.v1.StakingParamsQueryH\x00R\rstakingParams\x12C\n" +
+	"\rmissed_blocks\x18\b \x01(\v2\x1c.mojave.v1.MissedBlocksQueryH\x00R\fmissedBlocks\x12I\n" +
+	"\x0fslashing_params\x18\t \x01(\v2\x1e.mojave.v1.SlashingParamsQueryH\x00R\x0eslashingParams\x120\n" +
+	"\x06supply\x18\n" +
+	" \x01(\v2\x16.mojave.v1.SupplyQueryH\x00R\x06supply\x12=\n" +
+	"\vmint_params\x18\v \x01(\v2\x1a.mojave.v1.MintParamsQueryH\x00R\n" +
+	"mintParams\x120\n" +
+	"\x06params\x18\f \x01(\v2\x16.mojave.v1.ParamsQueryH\x00R\x06params\x126\n" +
+	"\bproposal\x18\r \x01(\v2\x18.mojave.v1.ProposalQueryH\x00R\bproposal\x12F\n" +
+	"\x0ecommunity_pool\x18\x10 \x01(\v2\x1d.mojave.v1.CommunityPoolQueryH\x00R\rcommunityPool\x12:\n" +
+	"\n" +
+	"gov_params\x18\x11 \x01(\v2\x19.mojave.v1.GovParamsQueryH\x00R\tgovParams\x12@\n" +
+	"\fupgrade_plan\x18\x12 \x01(\v2\x1b.mojave.v1.UpgradePlanQueryH\x00R\vupgradePlan\x12L\n" +
+	"\x10proposal_deposit\x18\x13 \x01(\v2\x1f.mojave.v1.ProposalDepositQueryH\x00R\x0fproposalDeposit\x12C\n" +
+	"\rproposal_vote\x18\x14 \x01(\v2\x1c.mojave.v1.ProposalVoteQueryH\x00R\fproposalVoteB\a\n" +
+	"\x05queryJ\x04\b\x0e\x10\x0fJ\x04\b\x0f\x10\x10\"\xbf\b\n" +
 	"\rQueryResponse\x127\n" +
 	"\tkey_value\x18\x01 \x01(\v2\x18.mojave.v1.KeyValueStateH\x00R\bkeyValue\x123\n" +
-	"\aaccount\x18\x02 \x01(\v2\x17.mojave.v1.AccountStateH\x00R\aaccountB\n" +
+	"\aaccount\x18\x02 \x01(\v2\x17.mojave.v1.AccountStateH\x00R\aaccount\x123\n" +
+	"\acontent\x18\x03 \x01(\v2\x17.mojave.v1.ContentStateH\x00R\acontent\x129\n" +
+	"\n" +
+	"validators\x18\x04 \x01(\v2\x17.mojave.v1.ValidatorSetH\x00R\n" +
+	"validators\x12=\n" +
+	"\vdelegations\x18\x05 \x01(\v2\x19.mojave.v1.DelegatorStateH\x00R\vdelegations\x129\n" +
+	"\tunbonding\x18\x06 \x01(\v2\x19.mojave.v1.UnbondingQueueH\x00R\tunbonding\x12A\n" +
+	"\x0estaking_params\x18\a \x01(\v2\x18.mojave.v1.StakingParamsH\x00R\rstakingParams\x12>\n" +
+	"\rmissed_blocks\x18\b \x01(\v2\x17.mojave.v1.MissedBlocksH\x00R\fmissedBlocks\x12D\n" +
+	"\x0fslashing_params\x18\t \x01(\v2\x19.mojave.v1.SlashingParamsH\x00R\x0eslashingParams\x12+\n" +
+	"\x06supply\x18\n" +
+	" \x01(\v2\x11.mojave.v1.SupplyH\x00R\x06supply\x128\n" +
+	"\vmint_params\x18\v \x01(\v2\x15.mojave.v1.MintParamsH\x00R\n" +
+	"mintParams\x12+\n" +
+	"\x06params\x18\f \x01(\v2\x11.mojave.v1.ParamsH\x00R\x06params\x121\n" +
+	"\bproposal\x18\r \x01(\v2\x13.mojave.v1.ProposalH\x00R\bproposal\x12A\n" +
+	"\x0ecommunity_pool\x18\x10 \x01(\v2\x18.mojave.v1.CommunityPoolH\x00R\rcommunityPool\x125\n" +
+	"\n" +
+	"gov_params\x18\x11 \x01(\v2\x14.mojave.v1.GovParamsH\x00R\tgovParams\x12;\n" +
+	"\fupgrade_plan\x18\x12 \x01(\v2\x16.mojave.v1.UpgradePlanH\x00R\vupgradePlan\x12?\n" +
+	"\x10proposal_deposit\x18\x13 \x01(\v2\x12.mojave.v1.DepositH\x00R\x0fproposalDeposit\x126\n" +
+	"\rproposal_vote\x18\x14 \x01(\v2\x0f.mojave.v1.VoteH\x00R\fproposalVoteB\n" +
 	"\n" +
-	"\bresponseB+Z)github.com/alecsavvy/mojave/gen/mojave/v1b\x06proto3"
+	"\bresponseJ\x04\b\x0e\x10\x0fJ\x04\b\x0f\x10\x10*\x9b\x01\n" +
+	"\x0eQueryErrorCode\x12 \n" +
+	"\x1cQUERY_ERROR_CODE_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aQUERY_ERROR_CODE_NOT_FOUND\x10\x01\x12#\n" +
+	"\x1fQUERY_ERROR_CODE_INVALID_HEIGHT\x10\x02\x12\"\n" +
+	"\x1eQUERY_ERROR_CODE_PRUNED_HEIGHT\x10\x03B+Z)github.com/alecsavvy/mojave/gen/mojave/v1b\x06proto3"
 
 var (
 	file_mojave_v1_query_proto_rawDescOnce sync.Once
@@ -212,25 +824,91 @@ func file_mojave_v1_query_proto_rawDescGZIP() []byte {
 	return file_mojave_v1_query_proto_rawDescData
 }
 
+var file_mojave_v1_query_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_mojave_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_mojave_v1_query_proto_goTypes = []any{
-	(*Query)(nil),             // 0: mojave.v1.Query
-	(*QueryResponse)(nil),     // 1: mojave.v1.QueryResponse
-	(*KeyValueQuery)(nil),     // 2: mojave.v1.KeyValueQuery
-	(*AccountStateQuery)(nil), // 3: mojave.v1.AccountStateQuery
-	(*KeyValueState)(nil),     // 4: mojave.v1.KeyValueState
-	(*AccountState)(nil),      // 5: mojave.v1.AccountState
+	(QueryErrorCode)(0),          // 0: mojave.v1.QueryErrorCode
+	(*Query)(nil),                // 1: mojave.v1.Query
+	(*QueryResponse)(nil),        // 2: mojave.v1.QueryResponse
+	(*KeyValueQuery)(nil),        // 3: mojave.v1.KeyValueQuery
+	(*AccountStateQuery)(nil),    // 4: mojave.v1.AccountStateQuery
+	(*ContentQuery)(nil),         // 5: mojave.v1.ContentQuery
+	(*ValidatorSetQuery)(nil),    // 6: mojave.v1.ValidatorSetQuery
+	(*DelegationsQuery)(nil),     // 7: mojave.v1.DelegationsQuery
+	(*UnbondingQuery)(nil),       // 8: mojave.v1.UnbondingQuery
+	(*StakingParamsQuery)(nil),   // 9: mojave.v1.StakingParamsQuery
+	(*MissedBlocksQuery)(nil),    // 10: mojave.v1.MissedBlocksQuery
+	(*SlashingParamsQuery)(nil),  // 11: mojave.v1.SlashingParamsQuery
+	(*SupplyQuery)(nil),          // 12: mojave.v1.SupplyQuery
+	(*MintParamsQuery)(nil),      // 13: mojave.v1.MintParamsQuery
+	(*ParamsQuery)(nil),          // 14: mojave.v1.ParamsQuery
+	(*ProposalQuery)(nil),        // 15: mojave.v1.ProposalQuery
+	(*CommunityPoolQuery)(nil),   // 16: mojave.v1.CommunityPoolQuery
+	(*GovParamsQuery)(nil),       // 17: mojave.v1.GovParamsQuery
+	(*UpgradePlanQuery)(nil),     // 18: mojave.v1.UpgradePlanQuery
+	(*ProposalDepositQuery)(nil), // 19: mojave.v1.ProposalDepositQuery
+	(*ProposalVoteQuery)(nil),    // 20: mojave.v1.ProposalVoteQuery
+	(*KeyValueState)(nil),        // 21: mojave.v1.KeyValueState
+	(*AccountState)(nil),         // 22: mojave.v1.AccountState
+	(*ContentState)(nil),         // 23: mojave.v1.ContentState
+	(*ValidatorSet)(nil),         // 24: mojave.v1.ValidatorSet
+	(*DelegatorState)(nil),       // 25: mojave.v1.DelegatorState
+	(*UnbondingQueue)(nil),       // 26: mojave.v1.UnbondingQueue
+	(*StakingParams)(nil),        // 27: mojave.v1.StakingParams
+	(*MissedBlocks)(nil),         // 28: mojave.v1.MissedBlocks
+	(*SlashingParams)(nil),       // 29: mojave.v1.SlashingParams
+	(*Supply)(nil),               // 30: mojave.v1.Supply
+	(*MintParams)(nil),           // 31: mojave.v1.MintParams
+	(*Params)(nil),               // 32: mojave.v1.Params
+	(*Proposal)(nil),             // 33: mojave.v1.Proposal
+	(*CommunityPool)(nil),        // 34: mojave.v1.CommunityPool
+	(*GovParams)(nil),            // 35: mojave.v1.GovParams
+	(*UpgradePlan)(nil),          // 36: mojave.v1.UpgradePlan
+	(*Deposit)(nil),              // 37: mojave.v1.Deposit
+	(*Vote)(nil),                 // 38: mojave.v1.Vote
 }
 var file_mojave_v1_query_proto_depIdxs = []int32{
-	2, // 0: mojave.v1.Query.key_value:type_name -> mojave.v1.KeyValueQuery
-	3, // 1: mojave.v1.Query.account:type_name -> mojave.v1.AccountStateQuery
-	4, // 2: mojave.v1.QueryResponse.key_value:type_name -> mojave.v1.KeyValueState
-	5, // 3: mojave.v1.QueryResponse.account:type_name -> mojave.v1.AccountState
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	3,  // 0: mojave.v1.Query.key_value:type_name -> mojave.v1.KeyValueQuery
+	4,  // 1: mojave.v1.Query.account:type_name -> mojave.v1.AccountStateQuery
+	5,  // 2: mojave.v1.Query.content:type_name -> mojave.v1.ContentQuery
+	6,  // 3: mojave.v1.Query.validators:type_name -> mojave.v1.ValidatorSetQuery
+	7,  // 4: mojave.v1.Query.delegations:type_name -> mojave.v1.DelegationsQuery
+	8,  // 5: mojave.v1.Query.unbonding:type_name -> mojave.v1.UnbondingQuery
+	9,  // 6: mojave.v1.Query.staking_params:type_name -> mojave.v1.StakingParamsQuery
+	10, // 7: mojave.v1.Query.missed_blocks:type_name -> mojave.v1.MissedBlocksQuery
+	11, // 8: mojave.v1.Query.slashing_params:type_name -> mojave.v1.SlashingParamsQuery
+	12, // 9: mojave.v1.Query.supply:type_name -> mojave.v1.SupplyQuery
+	13, // 10: mojave.v1.Query.mint_params:type_name -> mojave.v1.MintParamsQuery
+	14, // 11: mojave.v1.Query.params:type_name -> mojave.v1.ParamsQuery
+	15, // 12: mojave.v1.Query.proposal:type_name -> mojave.v1.ProposalQuery
+	16, // 13: mojave.v1.Query.community_pool:type_name -> mojave.v1.CommunityPoolQuery
+	17, // 14: mojave.v1.Query.gov_params:type_name -> mojave.v1.GovParamsQuery
+	18, // 15: mojave.v1.Query.upgrade_plan:type_name -> mojave.v1.UpgradePlanQuery
+	19, // 16: mojave.v1.Query.proposal_deposit:type_name -> mojave.v1.ProposalDepositQuery
+	20, // 17: mojave.v1.Query.proposal_vote:type_name -> mojave.v1.ProposalVoteQuery
+	21, // 18: mojave.v1.QueryResponse.key_value:type_name -> mojave.v1.KeyValueState
+	22, // 19: mojave.v1.QueryResponse.account:type_name -> mojave.v1.AccountState
+	23, // 20: mojave.v1.QueryResponse.content:type_name -> mojave.v1.ContentState
+	24, // 21: mojave.v1.QueryResponse.validators:type_name -> mojave.v1.ValidatorSet
+	25, // 22: mojave.v1.QueryResponse.delegations:type_name -> mojave.v1.DelegatorState
+	26, // 23: mojave.v1.QueryResponse.unbonding:type_name -> mojave.v1.UnbondingQueue
+	27, // 24: mojave.v1.QueryResponse.staking_params:type_name -> mojave.v1.StakingParams
+	28, // 25: mojave.v1.QueryResponse.missed_blocks:type_name -> mojave.v1.MissedBlocks
+	29, // 26: mojave.v1.QueryResponse.slashing_params:type_name -> mojave.v1.SlashingParams
+	30, // 27: mojave.v1.QueryResponse.supply:type_name -> mojave.v1.Supply
+	31, // 28: mojave.v1.QueryResponse.mint_params:type_name -> mojave.v1.MintParams
+	32, // 29: mojave.v1.QueryResponse.params:type_name -> mojave.v1.Params
+	33, // 30: mojave.v1.QueryResponse.proposal:type_name -> mojave.v1.Proposal
+	34, // 31: mojave.v1.QueryResponse.community_pool:type_name -> mojave.v1.CommunityPool
+	35, // 32: mojave.v1.QueryResponse.gov_params:type_name -> mojave.v1.GovParams
+	36, // 33: mojave.v1.QueryResponse.upgrade_plan:type_name -> mojave.v1.UpgradePlan
+	37, // 34: mojave.v1.QueryResponse.proposal_deposit:type_name -> mojave.v1.Deposit
+	38, // 35: mojave.v1.QueryResponse.proposal_vote:type_name -> mojave.v1.Vote
+	36, // [36:36] is the sub-list for method output_type
+	36, // [36:36] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
 }
 
 func init() { file_mojave_v1_query_proto_init() }
@@ -239,27 +917,67 @@ func file_mojave_v1_query_proto_init() {
 		return
 	}
 	file_mojave_v1_account_proto_init()
+	file_mojave_v1_content_proto_init()
+	file_mojave_v1_gov_proto_init()
 	file_mojave_v1_kv_proto_init()
+	file_mojave_v1_mint_proto_init()
+	file_mojave_v1_params_proto_init()
+	file_mojave_v1_slashing_proto_init()
+	file_mojave_v1_staking_proto_init()
+	file_mojave_v1_validator_proto_init()
 	file_mojave_v1_query_proto_msgTypes[0].OneofWrappers = []any{
 		(*Query_KeyValue)(nil),
 		(*Query_Account)(nil),
+		(*Query_Content)(nil),
+		(*Query_Validators)(nil),
+		(*Query_Delegations)(nil),
+		(*Query_Unbonding)(nil),
+		(*Query_StakingParams)(nil),
+		(*Query_MissedBlocks)(nil),
+		(*Query_SlashingParams)(nil),
+		(*Query_Supply)(nil),
+		(*Query_MintParams)(nil),
+		(*Query_Params)(nil),
+		(*Query_Proposal)(nil),
+		(*Query_CommunityPool)(nil),
+		(*Query_GovParams)(nil),
+		(*Query_UpgradePlan)(nil),
+		(*Query_ProposalDeposit)(nil),
+		(*Query_ProposalVote)(nil),
 	}
 	file_mojave_v1_query_proto_msgTypes[1].OneofWrappers = []any{
 		(*QueryResponse_KeyValue)(nil),
 		(*QueryResponse_Account)(nil),
+		(*QueryResponse_Content)(nil),
+		(*QueryResponse_Validators)(nil),
+		(*QueryResponse_Delegations)(nil),
+		(*QueryResponse_Unbonding)(nil),
+		(*QueryResponse_StakingParams)(nil),
+		(*QueryResponse_MissedBlocks)(nil),
+		(*QueryResponse_SlashingParams)(nil),
+		(*QueryResponse_Supply)(nil),
+		(*QueryResponse_MintParams)(nil),
+		(*QueryResponse_Params)(nil),
+		(*QueryResponse_Proposal)(nil),
+		(*QueryResponse_CommunityPool)(nil),
+		(*QueryResponse_GovParams)(nil),
+		(*QueryResponse_UpgradePlan)(nil),
+		(*QueryResponse_ProposalDeposit)(nil),
+		(*QueryResponse_ProposalVote)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mojave_v1_query_proto_rawDesc), len(file_mojave_v1_query_proto_rawDesc)),
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_mojave_v1_query_proto_goTypes,
 		DependencyIndexes: file_mojave_v1_query_proto_depIdxs,
+		EnumInfos:         file_mojave_v1_query_proto_enumTypes,
 		MessageInfos:      file_mojave_v1_query_proto_msgTypes,
 	}.Build()
 	File_mojave_v1_query_proto = out.File
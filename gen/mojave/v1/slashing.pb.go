@@ -0,0 +1,578 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: mojave/v1/slashing.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SlashingParams struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// signed_blocks_window is the number of most recent blocks a validator's liveness is
+	// judged over.
+	SignedBlocksWindow uint64 `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
+	// min_signed_bps is the share of the window, in basis points, a validator must sign to
+	// stay out of jail.
+	MinSignedBps uint32 `protobuf:"varint,2,opt,name=min_signed_bps,json=minSignedBps,proto3" json:"min_signed_bps,omitempty"`
+	// downtime_slash_bps and double_sign_slash_bps are the share of a validator's bonded
+	// tokens, in basis points, burned when it is jailed for downtime or double signing.
+	DowntimeSlashBps   uint32 `protobuf:"varint,3,opt,name=downtime_slash_bps,json=downtimeSlashBps,proto3" json:"downtime_slash_bps,omitempty"`
+	DoubleSignSlashBps uint32 `protobuf:"varint,4,opt,name=double_sign_slash_bps,json=doubleSignSlashBps,proto3" json:"double_sign_slash_bps,omitempty"`
+	// jail_period is the number of blocks a jailed validator must wait before it can unjail.
+	JailPeriod    uint64 `protobuf:"varint,5,opt,name=jail_period,json=jailPeriod,proto3" json:"jail_period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlashingParams) Reset() {
+	*x = SlashingParams{}
+	mi := &file_mojave_v1_slashing_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlashingParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlashingParams) ProtoMessage() {}
+
+func (x *SlashingParams) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_slashing_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlashingParams.ProtoReflect.Descriptor instead.
+func (*SlashingParams) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_slashing_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SlashingParams) GetSignedBlocksWindow() uint64 {
+	if x != nil {
+		return x.SignedBlocksWindow
+	}
+	return 0
+}
+
+func (x *SlashingParams) GetMinSignedBps() uint32 {
+	if x != nil {
+		return x.MinSignedBps
+	}
+	return 0
+}
+
+func (x *SlashingParams) GetDowntimeSlashBps() uint32 {
+	if x != nil {
+		return x.DowntimeSlashBps
+	}
+	return 0
+}
+
+func (x *SlashingParams) GetDoubleSignSlashBps() uint32 {
+	if x != nil {
+		return x.DoubleSignSlashBps
+	}
+	return 0
+}
+
+func (x *SlashingParams) GetJailPeriod() uint64 {
+	if x != nil {
+		return x.JailPeriod
+	}
+	return 0
+}
+
+// MissedBlocks holds the heights within the signed blocks window at which a validator did
+// not sign the commit.
+type MissedBlocks struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ValidatorAddress []byte                 `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Heights          []uint64               `protobuf:"varint,2,rep,packed,name=heights,proto3" json:"heights,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MissedBlocks) Reset() {
+	*x = MissedBlocks{}
+	mi := &file_mojave_v1_slashing_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MissedBlocks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissedBlocks) ProtoMessage() {}
+
+func (x *MissedBlocks) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_slashing_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissedBlocks.ProtoReflect.Descriptor instead.
+func (*MissedBlocks) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_slashing_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MissedBlocks) GetValidatorAddress() []byte {
+	if x != nil {
+		return x.ValidatorAddress
+	}
+	return nil
+}
+
+func (x *MissedBlocks) GetHeights() []uint64 {
+	if x != nil {
+		return x.Heights
+	}
+	return nil
+}
+
+// UnjailTransaction returns the signer's jailed validator to the set once its jail period
+// is over.
+type UnjailTransaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnjailTransaction) Reset() {
+	*x = UnjailTransaction{}
+	mi := &file_mojave_v1_slashing_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnjailTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnjailTransaction) ProtoMessage() {}
+
+func (x *UnjailTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_slashing_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnjailTransaction.ProtoReflect.Descriptor instead.
+func (*UnjailTransaction) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_slashing_proto_rawDescGZIP(), []int{2}
+}
+
+type UnjailResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnjailResult) Reset() {
+	*x = UnjailResult{}
+	mi := &file_mojave_v1_slashing_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnjailResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnjailResult) ProtoMessage() {}
+
+func (x *UnjailResult) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_slashing_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnjailResult.ProtoReflect.Descriptor instead.
+func (*UnjailResult) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_slashing_proto_rawDescGZIP(), []int{3}
+}
+
+// ValidatorSlashedEvent is emitted when a validator is slashed for misbehavior at
+// infraction_height. burned is what was burned of the tokens bonded to and unbonding from it.
+type ValidatorSlashedEvent struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ValidatorPubkey  []byte                 `protobuf:"bytes,1,opt,name=validator_pubkey,json=validatorPubkey,proto3" json:"validator_pubkey,omitempty"`
+	Reason           string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	InfractionHeight int64                  `protobuf:"varint,3,opt,name=infraction_height,json=infractionHeight,proto3" json:"infraction_height,omitempty"`
+	Burned           uint64                 `protobuf:"varint,4,opt,name=burned,proto3" json:"burned,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ValidatorSlashedEvent) Reset() {
+	*x = ValidatorSlashedEvent{}
+	mi := &file_mojave_v1_slashing_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidatorSlashedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorSlashedEvent) ProtoMessage() {}
+
+func (x *ValidatorSlashedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_slashing_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorSlashedEvent.ProtoReflect.Descriptor instead.
+func (*ValidatorSlashedEvent) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_slashing_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ValidatorSlashedEvent) GetValidatorPubkey() []byte {
+	if x != nil {
+		return x.ValidatorPubkey
+	}
+	return nil
+}
+
+func (x *ValidatorSlashedEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ValidatorSlashedEvent) GetInfractionHeight() int64 {
+	if x != nil {
+		return x.InfractionHeight
+	}
+	return 0
+}
+
+func (x *ValidatorSlashedEvent) GetBurned() uint64 {
+	if x != nil {
+		return x.Burned
+	}
+	return 0
+}
+
+// ValidatorJailedEvent is emitted when a validator is jailed. It can unjail from jailed_until on.
+type ValidatorJailedEvent struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ValidatorPubkey []byte                 `protobuf:"bytes,1,opt,name=validator_pubkey,json=validatorPubkey,proto3" json:"validator_pubkey,omitempty"`
+	JailedUntil     uint64                 `protobuf:"varint,2,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ValidatorJailedEvent) Reset() {
+	*x = ValidatorJailedEvent{}
+	mi := &file_mojave_v1_slashing_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidatorJailedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorJailedEvent) ProtoMessage() {}
+
+func (x *ValidatorJailedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_slashing_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorJailedEvent.ProtoReflect.Descriptor instead.
+func (*ValidatorJailedEvent) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_slashing_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ValidatorJailedEvent) GetValidatorPubkey() []byte {
+	if x != nil {
+		return x.ValidatorPubkey
+	}
+	return nil
+}
+
+func (x *ValidatorJailedEvent) GetJailedUntil() uint64 {
+	if x != nil {
+		return x.JailedUntil
+	}
+	return 0
+}
+
+// PunishedEvidence records evidence of misbehavior a validator was punished for, so the same
+// evidence is never punished twice.
+type PunishedEvidence struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ValidatorAddress []byte                 `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Height           int64                  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PunishedEvidence) Reset() {
+	*x = PunishedEvidence{}
+	mi := &file_mojave_v1_slashing_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PunishedEvidence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PunishedEvidence) ProtoMessage() {}
+
+func (x *PunishedEvidence) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_slashing_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PunishedEvidence.ProtoReflect.Descriptor instead.
+func (*PunishedEvidence) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_slashing_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PunishedEvidence) GetValidatorAddress() []byte {
+	if x != nil {
+		return x.ValidatorAddress
+	}
+	return nil
+}
+
+func (x *PunishedEvidence) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type MissedBlocksQuery struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ValidatorAddress []byte                 `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MissedBlocksQuery) Reset() {
+	*x = MissedBlocksQuery{}
+	mi := &file_mojave_v1_slashing_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MissedBlocksQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissedBlocksQuery) ProtoMessage() {}
+
+func (x *MissedBlocksQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_slashing_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissedBlocksQuery.ProtoReflect.Descriptor instead.
+func (*MissedBlocksQuery) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_slashing_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MissedBlocksQuery) GetValidatorAddress() []byte {
+	if x != nil {
+		return x.ValidatorAddress
+	}
+	return nil
+}
+
+type SlashingParamsQuery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlashingParamsQuery) Reset() {
+	*x = SlashingParamsQuery{}
+	mi := &file_mojave_v1_slashing_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlashingParamsQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlashingParamsQuery) ProtoMessage() {}
+
+func (x *SlashingParamsQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_slashing_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlashingParamsQuery.ProtoReflect.Descriptor instead.
+func (*SlashingParamsQuery) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_slashing_proto_rawDescGZIP(), []int{8}
+}
+
+var File_mojave_v1_slashing_proto protoreflect.FileDescriptor
+
+const file_mojave_v1_slashing_proto_rawDesc = "" +
+	"\n" +
+	"\x18mojave/v1/slashing.proto\x12\tmojave.v1\"\xea\x01\n" +
+	"\x0eSlashingParams\x120\n" +
+	"\x14signed_blocks_window\x18\x01 \x01(\x04R\x12signedBlocksWindow\x12$\n" +
+	"\x0emin_signed_bps\x18\x02 \x01(\rR\fminSignedBps\x12,\n" +
+	"\x12downtime_slash_bps\x18\x03 \x01(\rR\x10downtimeSlashBps\x121\n" +
+	"\x15double_sign_slash_bps\x18\x04 \x01(\rR\x12doubleSignSlashBps\x12\x1f\n" +
+	"\vjail_period\x18\x05 \x01(\x04R\n" +
+	"jailPeriod\"U\n" +
+	"\fMissedBlocks\x12+\n" +
+	"\x11validator_address\x18\x01 \x01(\fR\x10validatorAddress\x12\x18\n" +
+	"\aheights\x18\x02 \x03(\x04R\aheights\"\x13\n" +
+	"\x11UnjailTransaction\"\x0e\n" +
+	"\fUnjailResult\"\x9f\x01\n" +
+	"\x15ValidatorSlashedEvent\x12)\n" +
+	"\x10validator_pubkey\x18\x01 \x01(\fR\x0fvalidatorPubkey\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12+\n" +
+	"\x11infraction_height\x18\x03 \x01(\x03R\x10infractionHeight\x12\x16\n" +
+	"\x06burned\x18\x04 \x01(\x04R\x06burned\"d\n" +
+	"\x14ValidatorJailedEvent\x12)\n" +
+	"\x10validator_pubkey\x18\x01 \x01(\fR\x0fvalidatorPubkey\x12!\n" +
+	"\fjailed_until\x18\x02 \x01(\x04R\vjailedUntil\"W\n" +
+	"\x10PunishedEvidence\x12+\n" +
+	"\x11validator_address\x18\x01 \x01(\fR\x10validatorAddress\x12\x16\n" +
+	"\x06height\x18\x02 \x01(\x03R\x06height\"@\n" +
+	"\x11MissedBlocksQuery\x12+\n" +
+	"\x11validator_address\x18\x01 \x01(\fR\x10validatorAddress\"\x15\n" +
+	"\x13SlashingParamsQueryB+Z)github.com/alecsavvy/mojave/gen/mojave/v1b\x06proto3"
+
+var (
+	file_mojave_v1_slashing_proto_rawDescOnce sync.Once
+	file_mojave_v1_slashing_proto_rawDescData []byte
+)
+
+func file_mojave_v1_slashing_proto_rawDescGZIP() []byte {
+	file_mojave_v1_slashing_proto_rawDescOnce.Do(func() {
+		file_mojave_v1_slashing_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_mojave_v1_slashing_proto_rawDesc), len(file_mojave_v1_slashing_proto_rawDesc)))
+	})
+	return file_mojave_v1_slashing_proto_rawDescData
+}
+
+var file_mojave_v1_slashing_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_mojave_v1_slashing_proto_goTypes = []any{
+	(*SlashingParams)(nil),        // 0: mojave.v1.SlashingParams
+	(*MissedBlocks)(nil),          // 1: mojave.v1.MissedBlocks
+	(*UnjailTransaction)(nil),     // 2: mojave.v1.UnjailTransaction
+	(*UnjailResult)(nil),          // 3: mojave.v1.UnjailResult
+	(*ValidatorSlashedEvent)(nil), // 4: mojave.v1.ValidatorSlashedEvent
+	(*ValidatorJailedEvent)(nil),  // 5: mojave.v1.ValidatorJailedEvent
+	(*PunishedEvidence)(nil),      // 6: mojave.v1.PunishedEvidence
+	(*MissedBlocksQuery)(nil),     // 7: mojave.v1.MissedBlocksQuery
+	(*SlashingParamsQuery)(nil),   // 8: mojave.v1.SlashingParamsQuery
+}
+var file_mojave_v1_slashing_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_mojave_v1_slashing_proto_init() }
+func file_mojave_v1_slashing_proto_init() {
+	if File_mojave_v1_slashing_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mojave_v1_slashing_proto_rawDesc), len(file_mojave_v1_slashing_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mojave_v1_slashing_proto_goTypes,
+		DependencyIndexes: file_mojave_v1_slashing_proto_depIdxs,
+		MessageInfos:      file_mojave_v1_slashing_proto_msgTypes,
+	}.Build()
+	File_mojave_v1_slashing_proto = out.File
+	file_mojave_v1_slashing_proto_goTypes = nil
+	file_mojave_v1_slashing_proto_depIdxs = nil
+}
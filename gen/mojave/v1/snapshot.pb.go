@@ -0,0 +1,125 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: mojave/v1/snapshot.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SnapshotMetadata is carried in the metadata of a state sync snapshot. The snapshot hash is the
+// sha256 of the encoded metadata, so verifying it covers every chunk.
+type SnapshotMetadata struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// chunk_hashes are the sha256 hashes of the snapshot's chunks, in order.
+	ChunkHashes   [][]byte `protobuf:"bytes,1,rep,name=chunk_hashes,json=chunkHashes,proto3" json:"chunk_hashes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnapshotMetadata) Reset() {
+	*x = SnapshotMetadata{}
+	mi := &file_mojave_v1_snapshot_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotMetadata) ProtoMessage() {}
+
+func (x *SnapshotMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_snapshot_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotMetadata.ProtoReflect.Descriptor instead.
+func (*SnapshotMetadata) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_snapshot_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SnapshotMetadata) GetChunkHashes() [][]byte {
+	if x != nil {
+		return x.ChunkHashes
+	}
+	return nil
+}
+
+var File_mojave_v1_snapshot_proto protoreflect.FileDescriptor
+
+const file_mojave_v1_snapshot_proto_rawDesc = "" +
+	"\n" +
+	"\x18mojave/v1/snapshot.proto\x12\tmojave.v1\"5\n" +
+	"\x10SnapshotMetadata\x12!\n" +
+	"\fchunk_hashes\x18\x01 \x03(\fR\vchunkHashesB+Z)github.com/alecsavvy/mojave/gen/mojave/v1b\x06proto3"
+
+var (
+	file_mojave_v1_snapshot_proto_rawDescOnce sync.Once
+	file_mojave_v1_snapshot_proto_rawDescData []byte
+)
+
+func file_mojave_v1_snapshot_proto_rawDescGZIP() []byte {
+	file_mojave_v1_snapshot_proto_rawDescOnce.Do(func() {
+		file_mojave_v1_snapshot_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_mojave_v1_snapshot_proto_rawDesc), len(file_mojave_v1_snapshot_proto_rawDesc)))
+	})
+	return file_mojave_v1_snapshot_proto_rawDescData
+}
+
+var file_mojave_v1_snapshot_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_mojave_v1_snapshot_proto_goTypes = []any{
+	(*SnapshotMetadata)(nil), // 0: mojave.v1.SnapshotMetadata
+}
+var file_mojave_v1_snapshot_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_mojave_v1_snapshot_proto_init() }
+func file_mojave_v1_snapshot_proto_init() {
+	if File_mojave_v1_snapshot_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mojave_v1_snapshot_proto_rawDesc), len(file_mojave_v1_snapshot_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mojave_v1_snapshot_proto_goTypes,
+		DependencyIndexes: file_mojave_v1_snapshot_proto_depIdxs,
+		MessageInfos:      file_mojave_v1_snapshot_proto_msgTypes,
+	}.Build()
+	File_mojave_v1_snapshot_proto = out.File
+	file_mojave_v1_snapshot_proto_goTypes = nil
+	file_mojave_v1_snapshot_proto_depIdxs = nil
+}
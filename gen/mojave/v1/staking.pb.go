@@ -0,0 +1,835 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: mojave/v1/staking.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StakingParams struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// unbonding_period is the number of blocks unbonded tokens stay locked before they return
+	// to the delegator's balance.
+	UnbondingPeriod uint64 `protobuf:"varint,1,opt,name=unbonding_period,json=unbondingPeriod,proto3" json:"unbonding_period,omitempty"`
+	// tokens_per_power is the number of bonded tokens that make up one unit of voting power.
+	TokensPerPower uint64 `protobuf:"varint,2,opt,name=tokens_per_power,json=tokensPerPower,proto3" json:"tokens_per_power,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StakingParams) Reset() {
+	*x = StakingParams{}
+	mi := &file_mojave_v1_staking_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StakingParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakingParams) ProtoMessage() {}
+
+func (x *StakingParams) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_staking_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakingParams.ProtoReflect.Descriptor instead.
+func (*StakingParams) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_staking_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StakingParams) GetUnbondingPeriod() uint64 {
+	if x != nil {
+		return x.UnbondingPeriod
+	}
+	return 0
+}
+
+func (x *StakingParams) GetTokensPerPower() uint64 {
+	if x != nil {
+		return x.TokensPerPower
+	}
+	return 0
+}
+
+// Delegation is a delegator's stake in a validator. It holds shares of the validator's
+// bonded tokens rather than tokens, so that slashing the validator reduces every
+// delegation at once.
+type Delegation struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ValidatorPubkey []byte                 `protobuf:"bytes,1,opt,name=validator_pubkey,json=validatorPubkey,proto3" json:"validator_pubkey,omitempty"`
+	Shares          uint64                 `protobuf:"varint,2,opt,name=shares,proto3" json:"shares,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Delegation) Reset() {
+	*x = Delegation{}
+	mi := &file_mojave_v1_staking_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Delegation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Delegation) ProtoMessage() {}
+
+func (x *Delegation) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_staking_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Delegation.ProtoReflect.Descriptor instead.
+func (*Delegation) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_staking_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Delegation) GetValidatorPubkey() []byte {
+	if x != nil {
+		return x.ValidatorPubkey
+	}
+	return nil
+}
+
+func (x *Delegation) GetShares() uint64 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+// DelegatorState holds a delegator's delegations, ordered by validator pubkey.
+type DelegatorState struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DelegatorPubkey []byte                 `protobuf:"bytes,1,opt,name=delegator_pubkey,json=delegatorPubkey,proto3" json:"delegator_pubkey,omitempty"`
+	Delegations     []*Delegation          `protobuf:"bytes,2,rep,name=delegations,proto3" json:"delegations,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DelegatorState) Reset() {
+	*x = DelegatorState{}
+	mi := &file_mojave_v1_staking_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DelegatorState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelegatorState) ProtoMessage() {}
+
+func (x *DelegatorState) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_staking_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelegatorState.ProtoReflect.Descriptor instead.
+func (*DelegatorState) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_staking_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DelegatorState) GetDelegatorPubkey() []byte {
+	if x != nil {
+		return x.DelegatorPubkey
+	}
+	return nil
+}
+
+func (x *DelegatorState) GetDelegations() []*Delegation {
+	if x != nil {
+		return x.Delegations
+	}
+	return nil
+}
+
+type UnbondingEntry struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ValidatorPubkey []byte                 `protobuf:"bytes,1,opt,name=validator_pubkey,json=validatorPubkey,proto3" json:"validator_pubkey,omitempty"`
+	Amount          uint64                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// completion_height is the height at which the tokens return to the delegator's balance.
+	CompletionHeight uint64 `protobuf:"varint,3,opt,name=completion_height,json=completionHeight,proto3" json:"completion_height,omitempty"`
+	// creation_height is the height the tokens started unbonding at. Misbehavior of the
+	// validator from that height on was committed while they were bonded, so it slashes them.
+	CreationHeight uint64 `protobuf:"varint,4,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UnbondingEntry) Reset() {
+	*x = UnbondingEntry{}
+	mi := &file_mojave_v1_staking_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbondingEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbondingEntry) ProtoMessage() {}
+
+func (x *UnbondingEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_staking_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbondingEntry.ProtoReflect.Descriptor instead.
+func (*UnbondingEntry) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_staking_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UnbondingEntry) GetValidatorPubkey() []byte {
+	if x != nil {
+		return x.ValidatorPubkey
+	}
+	return nil
+}
+
+func (x *UnbondingEntry) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *UnbondingEntry) GetCompletionHeight() uint64 {
+	if x != nil {
+		return x.CompletionHeight
+	}
+	return 0
+}
+
+func (x *UnbondingEntry) GetCreationHeight() uint64 {
+	if x != nil {
+		return x.CreationHeight
+	}
+	return 0
+}
+
+// UnbondingQueue holds the tokens a delegator is unbonding, ordered by completion height.
+type UnbondingQueue struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DelegatorPubkey []byte                 `protobuf:"bytes,1,opt,name=delegator_pubkey,json=delegatorPubkey,proto3" json:"delegator_pubkey,omitempty"`
+	Entries         []*UnbondingEntry      `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UnbondingQueue) Reset() {
+	*x = UnbondingQueue{}
+	mi := &file_mojave_v1_staking_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbondingQueue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbondingQueue) ProtoMessage() {}
+
+func (x *UnbondingQueue) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_staking_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbondingQueue.ProtoReflect.Descriptor instead.
+func (*UnbondingQueue) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_staking_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UnbondingQueue) GetDelegatorPubkey() []byte {
+	if x != nil {
+		return x.DelegatorPubkey
+	}
+	return nil
+}
+
+func (x *UnbondingQueue) GetEntries() []*UnbondingEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// UnbondingCompletedEvent is emitted when tokens a delegator unbonded return to its balance.
+type UnbondingCompletedEvent struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DelegatorPubkey []byte                 `protobuf:"bytes,1,opt,name=delegator_pubkey,json=delegatorPubkey,proto3" json:"delegator_pubkey,omitempty"`
+	Amount          uint64                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UnbondingCompletedEvent) Reset() {
+	*x = UnbondingCompletedEvent{}
+	mi := &file_mojave_v1_staking_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbondingCompletedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbondingCompletedEvent) ProtoMessage() {}
+
+func (x *UnbondingCompletedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_staking_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbondingCompletedEvent.ProtoReflect.Descriptor instead.
+func (*UnbondingCompletedEvent) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_staking_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UnbondingCompletedEvent) GetDelegatorPubkey() []byte {
+	if x != nil {
+		return x.DelegatorPubkey
+	}
+	return nil
+}
+
+func (x *UnbondingCompletedEvent) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// BondTransaction bonds tokens from the signer's balance to its own validator, creating the
+// validator if needed. The signer's key is the validator's consensus key.
+type BondTransaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        uint64                 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BondTransaction) Reset() {
+	*x = BondTransaction{}
+	mi := &file_mojave_v1_staking_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BondTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BondTransaction) ProtoMessage() {}
+
+func (x *BondTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_staking_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BondTransaction.ProtoReflect.Descriptor instead.
+func (*BondTransaction) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_staking_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BondTransaction) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// DelegateTransaction bonds tokens from the signer's balance to another validator.
+type DelegateTransaction struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ValidatorPubkey []byte                 `protobuf:"bytes,1,opt,name=validator_pubkey,json=validatorPubkey,proto3" json:"validator_pubkey,omitempty"`
+	Amount          uint64                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DelegateTransaction) Reset() {
+	*x = DelegateTransaction{}
+	mi := &file_mojave_v1_staking_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DelegateTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelegateTransaction) ProtoMessage() {}
+
+func (x *DelegateTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_staking_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelegateTransaction.ProtoReflect.Descriptor instead.
+func (*DelegateTransaction) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_staking_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DelegateTransaction) GetValidatorPubkey() []byte {
+	if x != nil {
+		return x.ValidatorPubkey
+	}
+	return nil
+}
+
+func (x *DelegateTransaction) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// UnbondTransaction starts unbonding tokens the signer bonded to a validator, its own or one
+// it delegated to.
+type UnbondTransaction struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ValidatorPubkey []byte                 `protobuf:"bytes,1,opt,name=validator_pubkey,json=validatorPubkey,proto3" json:"validator_pubkey,omitempty"`
+	Amount          uint64                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UnbondTransaction) Reset() {
+	*x = UnbondTransaction{}
+	mi := &file_mojave_v1_staking_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbondTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbondTransaction) ProtoMessage() {}
+
+func (x *UnbondTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_staking_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbondTransaction.ProtoReflect.Descriptor instead.
+func (*UnbondTransaction) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_staking_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UnbondTransaction) GetValidatorPubkey() []byte {
+	if x != nil {
+		return x.ValidatorPubkey
+	}
+	return nil
+}
+
+func (x *UnbondTransaction) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type DelegationResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// shares are the shares of the validator's bonded tokens the delegation received.
+	Shares        uint64 `protobuf:"varint,1,opt,name=shares,proto3" json:"shares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DelegationResult) Reset() {
+	*x = DelegationResult{}
+	mi := &file_mojave_v1_staking_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DelegationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelegationResult) ProtoMessage() {}
+
+func (x *DelegationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_staking_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelegationResult.ProtoReflect.Descriptor instead.
+func (*DelegationResult) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_staking_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DelegationResult) GetShares() uint64 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+type UnbondResult struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CompletionHeight uint64                 `protobuf:"varint,1,opt,name=completion_height,json=completionHeight,proto3" json:"completion_height,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UnbondResult) Reset() {
+	*x = UnbondResult{}
+	mi := &file_mojave_v1_staking_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbondResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbondResult) ProtoMessage() {}
+
+func (x *UnbondResult) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_staking_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbondResult.ProtoReflect.Descriptor instead.
+func (*UnbondResult) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_staking_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UnbondResult) GetCompletionHeight() uint64 {
+	if x != nil {
+		return x.CompletionHeight
+	}
+	return 0
+}
+
+type DelegationsQuery struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DelegatorPubkey []byte                 `protobuf:"bytes,1,opt,name=delegator_pubkey,json=delegatorPubkey,proto3" json:"delegator_pubkey,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DelegationsQuery) Reset() {
+	*x = DelegationsQuery{}
+	mi := &file_mojave_v1_staking_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DelegationsQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelegationsQuery) ProtoMessage() {}
+
+func (x *DelegationsQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_staking_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelegationsQuery.ProtoReflect.Descriptor instead.
+func (*DelegationsQuery) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_staking_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DelegationsQuery) GetDelegatorPubkey() []byte {
+	if x != nil {
+		return x.DelegatorPubkey
+	}
+	return nil
+}
+
+type UnbondingQuery struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DelegatorPubkey []byte                 `protobuf:"bytes,1,opt,name=delegator_pubkey,json=delegatorPubkey,proto3" json:"delegator_pubkey,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UnbondingQuery) Reset() {
+	*x = UnbondingQuery{}
+	mi := &file_mojave_v1_staking_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbondingQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbondingQuery) ProtoMessage() {}
+
+func (x *UnbondingQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_staking_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbondingQuery.ProtoReflect.Descriptor instead.
+func (*UnbondingQuery) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_staking_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UnbondingQuery) GetDelegatorPubkey() []byte {
+	if x != nil {
+		return x.DelegatorPubkey
+	}
+	return nil
+}
+
+type StakingParamsQuery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StakingParamsQuery) Reset() {
+	*x = StakingParamsQuery{}
+	mi := &file_mojave_v1_staking_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StakingParamsQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakingParamsQuery) ProtoMessage() {}
+
+func (x *StakingParamsQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_staking_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakingParamsQuery.ProtoReflect.Descriptor instead.
+func (*StakingParamsQuery) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_staking_proto_rawDescGZIP(), []int{13}
+}
+
+var File_mojave_v1_staking_proto protoreflect.FileDescriptor
+
+const file_mojave_v1_staking_proto_rawDesc = "" +
+	"\n" +
+	"\x17mojave/v1/staking.proto\x12\tmojave.v1\"d\n" +
+	"\rStakingParams\x12)\n" +
+	"\x10unbonding_period\x18\x01 \x01(\x04R\x0funbondingPeriod\x12(\n" +
+	"\x10tokens_per_power\x18\x02 \x01(\x04R\x0etokensPerPower\"O\n" +
+	"\n" +
+	"Delegation\x12)\n" +
+	"\x10validator_pubkey\x18\x01 \x01(\fR\x0fvalidatorPubkey\x12\x16\n" +
+	"\x06shares\x18\x02 \x01(\x04R\x06shares\"t\n" +
+	"\x0eDelegatorState\x12)\n" +
+	"\x10delegator_pubkey\x18\x01 \x01(\fR\x0fdelegatorPubkey\x127\n" +
+	"\vdelegations\x18\x02 \x03(\v2\x15.mojave.v1.DelegationR\vdelegations\"\xa9\x01\n" +
+	"\x0eUnbondingEntry\x12)\n" +
+	"\x10validator_pubkey\x18\x01 \x01(\fR\x0fvalidatorPubkey\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x04R\x06amount\x12+\n" +
+	"\x11completion_height\x18\x03 \x01(\x04R\x10completionHeight\x12'\n" +
+	"\x0fcreation_height\x18\x04 \x01(\x04R\x0ecreationHeight\"p\n" +
+	"\x0eUnbondingQueue\x12)\n" +
+	"\x10delegator_pubkey\x18\x01 \x01(\fR\x0fdelegatorPubkey\x123\n" +
+	"\aentries\x18\x02 \x03(\v2\x19.mojave.v1.UnbondingEntryR\aentries\"\\\n" +
+	"\x17UnbondingCompletedEvent\x12)\n" +
+	"\x10delegator_pubkey\x18\x01 \x01(\fR\x0fdelegatorPubkey\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x04R\x06amount\")\n" +
+	"\x0fBondTransaction\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x04R\x06amount\"X\n" +
+	"\x13DelegateTransaction\x12)\n" +
+	"\x10validator_pubkey\x18\x01 \x01(\fR\x0fvalidatorPubkey\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x04R\x06amount\"V\n" +
+	"\x11UnbondTransaction\x12)\n" +
+	"\x10validator_pubkey\x18\x01 \x01(\fR\x0fvalidatorPubkey\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x04R\x06amount\"*\n" +
+	"\x10DelegationResult\x12\x16\n" +
+	"\x06shares\x18\x01 \x01(\x04R\x06shares\";\n" +
+	"\fUnbondResult\x12+\n" +
+	"\x11completion_height\x18\x01 \x01(\x04R\x10completionHeight\"=\n" +
+	"\x10DelegationsQuery\x12)\n" +
+	"\x10delegator_pubkey\x18\x01 \x01(\fR\x0fdelegatorPubkey\";\n" +
+	"\x0eUnbondingQuery\x12)\n" +
+	"\x10delegator_pubkey\x18\x01 \x01(\fR\x0fdelegatorPubkey\"\x14\n" +
+	"\x12StakingParamsQueryB+Z)github.com/alecsavvy/mojave/gen/mojave/v1b\x06proto3"
+
+var (
+	file_mojave_v1_staking_proto_rawDescOnce sync.Once
+	file_mojave_v1_staking_proto_rawDescData []byte
+)
+
+func file_mojave_v1_staking_proto_rawDescGZIP() []byte {
+	file_mojave_v1_staking_proto_rawDescOnce.Do(func() {
+		file_mojave_v1_staking_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_mojave_v1_staking_proto_rawDesc), len(file_mojave_v1_staking_proto_rawDesc)))
+	})
+	return file_mojave_v1_staking_proto_rawDescData
+}
+
+var file_mojave_v1_staking_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_mojave_v1_staking_proto_goTypes = []any{
+	(*StakingParams)(nil),           // 0: mojave.v1.StakingParams
+	(*Delegation)(nil),              // 1: mojave.v1.Delegation
+	(*DelegatorState)(nil),          // 2: mojave.v1.DelegatorState
+	(*UnbondingEntry)(nil),          // 3: mojave.v1.UnbondingEntry
+	(*UnbondingQueue)(nil),          // 4: mojave.v1.UnbondingQueue
+	(*UnbondingCompletedEvent)(nil), // 5: mojave.v1.UnbondingCompletedEvent
+	(*BondTransaction)(nil),         // 6: mojave.v1.BondTransaction
+	(*DelegateTransaction)(nil),     // 7: mojave.v1.DelegateTransaction
+	(*UnbondTransaction)(nil),       // 8: mojave.v1.UnbondTransaction
+	(*DelegationResult)(nil),        // 9: mojave.v1.DelegationResult
+	(*UnbondResult)(nil),            // 10: mojave.v1.UnbondResult
+	(*DelegationsQuery)(nil),        // 11: mojave.v1.DelegationsQuery
+	(*UnbondingQuery)(nil),          // 12: mojave.v1.UnbondingQuery
+	(*StakingParamsQuery)(nil),      // 13: mojave.v1.StakingParamsQuery
+}
+var file_mojave_v1_staking_proto_depIdxs = []int32{
+	1, // 0: mojave.v1.DelegatorState.delegations:type_name -> mojave.v1.Delegation
+	3, // 1: mojave.v1.UnbondingQueue.entries:type_name -> mojave.v1.UnbondingEntry
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_mojave_v1_staking_proto_init() }
+func file_mojave_v1_staking_proto_init() {
+	if File_mojave_v1_staking_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mojave_v1_staking_proto_rawDesc), len(file_mojave_v1_staking_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mojave_v1_staking_proto_goTypes,
+		DependencyIndexes: file_mojave_v1_staking_proto_depIdxs,
+		MessageInfos:      file_mojave_v1_staking_proto_msgTypes,
+	}.Build()
+	File_mojave_v1_staking_proto = out.File
+	file_mojave_v1_staking_proto_goTypes = nil
+	file_mojave_v1_staking_proto_depIdxs = nil
+}
@@ -117,6 +117,67 @@ func (*TokenTransferResult) Descriptor() ([]byte, []int) {
 	return file_mojave_v1_token_proto_rawDescGZIP(), []int{1}
 }
 
+// TransferEvent is emitted when a token transfer moves amount from one account to another.
+type TransferEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          []byte                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            []byte                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Amount        uint64                 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferEvent) Reset() {
+	*x = TransferEvent{}
+	mi := &file_mojave_v1_token_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferEvent) ProtoMessage() {}
+
+func (x *TransferEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_token_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferEvent.ProtoReflect.Descriptor instead.
+func (*TransferEvent) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_token_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TransferEvent) GetFrom() []byte {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *TransferEvent) GetTo() []byte {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *TransferEvent) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
 var File_mojave_v1_token_proto protoreflect.FileDescriptor
 
 const file_mojave_v1_token_proto_rawDesc = "" +
@@ -127,7 +188,11 @@ const file_mojave_v1_token_proto_rawDesc = "" +
 	"fromPubkey\x12\x1b\n" +
 	"\tto_pubkey\x18\x02 \x01(\fR\btoPubkey\x12\x16\n" +
 	"\x06amount\x18\x03 \x01(\x04R\x06amount\"\x15\n" +
-	"\x13TokenTransferResultB+Z)github.com/alecsavvy/mojave/gen/mojave/v1b\x06proto3"
+	"\x13TokenTransferResult\"K\n" +
+	"\rTransferEvent\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\fR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\fR\x02to\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x04R\x06amountB+Z)github.com/alecsavvy/mojave/gen/mojave/v1b\x06proto3"
 
 var (
 	file_mojave_v1_token_proto_rawDescOnce sync.Once
@@ -141,10 +206,11 @@ func file_mojave_v1_token_proto_rawDescGZIP() []byte {
 	return file_mojave_v1_token_proto_rawDescData
 }
 
-var file_mojave_v1_token_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_mojave_v1_token_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_mojave_v1_token_proto_goTypes = []any{
 	(*TokenTransferTransaction)(nil), // 0: mojave.v1.TokenTransferTransaction
 	(*TokenTransferResult)(nil),      // 1: mojave.v1.TokenTransferResult
+	(*TransferEvent)(nil),            // 2: mojave.v1.TransferEvent
 }
 var file_mojave_v1_token_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -165,7 +231,7 @@ func file_mojave_v1_token_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mojave_v1_token_proto_rawDesc), len(file_mojave_v1_token_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -30,6 +30,10 @@ const (
 	TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_SIGNATURE  TransactionResultErrorCode = 3
 	TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_NONCE      TransactionResultErrorCode = 4
 	TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_WATT_LIMIT TransactionResultErrorCode = 5
+	TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_UNAUTHORIZED       TransactionResultErrorCode = 6
+	TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INSUFFICIENT_FUNDS TransactionResultErrorCode = 7
+	TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_CHAIN_ID   TransactionResultErrorCode = 8
+	TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INSUFFICIENT_FEE   TransactionResultErrorCode = 9
 )
 
 // Enum value maps for TransactionResultErrorCode.
@@ -41,6 +45,10 @@ var (
 		3: "TRANSACTION_RESULT_ERROR_CODE_INVALID_SIGNATURE",
 		4: "TRANSACTION_RESULT_ERROR_CODE_INVALID_NONCE",
 		5: "TRANSACTION_RESULT_ERROR_CODE_INVALID_WATT_LIMIT",
+		6: "TRANSACTION_RESULT_ERROR_CODE_UNAUTHORIZED",
+		7: "TRANSACTION_RESULT_ERROR_CODE_INSUFFICIENT_FUNDS",
+		8: "TRANSACTION_RESULT_ERROR_CODE_INVALID_CHAIN_ID",
+		9: "TRANSACTION_RESULT_ERROR_CODE_INSUFFICIENT_FEE",
 	}
 	TransactionResultErrorCode_value = map[string]int32{
 		"TRANSACTION_RESULT_ERROR_CODE_UNSPECIFIED":        0,
@@ -49,6 +57,10 @@ var (
 		"TRANSACTION_RESULT_ERROR_CODE_INVALID_SIGNATURE":  3,
 		"TRANSACTION_RESULT_ERROR_CODE_INVALID_NONCE":      4,
 		"TRANSACTION_RESULT_ERROR_CODE_INVALID_WATT_LIMIT": 5,
+		"TRANSACTION_RESULT_ERROR_CODE_UNAUTHORIZED":       6,
+		"TRANSACTION_RESULT_ERROR_CODE_INSUFFICIENT_FUNDS": 7,
+		"TRANSACTION_RESULT_ERROR_CODE_INVALID_CHAIN_ID":   8,
+		"TRANSACTION_RESULT_ERROR_CODE_INSUFFICIENT_FEE":   9,
 	}
 )
 
@@ -80,9 +92,13 @@ func (TransactionResultErrorCode) EnumDescriptor() ([]byte, []int) {
 }
 
 type SignedTransaction struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Signature     []byte                 `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
-	Transaction   []byte                 `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Signature   []byte                 `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	Transaction []byte                 `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// availability is set instead of the signature and transaction on the
+	// availability transaction a proposer adds to its block. It is authenticated
+	// by the vote extension signatures it carries.
+	Availability  *AvailabilityTransaction `protobuf:"bytes,3,opt,name=availability,proto3" json:"availability,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -131,6 +147,13 @@ func (x *SignedTransaction) GetTransaction() []byte {
 	return nil
 }
 
+func (x *SignedTransaction) GetAvailability() *AvailabilityTransaction {
+	if x != nil {
+		return x.Availability
+	}
+	return nil
+}
+
 type Transaction struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Header        *TransactionHeader     `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
@@ -184,12 +207,15 @@ func (x *Transaction) GetBody() *TransactionBody {
 }
 
 type TransactionHeader struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ChainId       string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	Nonce         string                 `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	FromPubkey    []byte                 `protobuf:"bytes,3,opt,name=from_pubkey,json=fromPubkey,proto3" json:"from_pubkey,omitempty"`
-	ToPubkey      []byte                 `protobuf:"bytes,4,opt,name=to_pubkey,json=toPubkey,proto3" json:"to_pubkey,omitempty"`
-	WattLimit     uint64                 `protobuf:"varint,5,opt,name=watt_limit,json=wattLimit,proto3" json:"watt_limit,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ChainId    string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Nonce      uint64                 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	FromPubkey []byte                 `protobuf:"bytes,3,opt,name=from_pubkey,json=fromPubkey,proto3" json:"from_pubkey,omitempty"`
+	ToPubkey   []byte                 `protobuf:"bytes,4,opt,name=to_pubkey,json=toPubkey,proto3" json:"to_pubkey,omitempty"`
+	WattLimit  uint64                 `protobuf:"varint,5,opt,name=watt_limit,json=wattLimit,proto3" json:"watt_limit,omitempty"`
+	// fee is deducted from the signer before the body executes, even if it then
+	// fails, and is paid to the proposer of the block.
+	Fee           uint64 `protobuf:"varint,6,opt,name=fee,proto3" json:"fee,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -231,11 +257,11 @@ func (x *TransactionHeader) GetChainId() string {
 	return ""
 }
 
-func (x *TransactionHeader) GetNonce() string {
+func (x *TransactionHeader) GetNonce() uint64 {
 	if x != nil {
 		return x.Nonce
 	}
-	return ""
+	return 0
 }
 
 func (x *TransactionHeader) GetFromPubkey() []byte {
@@ -259,12 +285,30 @@ func (x *TransactionHeader) GetWattLimit() uint64 {
 	return 0
 }
 
+func (x *TransactionHeader) GetFee() uint64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
 type TransactionBody struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Body:
 	//
 	//	*TransactionBody_KeyValue
 	//	*TransactionBody_TokenTransfer
+	//	*TransactionBody_ContentRegistration
+	//	*TransactionBody_AddValidator
+	//	*TransactionBody_RemoveValidator
+	//	*TransactionBody_Bond
+	//	*TransactionBody_Delegate
+	//	*TransactionBody_Unbond
+	//	*TransactionBody_Unjail
+	//	*TransactionBody_UpdateParams
+	//	*TransactionBody_SubmitProposal
+	//	*TransactionBody_Deposit
+	//	*TransactionBody_Vote
 	Body          isTransactionBody_Body `protobuf_oneof:"body"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -325,6 +369,105 @@ func (x *TransactionBody) GetTokenTransfer() *TokenTransferTransaction {
 	return nil
 }
 
+func (x *TransactionBody) GetContentRegistration() *ContentRegistrationTransaction {
+	if x != nil {
+		if x, ok := x.Body.(*TransactionBody_ContentRegistration); ok {
+			return x.ContentRegistration
+		}
+	}
+	return nil
+}
+
+func (x *TransactionBody) GetAddValidator() *AddValidatorTransaction {
+	if x != nil {
+		if x, ok := x.Body.(*TransactionBody_AddValidator); ok {
+			return x.AddValidator
+		}
+	}
+	return nil
+}
+
+func (x *TransactionBody) GetRemoveValidator() *RemoveValidatorTransaction {
+	if x != nil {
+		if x, ok := x.Body.(*TransactionBody_RemoveValidator); ok {
+			return x.RemoveValidator
+		}
+	}
+	return nil
+}
+
+func (x *TransactionBody) GetBond() *BondTransaction {
+	if x != nil {
+		if x, ok := x.Body.(*TransactionBody_Bond); ok {
+			return x.Bond
+		}
+	}
+	return nil
+}
+
+func (x *TransactionBody) GetDelegate() *DelegateTransaction {
+	if x != nil {
+		if x, ok := x.Body.(*TransactionBody_Delegate); ok {
+			return x.Delegate
+		}
+	}
+	return nil
+}
+
+func (x *TransactionBody) GetUnbond() *UnbondTransaction {
+	if x != nil {
+		if x, ok := x.Body.(*TransactionBody_Unbond); ok {
+			return x.Unbond
+		}
+	}
+	return nil
+}
+
+func (x *TransactionBody) GetUnjail() *UnjailTransaction {
+	if x != nil {
+		if x, ok := x.Body.(*TransactionBody_Unjail); ok {
+			return x.Unjail
+		}
+	}
+	return nil
+}
+
+func (x *TransactionBody) GetUpdateParams() *UpdateParamsTransaction {
+	if x != nil {
+		if x, ok := x.Body.(*TransactionBody_UpdateParams); ok {
+			return x.UpdateParams
+		}
+	}
+	return nil
+}
+
+func (x *TransactionBody) GetSubmitProposal() *SubmitProposalTransaction {
+	if x != nil {
+		if x, ok := x.Body.(*TransactionBody_SubmitProposal); ok {
+			return x.SubmitProposal
+		}
+	}
+	return nil
+}
+
+func (x *TransactionBody) GetDeposit() *DepositTransaction {
+	if x != nil {
+		if x, ok := x.Body.(*TransactionBody_Deposit); ok {
+			return x.Deposit
+		}
+	}
+	return nil
+}
+
+func (x *TransactionBody) GetVote() *VoteTransaction {
+	if x != nil {
+		if x, ok := x.Body.(*TransactionBody_Vote); ok {
+			return x.Vote
+		}
+	}
+	return nil
+}
+
 type isTransactionBody_Body interface {
 	isTransactionBody_Body()
 }
@@ -337,10 +480,76 @@ type TransactionBody_TokenTransfer struct {
 	TokenTransfer *TokenTransferTransaction `protobuf:"bytes,2,opt,name=token_transfer,json=tokenTransfer,proto3,oneof"`
 }
 
+type TransactionBody_ContentRegistration struct {
+	ContentRegistration *ContentRegistrationTransaction `protobuf:"bytes,3,opt,name=content_registration,json=contentRegistration,proto3,oneof"`
+}
+
+type TransactionBody_AddValidator struct {
+	AddValidator *AddValidatorTransaction `protobuf:"bytes,4,opt,name=add_validator,json=addValidator,proto3,oneof"`
+}
+
+type TransactionBody_RemoveValidator struct {
+	RemoveValidator *RemoveValidatorTransaction `protobuf:"bytes,5,opt,name=remove_validator,json=removeValidator,proto3,oneof"`
+}
+
+type TransactionBody_Bond struct {
+	Bond *BondTransaction `protobuf:"bytes,7,opt,name=bond,proto3,oneof"`
+}
+
+type TransactionBody_Delegate struct {
+	Delegate *DelegateTransaction `protobuf:"bytes,8,opt,name=delegate,proto3,oneof"`
+}
+
+type TransactionBody_Unbond struct {
+	Unbond *UnbondTransaction `protobuf:"bytes,9,opt,name=unbond,proto3,oneof"`
+}
+
+type TransactionBody_Unjail struct {
+	Unjail *UnjailTransaction `protobuf:"bytes,10,opt,name=unjail,proto3,oneof"`
+}
+
+type TransactionBody_UpdateParams struct {
+	UpdateParams *UpdateParamsTransaction `protobuf:"bytes,11,opt,name=update_params,json=updateParams,proto3,oneof"`
+}
+
+type TransactionBody_SubmitProposal struct {
+	SubmitProposal *SubmitProposalTransaction `protobuf:"bytes,12,opt,name=submit_proposal,json=submitProposal,proto3,oneof"`
+}
+
+type TransactionBody_Deposit struct {
+	Deposit *DepositTransaction `protobuf:"bytes,13,opt,name=deposit,proto3,oneof"`
+}
+
+type TransactionBody_Vote struct {
+	Vote *VoteTransaction `protobuf:"bytes,14,opt,name=vote,proto3,oneof"`
+}
+
 func (*TransactionBody_KeyValue) isTransactionBody_Body() {}
 
 func (*TransactionBody_TokenTransfer) isTransactionBody_Body() {}
 
+func (*TransactionBody_ContentRegistration) isTransactionBody_Body() {}
+
+func (*TransactionBody_AddValidator) isTransactionBody_Body() {}
+
+func (*TransactionBody_RemoveValidator) isTransactionBody_Body() {}
+
+func (*TransactionBody_Bond) isTransactionBody_Body() {}
+
+func (*TransactionBody_Delegate) isTransactionBody_Body() {}
+
+func (*TransactionBody_Unbond) isTransactionBody_Body() {}
+
+func (*TransactionBody_Unjail) isTransactionBody_Body() {}
+
+func (*TransactionBody_UpdateParams) isTransactionBody_Body() {}
+
+func (*TransactionBody_SubmitProposal) isTransactionBody_Body() {}
+
+func (*TransactionBody_Deposit) isTransactionBody_Body() {}
+
+func (*TransactionBody_Vote) isTransactionBody_Body() {}
+
 type TransactionResult struct {
 	state         protoimpl.MessageState   `protogen:"open.v1"`
 	Header        *TransactionResultHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
@@ -406,7 +615,7 @@ type TransactionResultHeader struct {
 	TxHash        string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
 	BlockHeight   uint64                 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 	ChainId       string                 `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	Nonce         string                 `protobuf:"bytes,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Nonce         uint64                 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	WattsUsed     uint64                 `protobuf:"varint,5,opt,name=watts_used,json=wattsUsed,proto3" json:"watts_used,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -463,11 +672,11 @@ func (x *TransactionResultHeader) GetChainId() string {
 	return ""
 }
 
-func (x *TransactionResultHeader) GetNonce() string {
+func (x *TransactionResultHeader) GetNonce() uint64 {
 	if x != nil {
 		return x.Nonce
 	}
-	return ""
+	return 0
 }
 
 func (x *TransactionResultHeader) GetWattsUsed() uint64 {
@@ -483,6 +692,16 @@ type TransactionResultBody struct {
 	//
 	//	*TransactionResultBody_KeyValue
 	//	*TransactionResultBody_TokenTransfer
+	//	*TransactionResultBody_ContentRegistration
+	//	*TransactionResultBody_Availability
+	//	*TransactionResultBody_ValidatorUpdate
+	//	*TransactionResultBody_Delegation
+	//	*TransactionResultBody_Unbond
+	//	*TransactionResultBody_Unjail
+	//	*TransactionResultBody_UpdateParams
+	//	*TransactionResultBody_SubmitProposal
+	//	*TransactionResultBody_Deposit
+	//	*TransactionResultBody_Vote
 	Body          isTransactionResultBody_Body `protobuf_oneof:"body"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -543,6 +762,96 @@ func (x *TransactionResultBody) GetTokenTransfer() *TokenTransferResult {
 	return nil
 }
 
+func (x *TransactionResultBody) GetContentRegistration() *ContentRegistrationResult {
+	if x != nil {
+		if x, ok := x.Body.(*TransactionResultBody_ContentRegistration); ok {
+			return x.ContentRegistration
+		}
+	}
+	return nil
+}
+
+func (x *TransactionResultBody) GetAvailability() *AvailabilityResult {
+	if x != nil {
+		if x, ok := x.Body.(*TransactionResultBody_Availability); ok {
+			return x.Availability
+		}
+	}
+	return nil
+}
+
+func (x *TransactionResultBody) GetValidatorUpdate() *ValidatorUpdateResult {
+	if x != nil {
+		if x, ok := x.Body.(*TransactionResultBody_ValidatorUpdate); ok {
+			return x.ValidatorUpdate
+		}
+	}
+	return nil
+}
+
+func (x *TransactionResultBody) GetDelegation() *DelegationResult {
+	if x != nil {
+		if x, ok := x.Body.(*TransactionResultBody_Delegation); ok {
+			return x.Delegation
+		}
+	}
+	return nil
+}
+
+func (x *TransactionResultBody) GetUnbond() *UnbondResult {
+	if x != nil {
+		if x, ok := x.Body.(*TransactionResultBody_Unbond); ok {
+			return x.Unbond
+		}
+	}
+	return nil
+}
+
+func (x *TransactionResultBody) GetUnjail() *UnjailResult {
+	if x != nil {
+		if x, ok := x.Body.(*TransactionResultBody_Unjail); ok {
+			return x.Unjail
+		}
+	}
+	return nil
+}
+
+func (x *TransactionResultBody) GetUpdateParams() *UpdateParamsResult {
+	if x != nil {
+		if x, ok := x.Body.(*TransactionResultBody_UpdateParams); ok {
+			return x.UpdateParams
+		}
+	}
+	return nil
+}
+
+func (x *TransactionResultBody) GetSubmitProposal() *SubmitProposalResult {
+	if x != nil {
+		if x, ok := x.Body.(*TransactionResultBody_SubmitProposal); ok {
+			return x.SubmitProposal
+		}
+	}
+	return nil
+}
+
+func (x *TransactionResultBody) GetDeposit() *DepositResult {
+	if x != nil {
+		if x, ok := x.Body.(*TransactionResultBody_Deposit); ok {
+			return x.Deposit
+		}
+	}
+	return nil
+}
+
+func (x *TransactionResultBody) GetVote() *VoteResult {
+	if x != nil {
+		if x, ok := x.Body.(*TransactionResultBody_Vote); ok {
+			return x.Vote
+		}
+	}
+	return nil
+}
+
 type isTransactionResultBody_Body interface {
 	isTransactionResultBody_Body()
 }
@@ -555,10 +864,70 @@ type TransactionResultBody_TokenTransfer struct {
 	TokenTransfer *TokenTransferResult `protobuf:"bytes,2,opt,name=token_transfer,json=tokenTransfer,proto3,oneof"`
 }
 
+type TransactionResultBody_ContentRegistration struct {
+	ContentRegistration *ContentRegistrationResult `protobuf:"bytes,3,opt,name=content_registration,json=contentRegistration,proto3,oneof"`
+}
+
+type TransactionResultBody_Availability struct {
+	Availability *AvailabilityResult `protobuf:"bytes,4,opt,name=availability,proto3,oneof"`
+}
+
+type TransactionResultBody_ValidatorUpdate struct {
+	ValidatorUpdate *ValidatorUpdateResult `protobuf:"bytes,5,opt,name=validator_update,json=validatorUpdate,proto3,oneof"`
+}
+
+type TransactionResultBody_Delegation struct {
+	Delegation *DelegationResult `protobuf:"bytes,6,opt,name=delegation,proto3,oneof"`
+}
+
+type TransactionResultBody_Unbond struct {
+	Unbond *UnbondResult `protobuf:"bytes,7,opt,name=unbond,proto3,oneof"`
+}
+
+type TransactionResultBody_Unjail struct {
+	Unjail *UnjailResult `protobuf:"bytes,8,opt,name=unjail,proto3,oneof"`
+}
+
+type TransactionResultBody_UpdateParams struct {
+	UpdateParams *UpdateParamsResult `protobuf:"bytes,9,opt,name=update_params,json=updateParams,proto3,oneof"`
+}
+
+type TransactionResultBody_SubmitProposal struct {
+	SubmitProposal *SubmitProposalResult `protobuf:"bytes,10,opt,name=submit_proposal,json=submitProposal,proto3,oneof"`
+}
+
+type TransactionResultBody_Deposit struct {
+	Deposit *DepositResult `protobuf:"bytes,11,opt,name=deposit,proto3,oneof"`
+}
+
+type TransactionResultBody_Vote struct {
+	Vote *VoteResult `protobuf:"bytes,12,opt,name=vote,proto3,oneof"`
+}
+
 func (*TransactionResultBody_KeyValue) isTransactionResultBody_Body() {}
 
 func (*TransactionResultBody_TokenTransfer) isTransactionResultBody_Body() {}
 
+func (*TransactionResultBody_ContentRegistration) isTransactionResultBody_Body() {}
+
+func (*TransactionResultBody_Availability) isTransactionResultBody_Body() {}
+
+func (*TransactionResultBody_ValidatorUpdate) isTransactionResultBody_Body() {}
+
+func (*TransactionResultBody_Delegation) isTransactionResultBody_Body() {}
+
+func (*TransactionResultBody_Unbond) isTransactionResultBody_Body() {}
+
+func (*TransactionResultBody_Unjail) isTransactionResultBody_Body() {}
+
+func (*TransactionResultBody_UpdateParams) isTransactionResultBody_Body() {}
+
+func (*TransactionResultBody_SubmitProposal) isTransactionResultBody_Body() {}
+
+func (*TransactionResultBody_Deposit) isTransactionResultBody_Body() {}
+
+func (*TransactionResultBody_Vote) isTransactionResultBody_Body() {}
+
 type TransactionResultError struct {
 	state         protoimpl.MessageState     `protogen:"open.v1"`
 	Code          TransactionResultErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=mojave.v1.TransactionResultErrorCode" json:"code,omitempty"`
@@ -615,25 +984,39 @@ var File_mojave_v1_transaction_proto protoreflect.FileDescriptor
 
 const file_mojave_v1_transaction_proto_rawDesc = "" +
 	"\n" +
-	"\x1bmojave/v1/transaction.proto\x12\tmojave.v1\x1a\x12mojave/v1/kv.proto\x1a\x15mojave/v1/token.proto\"S\n" +
+	"\x1bmojave/v1/transaction.proto\x12\tmojave.v1\x1a\x17mojave/v1/content.proto\x1a\x13mojave/v1/gov.proto\x1a\x12mojave/v1/kv.proto\x1a\x16mojave/v1/params.proto\x1a\x18mojave/v1/slashing.proto\x1a\x17mojave/v1/staking.proto\x1a\x15mojave/v1/token.proto\x1a\x19mojave/v1/validator.proto\"\x9b\x01\n" +
 	"\x11SignedTransaction\x12\x1c\n" +
 	"\tsignature\x18\x01 \x01(\fR\tsignature\x12 \n" +
-	"\vtransaction\x18\x02 \x01(\fR\vtransaction\"s\n" +
+	"\vtransaction\x18\x02 \x01(\fR\vtransaction\x12F\n" +
+	"\favailability\x18\x03 \x01(\v2\".mojave.v1.AvailabilityTransactionR\favailability\"s\n" +
 	"\vTransaction\x124\n" +
 	"\x06header\x18\x01 \x01(\v2\x1c.mojave.v1.TransactionHeaderR\x06header\x12.\n" +
-	"\x04body\x18\x02 \x01(\v2\x1a.mojave.v1.TransactionBodyR\x04body\"\xa1\x01\n" +
+	"\x04body\x18\x02 \x01(\v2\x1a.mojave.v1.TransactionBodyR\x04body\"\xb3\x01\n" +
 	"\x11TransactionHeader\x12\x19\n" +
 	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x14\n" +
-	"\x05nonce\x18\x02 \x01(\tR\x05nonce\x12\x1f\n" +
+	"\x05nonce\x18\x02 \x01(\x04R\x05nonce\x12\x1f\n" +
 	"\vfrom_pubkey\x18\x03 \x01(\fR\n" +
 	"fromPubkey\x12\x1b\n" +
 	"\tto_pubkey\x18\x04 \x01(\fR\btoPubkey\x12\x1d\n" +
 	"\n" +
-	"watt_limit\x18\x05 \x01(\x04R\twattLimit\"\xa6\x01\n" +
+	"watt_limit\x18\x05 \x01(\x04R\twattLimit\x12\x10\n" +
+	"\x03fee\x18\x06 \x01(\x04R\x03fee\"\x94\a\n" +
 	"\x0fTransactionBody\x12=\n" +
 	"\tkey_value\x18\x01 \x01(\v2\x1e.mojave.v1.KeyValueTransactionH\x00R\bkeyValue\x12L\n" +
-	"\x0etoken_transfer\x18\x02 \x01(\v2#.mojave.v1.TokenTransferTransactionH\x00R\rtokenTransferB\x06\n" +
-	"\x04body\"\xbe\x01\n" +
+	"\x0etoken_transfer\x18\x02 \x01(\v2#.mojave.v1.TokenTransferTransactionH\x00R\rtokenTransfer\x12^\n" +
+	"\x14content_registration\x18\x03 \x01(\v2).mojave.v1.ContentRegistrationTransactionH\x00R\x13contentRegistration\x12I\n" +
+	"\radd_validator\x18\x04 \x01(\v2\".mojave.v1.AddValidatorTransactionH\x00R\faddValidator\x12R\n" +
+	"\x10remove_validator\x18\x05 \x01(\v2%.mojave.v1.RemoveValidatorTransactionH\x00R\x0fremoveValidator\x120\n" +
+	"\x04bond\x18\a \x01(\v2\x1a.mojave.v1.BondTransactionH\x00R\x04bond\x12<\n" +
+	"\bdelegate\x18\b \x01(\v2\x1e.mojave.v1.DelegateTransactionH\x00R\bdelegate\x126\n" +
+	"\x06unbond\x18\t \x01(\v2\x1c.mojave.v1.UnbondTransactionH\x00R\x06unbond\x126\n" +
+	"\x06unjail\x18\n" +
+	" \x01(\v2\x1c.mojave.v1.UnjailTransactionH\x00R\x06unjail\x12I\n" +
+	"\rupdate_params\x18\v \x01(\v2\".mojave.v1.UpdateParamsTransactionH\x00R\fupdateParams\x12O\n" +
+	"\x0fsubmit_proposal\x18\f \x01(\v2$.mojave.v1.SubmitProposalTransactionH\x00R\x0esubmitProposal\x129\n" +
+	"\adeposit\x18\r \x01(\v2\x1d.mojave.v1.DepositTransactionH\x00R\adeposit\x120\n" +
+	"\x04vote\x18\x0e \x01(\v2\x1a.mojave.v1.VoteTransactionH\x00R\x04voteB\x06\n" +
+	"\x04bodyJ\x04\b\x06\x10\a\"\xbe\x01\n" +
 	"\x11TransactionResult\x12:\n" +
 	"\x06header\x18\x01 \x01(\v2\".mojave.v1.TransactionResultHeaderR\x06header\x124\n" +
 	"\x04body\x18\x02 \x01(\v2 .mojave.v1.TransactionResultBodyR\x04body\x127\n" +
@@ -642,23 +1025,40 @@ const file_mojave_v1_transaction_proto_rawDesc = "" +
 	"\atx_hash\x18\x01 \x01(\tR\x06txHash\x12!\n" +
 	"\fblock_height\x18\x02 \x01(\x04R\vblockHeight\x12\x19\n" +
 	"\bchain_id\x18\x03 \x01(\tR\achainId\x12\x14\n" +
-	"\x05nonce\x18\x04 \x01(\tR\x05nonce\x12\x1d\n" +
+	"\x05nonce\x18\x04 \x01(\x04R\x05nonce\x12\x1d\n" +
 	"\n" +
-	"watts_used\x18\x05 \x01(\x04R\twattsUsed\"\xa2\x01\n" +
+	"watts_used\x18\x05 \x01(\x04R\twattsUsed\"\xab\x06\n" +
 	"\x15TransactionResultBody\x128\n" +
 	"\tkey_value\x18\x01 \x01(\v2\x19.mojave.v1.KeyValueResultH\x00R\bkeyValue\x12G\n" +
-	"\x0etoken_transfer\x18\x02 \x01(\v2\x1e.mojave.v1.TokenTransferResultH\x00R\rtokenTransferB\x06\n" +
+	"\x0etoken_transfer\x18\x02 \x01(\v2\x1e.mojave.v1.TokenTransferResultH\x00R\rtokenTransfer\x12Y\n" +
+	"\x14content_registration\x18\x03 \x01(\v2$.mojave.v1.ContentRegistrationResultH\x00R\x13contentRegistration\x12C\n" +
+	"\favailability\x18\x04 \x01(\v2\x1d.mojave.v1.AvailabilityResultH\x00R\favailability\x12M\n" +
+	"\x10validator_update\x18\x05 \x01(\v2 .mojave.v1.ValidatorUpdateResultH\x00R\x0fvalidatorUpdate\x12=\n" +
+	"\n" +
+	"delegation\x18\x06 \x01(\v2\x1b.mojave.v1.DelegationResultH\x00R\n" +
+	"delegation\x121\n" +
+	"\x06unbond\x18\a \x01(\v2\x17.mojave.v1.UnbondResultH\x00R\x06unbond\x121\n" +
+	"\x06unjail\x18\b \x01(\v2\x17.mojave.v1.UnjailResultH\x00R\x06unjail\x12D\n" +
+	"\rupdate_params\x18\t \x01(\v2\x1d.mojave.v1.UpdateParamsResultH\x00R\fupdateParams\x12J\n" +
+	"\x0fsubmit_proposal\x18\n" +
+	" \x01(\v2\x1f.mojave.v1.SubmitProposalResultH\x00R\x0esubmitProposal\x124\n" +
+	"\adeposit\x18\v \x01(\v2\x18.mojave.v1.DepositResultH\x00R\adeposit\x12+\n" +
+	"\x04vote\x18\f \x01(\v2\x15.mojave.v1.VoteResultH\x00R\x04voteB\x06\n" +
 	"\x04body\"e\n" +
 	"\x16TransactionResultError\x129\n" +
 	"\x04code\x18\x01 \x01(\x0e2%.mojave.v1.TransactionResultErrorCodeR\x04code\x12\x10\n" +
-	"\x03log\x18\x02 \x01(\tR\x03log*\xc6\x02\n" +
+	"\x03log\x18\x02 \x01(\tR\x03log*\x94\x04\n" +
 	"\x1aTransactionResultErrorCode\x12-\n" +
 	")TRANSACTION_RESULT_ERROR_CODE_UNSPECIFIED\x10\x00\x12*\n" +
 	"&TRANSACTION_RESULT_ERROR_CODE_INTERNAL\x10\x01\x121\n" +
 	"-TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST\x10\x02\x123\n" +
 	"/TRANSACTION_RESULT_ERROR_CODE_INVALID_SIGNATURE\x10\x03\x12/\n" +
 	"+TRANSACTION_RESULT_ERROR_CODE_INVALID_NONCE\x10\x04\x124\n" +
-	"0TRANSACTION_RESULT_ERROR_CODE_INVALID_WATT_LIMIT\x10\x05B+Z)github.com/alecsavvy/mojave/gen/mojave/v1b\x06proto3"
+	"0TRANSACTION_RESULT_ERROR_CODE_INVALID_WATT_LIMIT\x10\x05\x12.\n" +
+	"*TRANSACTION_RESULT_ERROR_CODE_UNAUTHORIZED\x10\x06\x124\n" +
+	"0TRANSACTION_RESULT_ERROR_CODE_INSUFFICIENT_FUNDS\x10\a\x122\n" +
+	".TRANSACTION_RESULT_ERROR_CODE_INVALID_CHAIN_ID\x10\b\x122\n" +
+	".TRANSACTION_RESULT_ERROR_CODE_INSUFFICIENT_FEE\x10\tB+Z)github.com/alecsavvy/mojave/gen/mojave/v1b\x06proto3"
 
 var (
 	file_mojave_v1_transaction_proto_rawDescOnce sync.Once
@@ -675,36 +1075,80 @@ func file_mojave_v1_transaction_proto_rawDescGZIP() []byte {
 var file_mojave_v1_transaction_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_mojave_v1_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_mojave_v1_transaction_proto_goTypes = []any{
-	(TransactionResultErrorCode)(0),  // 0: mojave.v1.TransactionResultErrorCode
-	(*SignedTransaction)(nil),        // 1: mojave.v1.SignedTransaction
-	(*Transaction)(nil),              // 2: mojave.v1.Transaction
-	(*TransactionHeader)(nil),        // 3: mojave.v1.TransactionHeader
-	(*TransactionBody)(nil),          // 4: mojave.v1.TransactionBody
-	(*TransactionResult)(nil),        // 5: mojave.v1.TransactionResult
-	(*TransactionResultHeader)(nil),  // 6: mojave.v1.TransactionResultHeader
-	(*TransactionResultBody)(nil),    // 7: mojave.v1.TransactionResultBody
-	(*TransactionResultError)(nil),   // 8: mojave.v1.TransactionResultError
-	(*KeyValueTransaction)(nil),      // 9: mojave.v1.KeyValueTransaction
-	(*TokenTransferTransaction)(nil), // 10: mojave.v1.TokenTransferTransaction
-	(*KeyValueResult)(nil),           // 11: mojave.v1.KeyValueResult
-	(*TokenTransferResult)(nil),      // 12: mojave.v1.TokenTransferResult
+	(TransactionResultErrorCode)(0),        // 0: mojave.v1.TransactionResultErrorCode
+	(*SignedTransaction)(nil),              // 1: mojave.v1.SignedTransaction
+	(*Transaction)(nil),                    // 2: mojave.v1.Transaction
+	(*TransactionHeader)(nil),              // 3: mojave.v1.TransactionHeader
+	(*TransactionBody)(nil),                // 4: mojave.v1.TransactionBody
+	(*TransactionResult)(nil),              // 5: mojave.v1.TransactionResult
+	(*TransactionResultHeader)(nil),        // 6: mojave.v1.TransactionResultHeader
+	(*TransactionResultBody)(nil),          // 7: mojave.v1.TransactionResultBody
+	(*TransactionResultError)(nil),         // 8: mojave.v1.TransactionResultError
+	(*AvailabilityTransaction)(nil),        // 9: mojave.v1.AvailabilityTransaction
+	(*KeyValueTransaction)(nil),            // 10: mojave.v1.KeyValueTransaction
+	(*TokenTransferTransaction)(nil),       // 11: mojave.v1.TokenTransferTransaction
+	(*ContentRegistrationTransaction)(nil), // 12: mojave.v1.ContentRegistrationTransaction
+	(*AddValidatorTransaction)(nil),        // 13: mojave.v1.AddValidatorTransaction
+	(*RemoveValidatorTransaction)(nil),     // 14: mojave.v1.RemoveValidatorTransaction
+	(*BondTransaction)(nil),                // 15: mojave.v1.BondTransaction
+	(*DelegateTransaction)(nil),            // 16: mojave.v1.DelegateTransaction
+	(*UnbondTransaction)(nil),              // 17: mojave.v1.UnbondTransaction
+	(*UnjailTransaction)(nil),              // 18: mojave.v1.UnjailTransaction
+	(*UpdateParamsTransaction)(nil),        // 19: mojave.v1.UpdateParamsTransaction
+	(*SubmitProposalTransaction)(nil),      // 20: mojave.v1.SubmitProposalTransaction
+	(*DepositTransaction)(nil),             // 21: mojave.v1.DepositTransaction
+	(*VoteTransaction)(nil),                // 22: mojave.v1.VoteTransaction
+	(*KeyValueResult)(nil),                 // 23: mojave.v1.KeyValueResult
+	(*TokenTransferResult)(nil),            // 24: mojave.v1.TokenTransferResult
+	(*ContentRegistrationResult)(nil),      // 25: mojave.v1.ContentRegistrationResult
+	(*AvailabilityResult)(nil),             // 26: mojave.v1.AvailabilityResult
+	(*ValidatorUpdateResult)(nil),          // 27: mojave.v1.ValidatorUpdateResult
+	(*DelegationResult)(nil),               // 28: mojave.v1.DelegationResult
+	(*UnbondResult)(nil),                   // 29: mojave.v1.UnbondResult
+	(*UnjailResult)(nil),                   // 30: mojave.v1.UnjailResult
+	(*UpdateParamsResult)(nil),             // 31: mojave.v1.UpdateParamsResult
+	(*SubmitProposalResult)(nil),           // 32: mojave.v1.SubmitProposalResult
+	(*DepositResult)(nil),                  // 33: mojave.v1.DepositResult
+	(*VoteResult)(nil),                     // 34: mojave.v1.VoteResult
 }
 var file_mojave_v1_transaction_proto_depIdxs = []int32{
-	3,  // 0: mojave.v1.Transaction.header:type_name -> mojave.v1.TransactionHeader
-	4,  // 1: mojave.v1.Transaction.body:type_name -> mojave.v1.TransactionBody
-	9,  // 2: mojave.v1.TransactionBody.key_value:type_name -> mojave.v1.KeyValueTransaction
-	10, // 3: mojave.v1.TransactionBody.token_transfer:type_name -> mojave.v1.TokenTransferTransaction
-	6,  // 4: mojave.v1.TransactionResult.header:type_name -> mojave.v1.TransactionResultHeader
-	7,  // 5: mojave.v1.TransactionResult.body:type_name -> mojave.v1.TransactionResultBody
-	8,  // 6: mojave.v1.TransactionResult.error:type_name -> mojave.v1.TransactionResultError
-	11, // 7: mojave.v1.TransactionResultBody.key_value:type_name -> mojave.v1.KeyValueResult
-	12, // 8: mojave.v1.TransactionResultBody.token_transfer:type_name -> mojave.v1.TokenTransferResult
-	0,  // 9: mojave.v1.TransactionResultError.code:type_name -> mojave.v1.TransactionResultErrorCode
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	9,  // 0: mojave.v1.SignedTransaction.availability:type_name -> mojave.v1.AvailabilityTransaction
+	3,  // 1: mojave.v1.Transaction.header:type_name -> mojave.v1.TransactionHeader
+	4,  // 2: mojave.v1.Transaction.body:type_name -> mojave.v1.TransactionBody
+	10, // 3: mojave.v1.TransactionBody.key_value:type_name -> mojave.v1.KeyValueTransaction
+	11, // 4: mojave.v1.TransactionBody.token_transfer:type_name -> mojave.v1.TokenTransferTransaction
+	12, // 5: mojave.v1.TransactionBody.content_registration:type_name -> mojave.v1.ContentRegistrationTransaction
+	13, // 6: mojave.v1.TransactionBody.add_validator:type_name -> mojave.v1.AddValidatorTransaction
+	14, // 7: mojave.v1.TransactionBody.remove_validator:type_name -> mojave.v1.RemoveValidatorTransaction
+	15, // 8: mojave.v1.TransactionBody.bond:type_name -> mojave.v1.BondTransaction
+	16, // 9: mojave.v1.TransactionBody.delegate:type_name -> mojave.v1.DelegateTransaction
+	17, // 10: mojave.v1.TransactionBody.unbond:type_name -> mojave.v1.UnbondTransaction
+	18, // 11: mojave.v1.TransactionBody.unjail:type_name -> mojave.v1.UnjailTransaction
+	19, // 12: mojave.v1.TransactionBody.update_params:type_name -> mojave.v1.UpdateParamsTransaction
+	20, // 13: mojave.v1.TransactionBody.submit_proposal:type_name -> mojave.v1.SubmitProposalTransaction
+	21, // 14: mojave.v1.TransactionBody.deposit:type_name -> mojave.v1.DepositTransaction
+	22, // 15: mojave.v1.TransactionBody.vote:type_name -> mojave.v1.VoteTransaction
+	6,  // 16: mojave.v1.TransactionResult.header:type_name -> mojave.v1.TransactionResultHeader
+	7,  // 17: mojave.v1.TransactionResult.body:type_name -> mojave.v1.TransactionResultBody
+	8,  // 18: mojave.v1.TransactionResult.error:type_name -> mojave.v1.TransactionResultError
+	23, // 19: mojave.v1.TransactionResultBody.key_value:type_name -> mojave.v1.KeyValueResult
+	24, // 20: mojave.v1.TransactionResultBody.token_transfer:type_name -> mojave.v1.TokenTransferResult
+	25, // 21: mojave.v1.TransactionResultBody.content_registration:type_name -> mojave.v1.ContentRegistrationResult
+	26, // 22: mojave.v1.TransactionResultBody.availability:type_name -> mojave.v1.AvailabilityResult
+	27, // 23: mojave.v1.TransactionResultBody.validator_update:type_name -> mojave.v1.ValidatorUpdateResult
+	28, // 24: mojave.v1.TransactionResultBody.delegation:type_name -> mojave.v1.DelegationResult
+	29, // 25: mojave.v1.TransactionResultBody.unbond:type_name -> mojave.v1.UnbondResult
+	30, // 26: mojave.v1.TransactionResultBody.unjail:type_name -> mojave.v1.UnjailResult
+	31, // 27: mojave.v1.TransactionResultBody.update_params:type_name -> mojave.v1.UpdateParamsResult
+	32, // 28: mojave.v1.TransactionResultBody.submit_proposal:type_name -> mojave.v1.SubmitProposalResult
+	33, // 29: mojave.v1.TransactionResultBody.deposit:type_name -> mojave.v1.DepositResult
+	34, // 30: mojave.v1.TransactionResultBody.vote:type_name -> mojave.v1.VoteResult
+	0,  // 31: mojave.v1.TransactionResultError.code:type_name -> mojave.v1.TransactionResultErrorCode
+	32, // [32:32] is the sub-list for method output_type
+	32, // [32:32] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_mojave_v1_transaction_proto_init() }
@@ -712,15 +1156,42 @@ func file_mojave_v1_transaction_proto_init() {
 	if File_mojave_v1_transaction_proto != nil {
 		return
 	}
+	file_mojave_v1_content_proto_init()
+	file_mojave_v1_gov_proto_init()
 	file_mojave_v1_kv_proto_init()
+	file_mojave_v1_params_proto_init()
+	file_mojave_v1_slashing_proto_init()
+	file_mojave_v1_staking_proto_init()
 	file_mojave_v1_token_proto_init()
+	file_mojave_v1_validator_proto_init()
 	file_mojave_v1_transaction_proto_msgTypes[3].OneofWrappers = []any{
 		(*TransactionBody_KeyValue)(nil),
 		(*TransactionBody_TokenTransfer)(nil),
+		(*TransactionBody_ContentRegistration)(nil),
+		(*TransactionBody_AddValidator)(nil),
+		(*TransactionBody_RemoveValidator)(nil),
+		(*TransactionBody_Bond)(nil),
+		(*TransactionBody_Delegate)(nil),
+		(*TransactionBody_Unbond)(nil),
+		(*TransactionBody_Unjail)(nil),
+		(*TransactionBody_UpdateParams)(nil),
+		(*TransactionBody_SubmitProposal)(nil),
+		(*TransactionBody_Deposit)(nil),
+		(*TransactionBody_Vote)(nil),
 	}
 	file_mojave_v1_transaction_proto_msgTypes[6].OneofWrappers = []any{
 		(*TransactionResultBody_KeyValue)(nil),
 		(*TransactionResultBody_TokenTransfer)(nil),
+		(*TransactionResultBody_ContentRegistration)(nil),
+		(*TransactionResultBody_Availability)(nil),
+		(*TransactionResultBody_ValidatorUpdate)(nil),
+		(*TransactionResultBody_Delegation)(nil),
+		(*TransactionResultBody_Unbond)(nil),
+		(*TransactionResultBody_Unjail)(nil),
+		(*TransactionResultBody_UpdateParams)(nil),
+		(*TransactionResultBody_SubmitProposal)(nil),
+		(*TransactionResultBody_Deposit)(nil),
+		(*TransactionResultBody_Vote)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -0,0 +1,419 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: mojave/v1/validator.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ValidatorState struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// address is the CometBFT validator address derived from the pubkey.
+	Address []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pubkey is the validator's ed25519 consensus key, which also owns the
+	// validator's account.
+	Pubkey []byte `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// power is the validator's voting power, its bonded tokens in units of tokens_per_power,
+	// or 0 while it is removed or jailed.
+	Power int64 `protobuf:"varint,3,opt,name=power,proto3" json:"power,omitempty"`
+	// tokens are the tokens bonded to the validator, by itself and its delegators.
+	Tokens uint64 `protobuf:"varint,4,opt,name=tokens,proto3" json:"tokens,omitempty"`
+	// shares are the delegation shares issued over tokens.
+	Shares uint64 `protobuf:"varint,5,opt,name=shares,proto3" json:"shares,omitempty"`
+	// removed is set when the validator admin removed the validator from the set.
+	Removed bool `protobuf:"varint,6,opt,name=removed,proto3" json:"removed,omitempty"`
+	// jailed validators have no power until they unjail, which they can do from
+	// jailed_until on.
+	Jailed        bool   `protobuf:"varint,7,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	JailedUntil   uint64 `protobuf:"varint,8,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidatorState) Reset() {
+	*x = ValidatorState{}
+	mi := &file_mojave_v1_validator_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidatorState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorState) ProtoMessage() {}
+
+func (x *ValidatorState) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_validator_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorState.ProtoReflect.Descriptor instead.
+func (*ValidatorState) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_validator_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ValidatorState) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *ValidatorState) GetPubkey() []byte {
+	if x != nil {
+		return x.Pubkey
+	}
+	return nil
+}
+
+func (x *ValidatorState) GetPower() int64 {
+	if x != nil {
+		return x.Power
+	}
+	return 0
+}
+
+func (x *ValidatorState) GetTokens() uint64 {
+	if x != nil {
+		return x.Tokens
+	}
+	return 0
+}
+
+func (x *ValidatorState) GetShares() uint64 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+func (x *ValidatorState) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
+func (x *ValidatorState) GetJailed() bool {
+	if x != nil {
+		return x.Jailed
+	}
+	return false
+}
+
+func (x *ValidatorState) GetJailedUntil() uint64 {
+	if x != nil {
+		return x.JailedUntil
+	}
+	return 0
+}
+
+// ValidatorSet is the active validator set, ordered by address.
+type ValidatorSet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Validators    []*ValidatorState      `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidatorSet) Reset() {
+	*x = ValidatorSet{}
+	mi := &file_mojave_v1_validator_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidatorSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorSet) ProtoMessage() {}
+
+func (x *ValidatorSet) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_validator_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorSet.ProtoReflect.Descriptor instead.
+func (*ValidatorSet) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_validator_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ValidatorSet) GetValidators() []*ValidatorState {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+type ValidatorSetQuery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidatorSetQuery) Reset() {
+	*x = ValidatorSetQuery{}
+	mi := &file_mojave_v1_validator_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidatorSetQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorSetQuery) ProtoMessage() {}
+
+func (x *ValidatorSetQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_validator_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorSetQuery.ProtoReflect.Descriptor instead.
+func (*ValidatorSetQuery) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_validator_proto_rawDescGZIP(), []int{2}
+}
+
+// AddValidatorTransaction and RemoveValidatorTransaction admit a validator to the set and
+// remove it. Only the validator admin set at genesis may sign them. A validator's power comes
+// from the tokens bonded to it, so an admitted validator only joins the set once it has enough.
+type AddValidatorTransaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pubkey        []byte                 `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddValidatorTransaction) Reset() {
+	*x = AddValidatorTransaction{}
+	mi := &file_mojave_v1_validator_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddValidatorTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddValidatorTransaction) ProtoMessage() {}
+
+func (x *AddValidatorTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_validator_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddValidatorTransaction.ProtoReflect.Descriptor instead.
+func (*AddValidatorTransaction) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_validator_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AddValidatorTransaction) GetPubkey() []byte {
+	if x != nil {
+		return x.Pubkey
+	}
+	return nil
+}
+
+type RemoveValidatorTransaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pubkey        []byte                 `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveValidatorTransaction) Reset() {
+	*x = RemoveValidatorTransaction{}
+	mi := &file_mojave_v1_validator_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveValidatorTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveValidatorTransaction) ProtoMessage() {}
+
+func (x *RemoveValidatorTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_validator_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveValidatorTransaction.ProtoReflect.Descriptor instead.
+func (*RemoveValidatorTransaction) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_validator_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RemoveValidatorTransaction) GetPubkey() []byte {
+	if x != nil {
+		return x.Pubkey
+	}
+	return nil
+}
+
+type ValidatorUpdateResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidatorUpdateResult) Reset() {
+	*x = ValidatorUpdateResult{}
+	mi := &file_mojave_v1_validator_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidatorUpdateResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorUpdateResult) ProtoMessage() {}
+
+func (x *ValidatorUpdateResult) ProtoReflect() protoreflect.Message {
+	mi := &file_mojave_v1_validator_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorUpdateResult.ProtoReflect.Descriptor instead.
+func (*ValidatorUpdateResult) Descriptor() ([]byte, []int) {
+	return file_mojave_v1_validator_proto_rawDescGZIP(), []int{5}
+}
+
+var File_mojave_v1_validator_proto protoreflect.FileDescriptor
+
+const file_mojave_v1_validator_proto_rawDesc = "" +
+	"\n" +
+	"\x19mojave/v1/validator.proto\x12\tmojave.v1\"\xdd\x01\n" +
+	"\x0eValidatorState\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\fR\aaddress\x12\x16\n" +
+	"\x06pubkey\x18\x02 \x01(\fR\x06pubkey\x12\x14\n" +
+	"\x05power\x18\x03 \x01(\x03R\x05power\x12\x16\n" +
+	"\x06tokens\x18\x04 \x01(\x04R\x06tokens\x12\x16\n" +
+	"\x06shares\x18\x05 \x01(\x04R\x06shares\x12\x18\n" +
+	"\aremoved\x18\x06 \x01(\bR\aremoved\x12\x16\n" +
+	"\x06jailed\x18\a \x01(\bR\x06jailed\x12!\n" +
+	"\fjailed_until\x18\b \x01(\x04R\vjailedUntil\"I\n" +
+	"\fValidatorSet\x129\n" +
+	"\n" +
+	"validators\x18\x01 \x03(\v2\x19.mojave.v1.ValidatorStateR\n" +
+	"validators\"\x13\n" +
+	"\x11ValidatorSetQuery\"7\n" +
+	"\x17AddValidatorTransaction\x12\x16\n" +
+	"\x06pubkey\x18\x01 \x01(\fR\x06pubkeyJ\x04\b\x02\x10\x03\"4\n" +
+	"\x1aRemoveValidatorTransaction\x12\x16\n" +
+	"\x06pubkey\x18\x01 \x01(\fR\x06pubkey\"\x17\n" +
+	"\x15ValidatorUpdateResultB+Z)github.com/alecsavvy/mojave/gen/mojave/v1b\x06proto3"
+
+var (
+	file_mojave_v1_validator_proto_rawDescOnce sync.Once
+	file_mojave_v1_validator_proto_rawDescData []byte
+)
+
+func file_mojave_v1_validator_proto_rawDescGZIP() []byte {
+	file_mojave_v1_validator_proto_rawDescOnce.Do(func() {
+		file_mojave_v1_validator_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_mojave_v1_validator_proto_rawDesc), len(file_mojave_v1_validator_proto_rawDesc)))
+	})
+	return file_mojave_v1_validator_proto_rawDescData
+}
+
+var file_mojave_v1_validator_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_mojave_v1_validator_proto_goTypes = []any{
+	(*ValidatorState)(nil),             // 0: mojave.v1.ValidatorState
+	(*ValidatorSet)(nil),               // 1: mojave.v1.ValidatorSet
+	(*ValidatorSetQuery)(nil),          // 2: mojave.v1.ValidatorSetQuery
+	(*AddValidatorTransaction)(nil),    // 3: mojave.v1.AddValidatorTransaction
+	(*RemoveValidatorTransaction)(nil), // 4: mojave.v1.RemoveValidatorTransaction
+	(*ValidatorUpdateResult)(nil),      // 5: mojave.v1.ValidatorUpdateResult
+}
+var file_mojave_v1_validator_proto_depIdxs = []int32{
+	0, // 0: mojave.v1.ValidatorSet.validators:type_name -> mojave.v1.ValidatorState
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_mojave_v1_validator_proto_init() }
+func file_mojave_v1_validator_proto_init() {
+	if File_mojave_v1_validator_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mojave_v1_validator_proto_rawDesc), len(file_mojave_v1_validator_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mojave_v1_validator_proto_goTypes,
+		DependencyIndexes: file_mojave_v1_validator_proto_depIdxs,
+		MessageInfos:      file_mojave_v1_validator_proto_msgTypes,
+	}.Build()
+	File_mojave_v1_validator_proto = out.File
+	file_mojave_v1_validator_proto_goTypes = nil
+	file_mojave_v1_validator_proto_depIdxs = nil
+}
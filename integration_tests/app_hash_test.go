@@ -0,0 +1,43 @@
+package integrationtests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppHash(t *testing.T) {
+	ctx := t.Context()
+
+	app := StartTestApp(ctx, t.TempDir())
+	t.Cleanup(func() {
+		app.Stop()
+	})
+	client := app.SDK()
+
+	appHashAfter := func(height int64) []byte {
+		t.Helper()
+		// the app hash of the state after block h is in the header of block h+1
+		next := height + 1
+		require.NoError(t, app.AwaitBlockHeight(ctx, next))
+		block, err := client.Block(ctx, &next)
+		require.NoError(t, err)
+		return block.Block.AppHash
+	}
+
+	_, err := client.SetKeyValue(ctx, "apphash", "one")
+	require.NoError(t, err)
+	status, err := client.Status(ctx)
+	require.NoError(t, err)
+	height := status.SyncInfo.LatestBlockHeight
+
+	before := appHashAfter(height)
+	require.Len(t, before, 32)
+
+	// every block mints its reward, so even empty blocks change the app hash
+	require.NotEqual(t, before, appHashAfter(height+1))
+
+	result, err := SendTransaction(ctx, client, keyValueBody("apphash", "two"), nil)
+	require.NoError(t, err)
+	require.NotEqual(t, before, appHashAfter(int64(result.Header.BlockHeight)))
+}
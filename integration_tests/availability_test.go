@@ -0,0 +1,208 @@
+package integrationtests
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alecsavvy/mojave/app"
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/alecsavvy/mojave/store"
+	"github.com/cockroachdb/pebble/vfs"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmted25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestContentAvailability(t *testing.T) {
+	ctx := t.Context()
+
+	testApp := StartTestApp(ctx, t.TempDir())
+	t.Cleanup(func() {
+		testApp.Stop()
+	})
+	client := testApp.SDK()
+
+	served := []byte("a track this validator serves")
+	servedHash := sha256.Sum256(served)
+	missingHash := sha256.Sum256([]byte("a track nobody serves"))
+	servedPath := filepath.Join(testApp.config.RootDir, "content", hex.EncodeToString(servedHash[:]))
+	require.NoError(t, os.WriteFile(servedPath, served, 0644))
+
+	_, err := client.RegisterContent(ctx, servedHash[:])
+	require.NoError(t, err)
+	_, err = client.RegisterContent(ctx, missingHash[:])
+	require.NoError(t, err)
+
+	_, err = client.RegisterContent(ctx, servedHash[:])
+	require.ErrorContains(t, err, "already registered")
+	_, err = client.RegisterContent(ctx, []byte("not a hash"))
+	require.ErrorContains(t, err, "not a sha256 hash")
+
+	availability := func(contentHash []byte) uint32 {
+		content, err := client.GetContent(ctx, contentHash)
+		require.NoError(t, err)
+		return content.AvailabilityBps
+	}
+	require.Eventually(t, func() bool {
+		return availability(servedHash[:]) == 10_000
+	}, 10*time.Second, 100*time.Millisecond)
+	require.Zero(t, availability(missingHash[:]))
+
+	content, err := client.GetContent(ctx, servedHash[:])
+	require.NoError(t, err)
+	require.Equal(t, []byte(client.GetPublicKey()), content.OwnerPubkey)
+
+	// the validator stops attesting once it can no longer serve the content
+	require.NoError(t, os.Remove(servedPath))
+	require.Eventually(t, func() bool {
+		return availability(servedHash[:]) == 0
+	}, 10*time.Second, 100*time.Millisecond)
+
+	// only proposers add availability transactions
+	tx, err := proto.Marshal(&v1.SignedTransaction{Availability: &v1.AvailabilityTransaction{Height: 1}})
+	require.NoError(t, err)
+	_, err = client.BroadcastTxSync(ctx, tx)
+	require.ErrorContains(t, err, "only added by proposers")
+}
+
+func TestAvailabilityProposal(t *testing.T) {
+	ctx := t.Context()
+
+	kvstore, db := openApp(t, vfs.NewMem())
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	validatorKey := cmted25519.GenPrivKey()
+	// the commits carry more voting power than scoring could multiply in an int64
+	validator := abcitypes.Validator{Address: validatorKey.PubKey().Address(), Power: 1 << 50}
+	initChain := &abcitypes.InitChainRequest{
+		ChainId:    "availability-test",
+		Validators: []abcitypes.ValidatorUpdate{abcitypes.NewValidatorUpdate(validatorKey.PubKey(), 10)},
+	}
+	_, err := kvstore.InitChain(ctx, initChain)
+	require.NoError(t, err)
+
+	_, ownerKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	contentHash := sha256.Sum256([]byte("track"))
+	register := signTx(t, ownerKey, &v1.TransactionHeader{ChainId: initChain.ChainId, Nonce: 1},
+		&v1.TransactionBody{Body: &v1.TransactionBody_ContentRegistration{
+			ContentRegistration: &v1.ContentRegistrationTransaction{ContentHash: contentHash[:]},
+		}})
+	_, err = kvstore.FinalizeBlock(ctx, &abcitypes.FinalizeBlockRequest{Height: 1, Txs: [][]byte{register}, ProposerAddress: validator.Address})
+	require.NoError(t, err)
+	_, err = kvstore.Commit(ctx, &abcitypes.CommitRequest{})
+	require.NoError(t, err)
+
+	// the precommits for height 2 are made against the state of height 1, so content registered
+	// in block 2 is outside their window even though it is registered by the time they are scored
+	lateHash := sha256.Sum256([]byte("late track"))
+	registerLate := signTx(t, ownerKey, &v1.TransactionHeader{ChainId: initChain.ChainId, Nonce: 2},
+		&v1.TransactionBody{Body: &v1.TransactionBody_ContentRegistration{
+			ContentRegistration: &v1.ContentRegistrationTransaction{ContentHash: lateHash[:]},
+		}})
+	_, err = kvstore.FinalizeBlock(ctx, &abcitypes.FinalizeBlockRequest{Height: 2, Txs: [][]byte{registerLate}, ProposerAddress: validator.Address})
+	require.NoError(t, err)
+	_, err = kvstore.Commit(ctx, &abcitypes.CommitRequest{})
+	require.NoError(t, err)
+
+	// the precommit for height 2 attests to the content
+	vote := func(contentHashes ...[]byte) abcitypes.ExtendedVoteInfo {
+		extension, err := proto.Marshal(&v1.AvailabilityAttestation{ContentHashes: contentHashes})
+		require.NoError(t, err)
+		signature, err := validatorKey.Sign(cmttypes.VoteExtensionSignBytes(initChain.ChainId, &cmtproto.Vote{
+			Type:      cmtproto.PrecommitType,
+			Height:    2,
+			Extension: extension,
+		}))
+		require.NoError(t, err)
+		return abcitypes.ExtendedVoteInfo{
+			Validator:          validator,
+			VoteExtension:      extension,
+			ExtensionSignature: signature,
+			BlockIdFlag:        cmtproto.BlockIDFlagCommit,
+		}
+	}
+	lastCommit := abcitypes.CommitInfo{Votes: []abcitypes.VoteInfo{{Validator: validator, BlockIdFlag: cmtproto.BlockIDFlagCommit}}}
+	process := func(txs ...[]byte) abcitypes.ProcessProposalStatus {
+		t.Helper()
+		resp, err := kvstore.ProcessProposal(ctx, &abcitypes.ProcessProposalRequest{Txs: txs, Height: 3, ProposedLastCommit: lastCommit})
+		require.NoError(t, err)
+		return resp.Status
+	}
+	availabilityTx := func(votes ...abcitypes.ExtendedVoteInfo) []byte {
+		availability := &v1.AvailabilityTransaction{Height: 2}
+		for _, vote := range votes {
+			availability.Attestations = append(availability.Attestations, &v1.SignedAttestation{
+				ValidatorAddress:   vote.Validator.Address,
+				Extension:          vote.VoteExtension,
+				ExtensionSignature: vote.ExtensionSignature,
+			})
+		}
+		tx, err := proto.Marshal(&v1.SignedTransaction{Availability: availability})
+		require.NoError(t, err)
+		return tx
+	}
+
+	proposal, err := kvstore.PrepareProposal(ctx, &abcitypes.PrepareProposalRequest{
+		Height:          3,
+		MaxTxBytes:      1 << 20,
+		LocalLastCommit: abcitypes.ExtendedCommitInfo{Votes: []abcitypes.ExtendedVoteInfo{vote(contentHash[:])}},
+	})
+	require.NoError(t, err)
+	require.Len(t, proposal.Txs, 1)
+	require.Equal(t, abcitypes.PROCESS_PROPOSAL_STATUS_ACCEPT, process(proposal.Txs...))
+
+	forged := vote(contentHash[:])
+	forged.ExtensionSignature[0] ^= 0xff
+	require.Equal(t, abcitypes.PROCESS_PROPOSAL_STATUS_REJECT, process(availabilityTx(forged)))
+
+	unregistered := sha256.Sum256([]byte("unregistered"))
+	require.Equal(t, abcitypes.PROCESS_PROPOSAL_STATUS_REJECT, process(availabilityTx(vote(unregistered[:]))))
+	require.Equal(t, abcitypes.PROCESS_PROPOSAL_STATUS_REJECT, process(availabilityTx(vote(contentHash[:]), vote(contentHash[:]))))
+	require.Equal(t, abcitypes.PROCESS_PROPOSAL_STATUS_REJECT, process(availabilityTx(vote(lateHash[:]))))
+
+	// an attestation may list no more content than a window holds
+	oversized := make([][]byte, app.MaxAvailabilityContent+1)
+	for i := range oversized {
+		oversized[i] = binary.BigEndian.AppendUint64(make([]byte, 24), uint64(i))
+	}
+	verified, err := kvstore.VerifyVoteExtension(ctx, &abcitypes.VerifyVoteExtensionRequest{Height: 2, VoteExtension: vote(oversized...).VoteExtension})
+	require.NoError(t, err)
+	require.Equal(t, abcitypes.VERIFY_VOTE_EXTENSION_STATUS_REJECT, verified.Status)
+
+	// the availability transaction only goes first
+	require.Equal(t, abcitypes.PROCESS_PROPOSAL_STATUS_REJECT, process(register, proposal.Txs[0]))
+
+	finalized, err := kvstore.FinalizeBlock(ctx, &abcitypes.FinalizeBlockRequest{
+		Height:            3,
+		Txs:               proposal.Txs,
+		DecidedLastCommit: lastCommit,
+		ProposerAddress:   validator.Address,
+	})
+	require.NoError(t, err)
+	require.Zero(t, finalized.TxResults[0].Code, finalized.TxResults[0].Log)
+	_, err = kvstore.Commit(ctx, &abcitypes.CommitRequest{})
+	require.NoError(t, err)
+
+	content, err := store.NewStore(db).GetContent(ctx, db, contentHash[:])
+	require.NoError(t, err)
+	require.Equal(t, uint32(10_000), content.AvailabilityBps)
+	require.Equal(t, uint64(2), content.AvailabilityHeight)
+
+	// the content registered after the votes was not scored by them
+	late, err := store.NewStore(db).GetContent(ctx, db, lateHash[:])
+	require.NoError(t, err)
+	require.Zero(t, late.AvailabilityHeight)
+}
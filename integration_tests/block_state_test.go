@@ -0,0 +1,100 @@
+package integrationtests
+
+import (
+	"testing"
+
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/alecsavvy/mojave/sdk"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestTransfersInOneBlock(t *testing.T) {
+	ctx := t.Context()
+
+	app := StartTestApp(ctx, t.TempDir())
+	t.Cleanup(func() {
+		app.Stop()
+	})
+	sender := app.SDK()
+	recipient := app.SDK()
+
+	require.NoError(t, sender.FaucetTokens(ctx, sender.GetPublicKey(), 1000))
+
+	transfer := func(nonce uint64) []byte {
+		transaction, err := sender.NewTransaction(ctx, &v1.TransactionBody{
+			Body: &v1.TransactionBody_TokenTransfer{
+				TokenTransfer: &v1.TokenTransferTransaction{
+					FromPubkey: sender.GetPublicKey(),
+					ToPubkey:   recipient.GetPublicKey(),
+					Amount:     100,
+				},
+			},
+		})
+		require.NoError(t, err)
+		transaction.Header.Nonce = nonce
+
+		signedTransaction, err := sender.SignTransaction(transaction)
+		require.NoError(t, err)
+		txBytes, err := proto.Marshal(signedTransaction)
+		require.NoError(t, err)
+		return txBytes
+	}
+
+	// both transfers sit in the mempool together so they land in the same block
+	first, second := transfer(1), transfer(2)
+	_, err := sender.BroadcastTxSync(ctx, first)
+	require.NoError(t, err)
+	response, err := sender.BroadcastTxCommit(ctx, second)
+	require.NoError(t, err)
+	require.Zero(t, response.TxResult.Code, response.TxResult.Log)
+
+	account, err := sender.GetAccount(ctx, sender.GetPublicKey())
+	require.NoError(t, err)
+	require.Equal(t, uint64(800), account.Balance)
+
+	account, err = recipient.GetAccount(ctx, recipient.GetPublicKey())
+	require.NoError(t, err)
+	require.Equal(t, uint64(200), account.Balance)
+}
+
+func TestFailedTransactionIsRolledBack(t *testing.T) {
+	ctx := t.Context()
+
+	app := StartTestApp(ctx, t.TempDir())
+	t.Cleanup(func() {
+		app.Stop()
+	})
+	sender := app.SDK()
+	recipient := app.SDK()
+
+	require.NoError(t, sender.FaucetTokens(ctx, sender.GetPublicKey(), 1000))
+
+	body := &v1.TransactionBody{
+		Body: &v1.TransactionBody_TokenTransfer{
+			TokenTransfer: &v1.TokenTransferTransaction{
+				FromPubkey: sender.GetPublicKey(),
+				ToPubkey:   recipient.GetPublicKey(),
+				Amount:     100,
+			},
+		},
+	}
+	result, err := SendTransaction(ctx, sender, body, nil)
+	require.NoError(t, err)
+
+	// runs out of watts after the debit but before the credit
+	sender.SetWattLimit(result.Header.WattsUsed - 1)
+	_, err = SendTransaction(ctx, sender, body, nil)
+	var txErr *sdk.TransactionError
+	require.ErrorAs(t, err, &txErr)
+	require.Equal(t, v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_WATT_LIMIT, txErr.Code)
+
+	account, err := sender.GetAccount(ctx, sender.GetPublicKey())
+	require.NoError(t, err)
+	require.Equal(t, uint64(900), account.Balance)
+	require.Equal(t, uint64(2), account.Nonce)
+
+	account, err = recipient.GetAccount(ctx, recipient.GetPublicKey())
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), account.Balance)
+}
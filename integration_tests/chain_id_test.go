@@ -0,0 +1,40 @@
+package integrationtests
+
+import (
+	"testing"
+
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainIDBinding(t *testing.T) {
+	ctx := t.Context()
+
+	app := StartTestApp(ctx, t.TempDir())
+	t.Cleanup(func() {
+		app.Stop()
+	})
+	client := app.SDK()
+
+	genDoc, err := types.GenesisDocFromFile(app.config.GenesisFile())
+	require.NoError(t, err)
+
+	chainID, err := client.ChainID(ctx)
+	require.NoError(t, err)
+	require.Equal(t, genDoc.ChainID, chainID)
+
+	_, err = SendTransaction(ctx, client, keyValueBody("chain", "other"), func(tx *v1.Transaction) {
+		tx.Header.ChainId = "mojave-testnet-1"
+	})
+	require.ErrorContains(t, err, "chain id mismatch")
+
+	_, err = SendTransaction(ctx, client, keyValueBody("chain", "empty"), func(tx *v1.Transaction) {
+		tx.Header.ChainId = ""
+	})
+	require.ErrorContains(t, err, "chain id is empty")
+
+	result, err := SendTransaction(ctx, client, keyValueBody("chain", "this"), nil)
+	require.NoError(t, err)
+	require.Equal(t, chainID, result.Header.ChainId)
+}
@@ -0,0 +1,155 @@
+package integrationtests
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/alecsavvy/mojave/config"
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/alecsavvy/mojave/store"
+	"github.com/alecsavvy/mojave/utils"
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/vfs"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmted25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/stretchr/testify/require"
+)
+
+// event is the ABCI event of type typ with an indexed attribute for each key and value pair.
+func event(typ string, attributes ...string) abcitypes.Event {
+	event := abcitypes.Event{Type: typ}
+	for i := 0; i+1 < len(attributes); i += 2 {
+		event.Attributes = append(event.Attributes, abcitypes.EventAttribute{Key: attributes[i], Value: attributes[i+1], Index: true})
+	}
+	return event
+}
+
+func TestEvents(t *testing.T) {
+	ctx := t.Context()
+
+	kvstore, db := openApp(t, vfs.NewMem())
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	validatorKey := cmted25519.GenPrivKey()
+	_, err := kvstore.InitChain(ctx, &abcitypes.InitChainRequest{
+		ChainId:       "events-test",
+		Validators:    []abcitypes.ValidatorUpdate{abcitypes.NewValidatorUpdate(validatorKey.PubKey(), 10)},
+		AppStateBytes: faucetAppState(t),
+	})
+	require.NoError(t, err)
+
+	recipient := cmted25519.GenPrivKey().PubKey().Bytes()
+	header := func(nonce uint64) *v1.TransactionHeader {
+		return &v1.TransactionHeader{ChainId: "events-test", Nonce: nonce}
+	}
+	transfer := func(nonce uint64, amount uint64) []byte {
+		return signTx(t, utils.FaucetPrivateKey, header(nonce), &v1.TransactionBody{Body: &v1.TransactionBody_TokenTransfer{
+			TokenTransfer: &v1.TokenTransferTransaction{FromPubkey: utils.FaucetPubkey, ToPubkey: recipient, Amount: amount},
+		}})
+	}
+	resp, err := kvstore.FinalizeBlock(ctx, &abcitypes.FinalizeBlockRequest{
+		Height: 1,
+		Txs: [][]byte{
+			transfer(1, 100),
+			signTx(t, utils.FaucetPrivateKey, header(2), &v1.TransactionBody{Body: &v1.TransactionBody_KeyValue{
+				KeyValue: &v1.KeyValueTransaction{Key: "greeting", Value: "hello"},
+			}}),
+			// fails after paying its fee, so it emits nothing
+			transfer(3, 1<<62),
+		},
+		ProposerAddress: validatorKey.PubKey().Address(),
+	})
+	require.NoError(t, err)
+
+	attribute := func(key, value string) abcitypes.EventAttribute {
+		return abcitypes.EventAttribute{Key: key, Value: value, Index: true}
+	}
+	require.Equal(t, []abcitypes.Event{
+		{Type: "account_created", Attributes: []abcitypes.EventAttribute{attribute("pubkey", hex.EncodeToString(recipient))}},
+		{Type: "transfer", Attributes: []abcitypes.EventAttribute{
+			attribute("from", hex.EncodeToString(utils.FaucetPubkey)),
+			attribute("to", hex.EncodeToString(recipient)),
+			attribute("amount", "100"),
+		}},
+	}, resp.TxResults[0].Events)
+	require.Equal(t, []abcitypes.Event{
+		{Type: "key_value_set", Attributes: []abcitypes.EventAttribute{
+			attribute("key", "greeting"),
+			attribute("setter", hex.EncodeToString(utils.FaucetPubkey)),
+		}},
+	}, resp.TxResults[1].Events)
+	require.NotZero(t, resp.TxResults[2].Code)
+	require.Empty(t, resp.TxResults[2].Events)
+
+	// the block reward creates the account of the proposer's consensus key
+	mintParams := config.DefaultMintParams()
+	require.Equal(t, []abcitypes.Event{
+		event("account_created", "pubkey", hex.EncodeToString(validatorKey.PubKey().Bytes())),
+		event("reward_minted",
+			"amount", strconv.FormatUint(mintParams.BlockReward, 10),
+			"community_pool", strconv.FormatUint(mintParams.BlockReward*uint64(mintParams.CommunityPoolBps)/10_000, 10)),
+	}, resp.Events)
+	_, err = kvstore.Commit(ctx, &abcitypes.CommitRequest{})
+	require.NoError(t, err)
+
+	// a transfer that runs out of watts writing its new recipient creates no account, so it
+	// emits no creation either
+	newRecipient := cmted25519.GenPrivKey().PubKey().Bytes()
+	outOfWatts := signTx(t, utils.FaucetPrivateKey, &v1.TransactionHeader{ChainId: "events-test", Nonce: 4, WattLimit: uint64(resp.TxResults[0].GasUsed) - 1},
+		&v1.TransactionBody{Body: &v1.TransactionBody_TokenTransfer{
+			TokenTransfer: &v1.TokenTransferTransaction{FromPubkey: utils.FaucetPubkey, ToPubkey: newRecipient, Amount: 100},
+		}})
+	resp, err = kvstore.FinalizeBlock(ctx, &abcitypes.FinalizeBlockRequest{
+		Height:          2,
+		Txs:             [][]byte{outOfWatts},
+		ProposerAddress: validatorKey.PubKey().Address(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint32(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_WATT_LIMIT), resp.TxResults[0].Code)
+	require.Empty(t, resp.TxResults[0].Events)
+	_, err = kvstore.Commit(ctx, &abcitypes.CommitRequest{})
+	require.NoError(t, err)
+	_, err = store.NewStore(db).GetAccount(ctx, db, newRecipient)
+	require.ErrorIs(t, err, pebble.ErrNotFound)
+}
+
+func TestEventSearch(t *testing.T) {
+	ctx := t.Context()
+
+	app := StartTestApp(ctx, t.TempDir())
+	t.Cleanup(func() {
+		app.Stop()
+	})
+	sender, recipient := app.SDK(), app.SDK()
+
+	require.NoError(t, sender.FaucetTokens(ctx, sender.GetPublicKey(), 1000))
+	_, err := sender.TransferTokens(ctx, sender.GetPublicKey(), recipient.GetPublicKey(), 100)
+	require.NoError(t, err)
+	_, err = sender.SetKeyValue(ctx, "searched", "value")
+	require.NoError(t, err)
+
+	// transactions are indexed by their events once their block is committed
+	search := func(query string) int {
+		result, err := sender.TxSearch(ctx, query, false, nil, nil, "")
+		require.NoError(t, err)
+		return result.TotalCount
+	}
+	require.Eventually(t, func() bool {
+		return search(fmt.Sprintf("transfer.to='%x'", recipient.GetPublicKey())) == 1
+	}, 10*time.Second, 100*time.Millisecond)
+	require.Equal(t, 1, search(fmt.Sprintf("transfer.from='%x'", sender.GetPublicKey())))
+	require.Equal(t, 1, search(fmt.Sprintf("transfer.to='%x'", sender.GetPublicKey())))
+	require.Equal(t, 1, search(fmt.Sprintf("account_created.pubkey='%x'", recipient.GetPublicKey())))
+	require.Equal(t, 1, search(fmt.Sprintf("key_value_set.key='searched' AND key_value_set.setter='%x'", sender.GetPublicKey())))
+	require.Equal(t, 0, search("transfer.amount > 1000"))
+
+	// the block reward created the validator's account in a block event
+	blocks, err := sender.BlockSearch(ctx, "account_created.pubkey EXISTS", nil, nil, "")
+	require.NoError(t, err)
+	require.NotZero(t, blocks.TotalCount)
+}
@@ -0,0 +1,64 @@
+package integrationtests
+
+import (
+	"testing"
+
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/alecsavvy/mojave/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeesPaidToProposer(t *testing.T) {
+	ctx := t.Context()
+
+	app := StartTestApp(ctx, t.TempDir())
+	t.Cleanup(func() {
+		app.Stop()
+	})
+	client := app.SDK()
+	proposer := app.ValidatorPubKey()
+
+	// the proposer is the only validator, so it also gets every block reward minted that does not
+	// go to the community pool
+	requireBalances := func(clientBalance uint64, proposerFees uint64) {
+		t.Helper()
+		status, err := client.Status(ctx)
+		require.NoError(t, err)
+		height := status.SyncInfo.LatestBlockHeight
+
+		account, err := client.GetAccountAt(ctx, client.GetPublicKey(), height)
+		require.NoError(t, err)
+		require.Equal(t, clientBalance, account.Balance)
+
+		account, err = client.GetAccountAt(ctx, proposer, height)
+		require.NoError(t, err)
+		supply, err := client.GetSupplyAt(ctx, height)
+		require.NoError(t, err)
+		pool, err := client.GetCommunityPoolAt(ctx, height)
+		require.NoError(t, err)
+		require.Equal(t, proposerFees, account.Balance-(supply.Minted-pool.Balance))
+	}
+
+	require.NoError(t, client.FaucetTokens(ctx, client.GetPublicKey(), 1000))
+	requireBalances(1000, 0)
+
+	client.SetFee(10)
+	result, err := SendTransaction(ctx, client, keyValueBody("fees", "paid"), nil)
+	require.NoError(t, err)
+	requireBalances(990, 10)
+
+	// a failing transaction still pays its fee
+	client.SetWattLimit(result.Header.WattsUsed - 1)
+	_, err = SendTransaction(ctx, client, keyValueBody("fees", "paid"), nil)
+	var txErr *sdk.TransactionError
+	require.ErrorAs(t, err, &txErr)
+	require.Equal(t, v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_WATT_LIMIT, txErr.Code)
+	requireBalances(980, 20)
+	client.SetWattLimit(sdk.DefaultWattLimit)
+
+	// a signer that cannot cover the fee never reaches a block
+	client.SetFee(5000)
+	_, err = client.SetKeyValue(ctx, "fees", "unpaid")
+	require.ErrorContains(t, err, "insufficient funds for fee")
+	requireBalances(980, 20)
+}
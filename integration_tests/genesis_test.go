@@ -0,0 +1,102 @@
+package integrationtests
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/alecsavvy/mojave/config"
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/alecsavvy/mojave/store"
+	"github.com/alecsavvy/mojave/utils"
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/vfs"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmted25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisAppState(t *testing.T) {
+	ctx := t.Context()
+
+	validatorKey := cmted25519.GenPrivKey().PubKey()
+	initChain := func(state *v1.GenesisState) (*pebble.DB, error) {
+		t.Helper()
+		kvstore, db := openApp(t, vfs.NewMem())
+		t.Cleanup(func() {
+			db.Close()
+		})
+		appState, err := config.MarshalAppState(state)
+		require.NoError(t, err)
+		_, err = kvstore.InitChain(ctx, &abcitypes.InitChainRequest{
+			ChainId:       "genesis-test",
+			Validators:    []abcitypes.ValidatorUpdate{abcitypes.NewValidatorUpdate(validatorKey, 10)},
+			AppStateBytes: appState,
+		})
+		return db, err
+	}
+
+	account := bytes.Repeat([]byte{1}, 32)
+	db, err := initChain(&v1.GenesisState{
+		StakingParams: &v1.StakingParams{UnbondingPeriod: 7, TokensPerPower: 100},
+		Accounts: []*v1.GenesisAccount{
+			{Pubkey: utils.FaucetPubkey, Balance: 5000},
+			{Pubkey: account, Balance: 42},
+		},
+		KeyValues: []*v1.KeyValueState{{Key: "genesis", Value: "hello"}},
+	})
+	require.NoError(t, err)
+	s := store.NewStore(db)
+
+	accountState, err := s.GetAccount(ctx, db, account)
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), accountState.Balance)
+	kv, err := s.GetKeyValue(ctx, db, "genesis")
+	require.NoError(t, err)
+	require.Equal(t, "hello", kv.Value)
+	stakingParams, err := s.GetStakingParams(ctx, db)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), stakingParams.UnbondingPeriod)
+
+	// without consensus params, evidence is accepted for as long as tokens unbond
+	consensusParams, err := s.GetConsensusParams(ctx, db)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), consensusParams.Evidence.MaxAgeNumBlocks)
+
+	// unset params take their defaults
+	mintParams, err := s.GetMintParams(ctx, db)
+	require.NoError(t, err)
+	require.Equal(t, config.DefaultMintParams().BlockReward, mintParams.BlockReward)
+
+	// the supply is the balances plus the 10 power bonded for the genesis validator
+	supply, err := s.GetSupply(ctx, db)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5000+42+10*100), supply.Total)
+
+	for reason, state := range map[string]*v1.GenesisState{
+		"invalid genesis account pubkey length":    {Accounts: []*v1.GenesisAccount{{Pubkey: account[:8]}}},
+		"duplicate genesis account":                {Accounts: []*v1.GenesisAccount{{Pubkey: account}, {Pubkey: account}}},
+		"genesis supply overflows":                 {Accounts: []*v1.GenesisAccount{{Pubkey: account, Balance: 1 << 63}, {Pubkey: utils.FaucetPubkey, Balance: 1 << 63}}},
+		"duplicate genesis key value":              {KeyValues: []*v1.KeyValueState{{Key: "a"}, {Key: "a"}}},
+		"exceeds max key size":                     {KeyValues: []*v1.KeyValueState{{Key: strings.Repeat("k", int(config.DefaultParams().MaxKeyValueKeySize)+1)}}},
+		"tokens per power must be positive":        {StakingParams: &v1.StakingParams{UnbondingPeriod: 1}},
+		"mint params basis points exceed":          {MintParams: &v1.MintParams{ProposerBonusBps: 10_001}},
+		"reduction interval must be 0 or at least": {MintParams: &v1.MintParams{ReductionInterval: 1, ReductionBps: 1}},
+	} {
+		_, err := initChain(state)
+		require.ErrorContains(t, err, reason)
+	}
+
+	kvstore, db := openApp(t, vfs.NewMem())
+	t.Cleanup(func() {
+		db.Close()
+	})
+	longEvidence := config.DefaultConsensusParams().ToProto()
+	longEvidence.Evidence.MaxAgeNumBlocks = int64(config.DefaultStakingParams().UnbondingPeriod) + 1
+	_, err = kvstore.InitChain(ctx, &abcitypes.InitChainRequest{
+		ChainId:         "genesis-test",
+		Validators:      []abcitypes.ValidatorUpdate{abcitypes.NewValidatorUpdate(validatorKey, 10)},
+		ConsensusParams: &longEvidence,
+	})
+	require.ErrorContains(t, err, "exceeds the unbonding period")
+}
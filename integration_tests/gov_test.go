@@ -0,0 +1,241 @@
+package integrationtests
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"testing"
+
+	"github.com/alecsavvy/mojave/config"
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/alecsavvy/mojave/sdk"
+	"github.com/alecsavvy/mojave/store"
+	"github.com/alecsavvy/mojave/utils"
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/vfs"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmted25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestGovernance(t *testing.T) {
+	ctx := t.Context()
+
+	kvstore, db := openApp(t, vfs.NewMem())
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	// a tenth of every block reward goes to the community pool
+	appState, err := config.MarshalAppState(&v1.GenesisState{
+		StakingParams: &v1.StakingParams{UnbondingPeriod: 10, TokensPerPower: 1000},
+		MintParams:    &v1.MintParams{BlockReward: 1000, CommunityPoolBps: 1_000},
+		GovParams:     &v1.GovParams{MinDeposit: 500, DepositPeriod: 2, VotingPeriod: 2, QuorumBps: 5_000, ThresholdBps: 5_000},
+		Accounts:      []*v1.GenesisAccount{{Pubkey: utils.FaucetPubkey, Balance: 10_000}},
+	})
+	require.NoError(t, err)
+	keyA, keyB := cmted25519.GenPrivKey(), cmted25519.GenPrivKey()
+	initChain := &abcitypes.InitChainRequest{
+		ChainId: "gov-test",
+		Validators: []abcitypes.ValidatorUpdate{
+			abcitypes.NewValidatorUpdate(keyA.PubKey(), 30),
+			abcitypes.NewValidatorUpdate(keyB.PubKey(), 10),
+		},
+		AppStateBytes: appState,
+	}
+	_, err = kvstore.InitChain(ctx, initChain)
+	require.NoError(t, err)
+
+	s := store.NewStore(db)
+	nonces := map[string]uint64{}
+	tx := func(key ed25519.PrivateKey, body *v1.TransactionBody) []byte {
+		nonces[string(key)]++
+		return signTx(t, key, &v1.TransactionHeader{ChainId: initChain.ChainId, Nonce: nonces[string(key)]}, body)
+	}
+	submit := func(title string, content *v1.ProposalContent, deposit uint64) []byte {
+		return tx(utils.FaucetPrivateKey, &v1.TransactionBody{Body: &v1.TransactionBody_SubmitProposal{
+			SubmitProposal: &v1.SubmitProposalTransaction{Title: title, Content: content, InitialDeposit: deposit},
+		}})
+	}
+	vote := func(key cmted25519.PrivKey, id uint64, option v1.VoteOption) []byte {
+		return tx(ed25519.PrivateKey(key), &v1.TransactionBody{Body: &v1.TransactionBody_Vote{
+			Vote: &v1.VoteTransaction{ProposalId: id, Option: option},
+		}})
+	}
+	spend := func(recipient []byte, amount uint64) *v1.ProposalContent {
+		return &v1.ProposalContent{Content: &v1.ProposalContent_CommunityPoolSpend{
+			CommunityPoolSpend: &v1.CommunityPoolSpend{RecipientPubkey: recipient, Amount: amount},
+		}}
+	}
+
+	height := int64(0)
+	finalize := func(txs ...[]byte) *abcitypes.FinalizeBlockResponse {
+		t.Helper()
+		height++
+		resp, err := kvstore.FinalizeBlock(ctx, &abcitypes.FinalizeBlockRequest{
+			Height:          height,
+			Txs:             txs,
+			ProposerAddress: keyA.PubKey().Address(),
+		})
+		require.NoError(t, err)
+		_, err = kvstore.Commit(ctx, &abcitypes.CommitRequest{})
+		require.NoError(t, err)
+		return resp
+	}
+	proposal := func(id uint64) *v1.Proposal {
+		t.Helper()
+		proposal, err := s.GetProposal(ctx, db, id)
+		require.NoError(t, err)
+		return proposal
+	}
+	balance := func(pubkey []byte) uint64 {
+		t.Helper()
+		account, err := s.GetAccount(ctx, db, pubkey)
+		if errors.Is(err, pebble.ErrNotFound) {
+			return 0
+		}
+		require.NoError(t, err)
+		return account.Balance
+	}
+	pool := func() uint64 {
+		t.Helper()
+		pool, err := s.GetCommunityPool(ctx, db)
+		require.NoError(t, err)
+		return pool.Balance
+	}
+
+	recipient := cmted25519.GenPrivKey().PubKey().Bytes()
+	upgrade := &v1.ProposalContent{Content: &v1.ProposalContent_Upgrade{Upgrade: &v1.UpgradePlan{Name: "v2", Height: 100}}}
+	results := finalize(
+		// proposals 1 and 3 reach the min deposit and go to a vote right away
+		submit("pay the recipient", spend(recipient, 150), 500),
+		submit("upgrade", upgrade, 100),
+		submit("pay too much", spend(recipient, 1_000_000), 500),
+		vote(keyA, 1, v1.VoteOption_VOTE_OPTION_NO),
+		vote(keyA, 2, v1.VoteOption_VOTE_OPTION_YES),
+		vote(keyA, 3, v1.VoteOption_VOTE_OPTION_YES),
+		vote(cmted25519.PrivKey(utils.FaucetPrivateKey), 1, v1.VoteOption_VOTE_OPTION_YES),
+	).TxResults
+	for i, result := range results {
+		if i == 4 {
+			require.NotZero(t, result.Code, "votes are only taken in the voting period")
+			continue
+		}
+		if i == 6 {
+			txResult := &v1.TransactionResult{}
+			require.NoError(t, proto.Unmarshal(result.Data, txResult))
+			require.Contains(t, txResult.Error.GetLog(), "no tokens bonded to an active validator")
+			continue
+		}
+		require.Zero(t, result.Code, "tx %d", i)
+	}
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD, proposal(1).Status)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_DEPOSIT_PERIOD, proposal(2).Status)
+	require.Equal(t, uint64(10_000-1100), balance(utils.FaucetPubkey))
+
+	// a second vote replaces the first, and the last deposit moves proposal 2 to a vote
+	results = finalize(
+		vote(keyA, 1, v1.VoteOption_VOTE_OPTION_YES),
+		vote(keyB, 1, v1.VoteOption_VOTE_OPTION_NO),
+		tx(utils.FaucetPrivateKey, &v1.TransactionBody{Body: &v1.TransactionBody_Deposit{
+			Deposit: &v1.DepositTransaction{ProposalId: 2, Amount: 400},
+		}}),
+		vote(keyB, 2, v1.VoteOption_VOTE_OPTION_YES),
+	).TxResults
+	for i, result := range results {
+		require.Zero(t, result.Code, "tx %d", i)
+	}
+	require.Equal(t, uint64(4), proposal(2).VotingEndHeight)
+	voteA, err := s.GetProposalVote(ctx, db, 1, keyA.PubKey().Bytes())
+	require.NoError(t, err)
+	require.Equal(t, v1.VoteOption_VOTE_OPTION_YES, voteA.Option)
+	_, err = s.GetProposalVote(ctx, db, 1, utils.FaucetPubkey)
+	require.ErrorIs(t, err, pebble.ErrNotFound)
+
+	// proposal 1 passes with 30 of the 40 bonded tokens and pays from the pool, leaving too little
+	// for proposal 3, which fails
+	ended := finalize(submit("expires", upgrade, 100))
+	require.Zero(t, ended.TxResults[0].Code)
+	require.Contains(t, ended.Events, event("proposal_ended", "proposal_id", "1", "status", "PROPOSAL_STATUS_PASSED"))
+	require.Contains(t, ended.Events, event("proposal_ended", "proposal_id", "3", "status", "PROPOSAL_STATUS_FAILED"))
+	passed := proposal(1)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_PASSED, passed.Status)
+	require.Equal(t, [4]uint64{30_000, 10_000, 0, 40_000},
+		[4]uint64{passed.Tally.Yes, passed.Tally.No, passed.Tally.Abstain, passed.Tally.TotalBonded})
+	failed := proposal(3)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_FAILED, failed.Status)
+	require.Contains(t, failed.Failure, "insufficient community pool funds")
+	require.Equal(t, uint64(150), balance(recipient))
+	require.Equal(t, uint64(300-150), pool())
+	// deposits on proposals that reach quorum are refunded
+	require.Equal(t, uint64(10_000-1100-400-100+1000), balance(utils.FaucetPubkey))
+
+	// proposal 2 misses quorum with a quarter of the bonded tokens, and its deposits go to the pool
+	finalize()
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_REJECTED, proposal(2).Status)
+	require.Equal(t, uint64(150+100+500), pool())
+	_, err = s.GetUpgradePlan(ctx, db)
+	require.Error(t, err)
+
+	// proposal 4 never reaches the min deposit
+	finalize()
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_EXPIRED, proposal(4).Status)
+	require.Equal(t, uint64(750+100+100), pool())
+
+	// governance moves tokens around without changing the supply
+	supply, err := s.GetSupply(ctx, db)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10_000+40_000+5*1000), supply.Total)
+}
+
+func TestGovernanceQueries(t *testing.T) {
+	ctx := t.Context()
+
+	app := StartTestApp(ctx, t.TempDir())
+	t.Cleanup(func() {
+		app.Stop()
+	})
+	client := app.SDK()
+
+	params, err := client.GetGovParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, config.DefaultGovParams().MinDeposit, params.MinDeposit)
+	require.NoError(t, client.FaucetTokens(ctx, client.GetPublicKey(), params.MinDeposit+1000))
+
+	content := &v1.ProposalContent{Content: &v1.ProposalContent_Upgrade{Upgrade: &v1.UpgradePlan{Name: "v2", Height: 1_000_000}}}
+	_, err = client.SubmitProposal(ctx, "", content, 0)
+	require.ErrorContains(t, err, "title must not be empty")
+	submitted, err := client.SubmitProposal(ctx, "upgrade to v2", content, params.MinDeposit)
+	require.NoError(t, err)
+
+	proposal, err := client.GetProposal(ctx, submitted.ProposalId)
+	require.NoError(t, err)
+	require.Equal(t, "upgrade to v2", proposal.Title)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD, proposal.Status)
+	deposit, err := client.GetProposalDeposit(ctx, submitted.ProposalId, client.GetPublicKey())
+	require.NoError(t, err)
+	require.Equal(t, params.MinDeposit, deposit.Amount)
+
+	// only accounts with tokens bonded to an active validator can vote
+	_, err = client.Vote(ctx, submitted.ProposalId, v1.VoteOption_VOTE_OPTION_ABSTAIN)
+	require.ErrorContains(t, err, "no tokens bonded to an active validator")
+	validators, err := client.GetValidators(ctx)
+	require.NoError(t, err)
+	_, err = client.Delegate(ctx, validators.Validators[0].Pubkey, 1000)
+	require.NoError(t, err)
+	_, err = client.Vote(ctx, submitted.ProposalId, v1.VoteOption_VOTE_OPTION_ABSTAIN)
+	require.NoError(t, err)
+	vote, err := client.GetProposalVote(ctx, submitted.ProposalId, client.GetPublicKey())
+	require.NoError(t, err)
+	require.Equal(t, v1.VoteOption_VOTE_OPTION_ABSTAIN, vote.Option)
+	_, err = client.GetProposalVote(ctx, submitted.ProposalId, utils.FaucetPubkey)
+	require.ErrorIs(t, err, sdk.ErrNotFound)
+
+	_, err = client.GetProposal(ctx, submitted.ProposalId+1)
+	require.Error(t, err)
+
+	// every block pays its share of the reward into the community pool
+	pool, err := client.GetCommunityPool(ctx)
+	require.NoError(t, err)
+	require.NotZero(t, pool.Balance)
+}
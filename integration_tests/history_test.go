@@ -0,0 +1,54 @@
+package integrationtests
+
+import (
+	"testing"
+
+	"github.com/alecsavvy/mojave/config"
+	"github.com/alecsavvy/mojave/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoricalQueries(t *testing.T) {
+	ctx := t.Context()
+
+	appConfig := config.DefaultAppConfig()
+	appConfig.StateRetention = 5
+	app := StartTestAppWithConfig(ctx, t.TempDir(), appConfig)
+	t.Cleanup(func() {
+		app.Stop()
+	})
+	client := app.SDK()
+
+	first, err := SendTransaction(ctx, client, keyValueBody("audit", "v1"), nil)
+	require.NoError(t, err)
+	second, err := SendTransaction(ctx, client, keyValueBody("audit", "v2"), nil)
+	require.NoError(t, err)
+	firstHeight, secondHeight := int64(first.Header.BlockHeight), int64(second.Header.BlockHeight)
+
+	_, err = client.GetKeyValueAt(ctx, "audit", firstHeight-1)
+	require.ErrorIs(t, err, sdk.ErrNotFound)
+
+	kvState, err := client.GetKeyValueAt(ctx, "audit", firstHeight)
+	require.NoError(t, err)
+	require.Equal(t, "v1", kvState.Value)
+
+	kvState, err = client.GetKeyValueAt(ctx, "audit", secondHeight)
+	require.NoError(t, err)
+	require.Equal(t, "v2", kvState.Value)
+
+	account, err := client.GetAccountAt(ctx, client.GetPublicKey(), firstHeight)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), account.Nonce)
+
+	_, err = client.GetKeyValueAt(ctx, "audit", secondHeight+1000)
+	require.ErrorContains(t, err, "in the future")
+
+	// once a height falls out of the retention window it can no longer be read
+	require.NoError(t, app.AwaitBlockHeight(ctx, firstHeight+int64(appConfig.StateRetention)+1))
+	_, err = client.GetKeyValueAt(ctx, "audit", firstHeight)
+	require.ErrorContains(t, err, "has been pruned")
+
+	kvState, err = client.GetKeyValue(ctx, "audit")
+	require.NoError(t, err)
+	require.Equal(t, "v2", kvState.Value)
+}
@@ -0,0 +1,175 @@
+package integrationtests
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alecsavvy/mojave/config"
+	mcrypto "github.com/alecsavvy/mojave/crypto"
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/alecsavvy/mojave/utils"
+	"github.com/cockroachdb/pebble/vfs"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmted25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestMempoolValidation(t *testing.T) {
+	ctx := t.Context()
+
+	testApp := StartTestApp(ctx, t.TempDir())
+	t.Cleanup(func() {
+		testApp.Stop()
+	})
+	client := testApp.SDK()
+	recipient := testApp.SDK()
+
+	require.NoError(t, client.FaucetTokens(ctx, client.GetPublicKey(), 1000))
+
+	// a transfer the balance cannot cover never reaches a block
+	_, err := client.TransferTokens(ctx, client.GetPublicKey(), recipient.GetPublicKey(), 1001)
+	require.ErrorContains(t, err, "insufficient funds")
+
+	_, err = client.SetKeyValue(ctx, "oversized", strings.Repeat("v", int(config.DefaultParams().MaxKeyValueValueSize)+1))
+	require.ErrorContains(t, err, "exceeds max value size")
+
+	_, err = client.SetKeyValue(ctx, strings.Repeat("k", int(config.DefaultParams().MaxKeyValueKeySize)+1), "value")
+	require.ErrorContains(t, err, "exceeds max key size")
+
+	_, err = SendTransaction(ctx, client, &v1.TransactionBody{}, nil)
+	require.ErrorContains(t, err, "unknown transaction body type")
+
+	// queued transfers may not spend more than the balance between them
+	transfer := func(amount uint64) *v1.TransactionBody {
+		return &v1.TransactionBody{
+			Body: &v1.TransactionBody_TokenTransfer{
+				TokenTransfer: &v1.TokenTransferTransaction{
+					FromPubkey: client.GetPublicKey(),
+					ToPubkey:   recipient.GetPublicKey(),
+					Amount:     amount,
+				},
+			},
+		}
+	}
+	nonce, err := client.NextNonce(ctx)
+	require.NoError(t, err)
+	broadcast := func(nonce uint64, amount uint64) error {
+		transaction, err := client.NewTransaction(ctx, transfer(amount))
+		require.NoError(t, err)
+		transaction.Header.Nonce = nonce
+		signedTransaction, err := client.SignTransaction(transaction)
+		require.NoError(t, err)
+		tx, err := proto.Marshal(signedTransaction)
+		require.NoError(t, err)
+		_, err = client.BroadcastTxSync(ctx, tx)
+		return err
+	}
+
+	// the same signed transaction with an unknown field added would have another tx hash
+	transaction, err := client.NewTransaction(ctx, transfer(600))
+	require.NoError(t, err)
+	transaction.Header.Nonce = nonce
+	signedTransaction, err := client.SignTransaction(transaction)
+	require.NoError(t, err)
+	signedTransaction.Transaction = protowire.AppendTag(signedTransaction.Transaction, 99, protowire.VarintType)
+	signedTransaction.Transaction = protowire.AppendVarint(signedTransaction.Transaction, 1)
+	tx, err := proto.Marshal(signedTransaction)
+	require.NoError(t, err)
+	_, err = client.BroadcastTxSync(ctx, tx)
+	require.ErrorContains(t, err, "not canonically encoded")
+
+	require.NoError(t, broadcast(nonce, 600))
+	require.ErrorContains(t, broadcast(nonce+1, 600), "insufficient funds")
+	require.NoError(t, broadcast(nonce+1, 400))
+
+	require.Eventually(t, func() bool {
+		account, err := recipient.GetAccount(ctx, recipient.GetPublicKey())
+		return err == nil && account.Balance == 1000
+	}, 10*time.Second, 100*time.Millisecond)
+
+	account, err := client.GetAccount(ctx, client.GetPublicKey())
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), account.Balance)
+	require.Equal(t, nonce+1, account.Nonce)
+}
+
+func TestRecheckEvictsStaleTransactions(t *testing.T) {
+	ctx := t.Context()
+
+	kvstore, db := openApp(t, vfs.NewMem())
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	validatorKey := cmted25519.GenPrivKey().PubKey()
+	initChain := &abcitypes.InitChainRequest{
+		ChainId:       "recheck-test",
+		Validators:    []abcitypes.ValidatorUpdate{abcitypes.NewValidatorUpdate(validatorKey, 10)},
+		AppStateBytes: faucetAppState(t),
+	}
+	_, err := kvstore.InitChain(ctx, initChain)
+	require.NoError(t, err)
+
+	senderPubkey, senderKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	recipient, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	transfer := func(key ed25519.PrivateKey, nonce uint64, to []byte, amount uint64) []byte {
+		from := key.Public().(ed25519.PublicKey)
+		signedTransaction, err := mcrypto.SignTransaction(key, &v1.Transaction{
+			Header: &v1.TransactionHeader{
+				ChainId:    initChain.ChainId,
+				Nonce:      nonce,
+				FromPubkey: from,
+				WattLimit:  100_000,
+			},
+			Body: &v1.TransactionBody{
+				Body: &v1.TransactionBody_TokenTransfer{
+					TokenTransfer: &v1.TokenTransferTransaction{FromPubkey: from, ToPubkey: to, Amount: amount},
+				},
+			},
+		})
+		require.NoError(t, err)
+		tx, err := proto.Marshal(signedTransaction)
+		require.NoError(t, err)
+		return tx
+	}
+	commitBlock := func(height int64, txs ...[]byte) {
+		finalized, err := kvstore.FinalizeBlock(ctx, &abcitypes.FinalizeBlockRequest{Height: height, Txs: txs, ProposerAddress: validatorKey.Address()})
+		require.NoError(t, err)
+		for _, result := range finalized.TxResults {
+			require.Zero(t, result.Code, result.Log)
+		}
+		_, err = kvstore.Commit(ctx, &abcitypes.CommitRequest{})
+		require.NoError(t, err)
+	}
+	checkTx := func(tx []byte, checkType abcitypes.CheckTxType) uint32 {
+		response, err := kvstore.CheckTx(ctx, &abcitypes.CheckTxRequest{Tx: tx, Type: checkType})
+		require.NoError(t, err)
+		return response.Code
+	}
+
+	commitBlock(1, transfer(utils.FaucetPrivateKey, 1, senderPubkey, 1000))
+
+	first := transfer(senderKey, 1, recipient, 600)
+	second := transfer(senderKey, 2, recipient, 400)
+	require.Zero(t, checkTx(first, abcitypes.CHECK_TX_TYPE_CHECK))
+	require.Zero(t, checkTx(second, abcitypes.CHECK_TX_TYPE_CHECK))
+	require.Equal(t, uint32(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INSUFFICIENT_FUNDS),
+		checkTx(transfer(senderKey, 3, recipient, 1), abcitypes.CHECK_TX_TYPE_CHECK))
+
+	// another proposer's block spends the sender's nonce and most of its funds
+	commitBlock(2, transfer(senderKey, 1, recipient, 900))
+
+	require.Equal(t, uint32(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_NONCE),
+		checkTx(first, abcitypes.CHECK_TX_TYPE_RECHECK))
+	require.Equal(t, uint32(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INSUFFICIENT_FUNDS),
+		checkTx(second, abcitypes.CHECK_TX_TYPE_RECHECK))
+	require.Zero(t, checkTx(transfer(senderKey, 2, recipient, 100), abcitypes.CHECK_TX_TYPE_CHECK))
+}
@@ -0,0 +1,162 @@
+package integrationtests
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/alecsavvy/mojave/config"
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/alecsavvy/mojave/store"
+	"github.com/alecsavvy/mojave/utils"
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/vfs"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmted25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMint(t *testing.T) {
+	ctx := t.Context()
+
+	kvstore, db := openApp(t, vfs.NewMem())
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	// the reward halves every 100 blocks and the proposer keeps a fifth of it
+	appState, err := config.MarshalAppState(&v1.GenesisState{
+		StakingParams: &v1.StakingParams{UnbondingPeriod: 10, TokensPerPower: 1000},
+		MintParams:    &v1.MintParams{BlockReward: 1000, ReductionInterval: 100, ReductionBps: 5_000, ProposerBonusBps: 2_000},
+		Accounts:      []*v1.GenesisAccount{{Pubkey: utils.FaucetPubkey, Balance: 5000}},
+	})
+	require.NoError(t, err)
+	keyA, keyB := cmted25519.GenPrivKey(), cmted25519.GenPrivKey()
+	initChain := &abcitypes.InitChainRequest{
+		ChainId: "mint-test",
+		Validators: []abcitypes.ValidatorUpdate{
+			abcitypes.NewValidatorUpdate(keyA.PubKey(), 10),
+			abcitypes.NewValidatorUpdate(keyB.PubKey(), 30),
+		},
+		AppStateBytes: appState,
+	}
+	_, err = kvstore.InitChain(ctx, initChain)
+	require.NoError(t, err)
+
+	s := store.NewStore(db)
+	requireSupply := func(total, minted, burned uint64) {
+		t.Helper()
+		supply, err := s.GetSupply(ctx, db)
+		require.NoError(t, err)
+		require.Equal(t, [3]uint64{total, minted, burned}, [3]uint64{supply.Total, supply.Minted, supply.Burned})
+	}
+	balance := func(key cmted25519.PrivKey) uint64 {
+		t.Helper()
+		account, err := s.GetAccount(ctx, db, key.PubKey().Bytes())
+		if errors.Is(err, pebble.ErrNotFound) {
+			return 0
+		}
+		require.NoError(t, err)
+		return account.Balance
+	}
+	// genesis balances and bonds make up the initial supply
+	requireSupply(5000+40_000, 0, 0)
+
+	validatorA := abcitypes.Validator{Address: keyA.PubKey().Address(), Power: 10}
+	validatorB := abcitypes.Validator{Address: keyB.PubKey().Address(), Power: 30}
+	height := int64(0)
+	finalize := func(proposer abcitypes.Validator, votes ...abcitypes.VoteInfo) {
+		t.Helper()
+		height++
+		_, err := kvstore.FinalizeBlock(ctx, &abcitypes.FinalizeBlockRequest{
+			Height:            height,
+			DecidedLastCommit: abcitypes.CommitInfo{Votes: votes},
+			ProposerAddress:   proposer.Address,
+		})
+		require.NoError(t, err)
+		_, err = kvstore.Commit(ctx, &abcitypes.CommitRequest{})
+		require.NoError(t, err)
+	}
+
+	// the first block has no last commit, so its proposer gets the whole reward
+	finalize(validatorA)
+	require.Equal(t, uint64(1000), balance(keyA))
+	require.Zero(t, balance(keyB))
+
+	// signers split 800 by power and the proposer adds its bonus of 200
+	finalize(validatorA,
+		abcitypes.VoteInfo{Validator: validatorA, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+		abcitypes.VoteInfo{Validator: validatorB, BlockIdFlag: cmtproto.BlockIDFlagCommit})
+	require.Equal(t, uint64(1000+200+200), balance(keyA))
+	require.Equal(t, uint64(600), balance(keyB))
+
+	// the reward holds for the rest of the interval
+	for height < 100 {
+		finalize(validatorA)
+	}
+	require.Equal(t, uint64(1400+98*1000), balance(keyA))
+	requireSupply(45_000+100_000, 100_000, 0)
+
+	// the reward halves, and validators that did not sign get no share of it
+	finalize(validatorB,
+		abcitypes.VoteInfo{Validator: validatorA, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+		abcitypes.VoteInfo{Validator: validatorB, BlockIdFlag: cmtproto.BlockIDFlagAbsent})
+	require.Equal(t, uint64(99_400+400), balance(keyA))
+	require.Equal(t, uint64(600+100), balance(keyB))
+	requireSupply(145_000+500, 100_500, 0)
+
+	// slashed tokens are burned
+	_, err = kvstore.FinalizeBlock(ctx, &abcitypes.FinalizeBlockRequest{
+		Height:          height + 1,
+		ProposerAddress: validatorA.Address,
+		Misbehavior: []abcitypes.Misbehavior{{
+			Type:      abcitypes.MISBEHAVIOR_TYPE_DUPLICATE_VOTE,
+			Validator: validatorB,
+			Height:    height,
+		}},
+	})
+	require.NoError(t, err)
+	_, err = kvstore.Commit(ctx, &abcitypes.CommitRequest{})
+	require.NoError(t, err)
+	burned := uint64(30_000) * uint64(config.DefaultSlashingParams().DoubleSignSlashBps) / 10_000
+	requireSupply(145_500+500-burned, 101_000, burned)
+
+	// the share of a signer the state does not know goes to the community pool instead
+	height++
+	unknown := abcitypes.Validator{Address: cmted25519.GenPrivKey().PubKey().Address(), Power: 10}
+	balanceA := balance(keyA)
+	finalize(validatorA,
+		abcitypes.VoteInfo{Validator: validatorA, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+		abcitypes.VoteInfo{Validator: unknown, BlockIdFlag: cmtproto.BlockIDFlagCommit})
+	require.Equal(t, balanceA+200+100, balance(keyA))
+	pool, err := s.GetCommunityPool(ctx, db)
+	require.NoError(t, err)
+	require.Equal(t, uint64(200), pool.Balance)
+	requireSupply(146_000-burned+500, 101_500, burned)
+}
+
+func TestSupplyQuery(t *testing.T) {
+	ctx := t.Context()
+
+	app := StartTestApp(ctx, t.TempDir())
+	t.Cleanup(func() {
+		app.Stop()
+	})
+	client := app.SDK()
+
+	params, err := client.GetMintParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, config.DefaultMintParams().BlockReward, params.BlockReward)
+
+	status, err := client.Status(ctx)
+	require.NoError(t, err)
+	height := status.SyncInfo.LatestBlockHeight
+	require.NoError(t, app.AwaitBlockHeight(ctx, height+1))
+
+	before, err := client.GetSupplyAt(ctx, height)
+	require.NoError(t, err)
+	after, err := client.GetSupplyAt(ctx, height+1)
+	require.NoError(t, err)
+	require.Equal(t, before.Total+params.BlockReward, after.Total)
+	require.Equal(t, before.Minted+params.BlockReward, after.Minted)
+}
@@ -0,0 +1,44 @@
+package integrationtests
+
+import (
+	"testing"
+
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNonceReplay(t *testing.T) {
+	ctx := t.Context()
+
+	app := StartTestApp(ctx, t.TempDir())
+	t.Cleanup(func() {
+		app.Stop()
+	})
+	client := app.SDK()
+
+	_, err := client.SetKeyValue(ctx, "nonce", "first")
+	require.NoError(t, err)
+
+	account, err := client.GetAccount(ctx, client.GetPublicKey())
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), account.Nonce)
+
+	send := func(nonce uint64, value string) error {
+		_, err := SendTransaction(ctx, client, keyValueBody("nonce", value), func(tx *v1.Transaction) {
+			tx.Header.Nonce = nonce
+		})
+		return err
+	}
+
+	// replayed nonce
+	require.ErrorContains(t, send(1, "replayed"), "invalid nonce")
+
+	// skipped nonce
+	require.ErrorContains(t, send(3, "out of order"), "invalid nonce")
+
+	require.NoError(t, send(2, "second"))
+
+	kvState, err := client.GetKeyValue(ctx, "nonce")
+	require.NoError(t, err)
+	require.Equal(t, "second", kvState.Value)
+}
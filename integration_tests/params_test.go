@@ -0,0 +1,82 @@
+package integrationtests
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alecsavvy/mojave/config"
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/alecsavvy/mojave/utils"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestParams(t *testing.T) {
+	ctx := t.Context()
+
+	app := StartTestApp(ctx, t.TempDir())
+	t.Cleanup(func() {
+		app.Stop()
+	})
+	client := app.SDK()
+	admin := app.SDK()
+	admin.SetPrivateKey(utils.DevAdminPrivateKey)
+	require.NoError(t, client.FaucetTokens(ctx, client.GetPublicKey(), 1000))
+
+	params, err := client.GetParams(ctx)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(config.DefaultParams(), params))
+
+	updated := proto.Clone(params).(*v1.Params)
+	updated.MaxKeyValueValueSize = 16
+	updated.MinFee = 5
+
+	_, err = client.UpdateParams(ctx, &v1.UpdateParamsTransaction{Params: updated})
+	require.ErrorContains(t, err, "signed by the params admin")
+	_, err = admin.UpdateParams(ctx, &v1.UpdateParamsTransaction{Params: &v1.Params{MaxTxSize: 1}})
+	require.ErrorContains(t, err, "must be positive")
+	_, err = admin.UpdateParams(ctx, &v1.UpdateParamsTransaction{
+		StakingParams: &v1.StakingParams{UnbondingPeriod: 10, TokensPerPower: 1},
+	})
+	require.ErrorContains(t, err, "tokens per power cannot change")
+	_, err = admin.UpdateParams(ctx, &v1.UpdateParamsTransaction{
+		ConsensusParams: &v1.ConsensusParamsUpdate{BlockMaxBytes: -2},
+	})
+	require.ErrorContains(t, err, "block.MaxBytes")
+	// evidence must not outlive the tokens unbonding at the infraction
+	_, err = admin.UpdateParams(ctx, &v1.UpdateParamsTransaction{
+		ConsensusParams: &v1.ConsensusParamsUpdate{EvidenceMaxAgeNumBlocks: int64(config.DefaultStakingParams().UnbondingPeriod) + 1},
+	})
+	require.ErrorContains(t, err, "exceeds the unbonding period")
+	_, err = admin.UpdateParams(ctx, &v1.UpdateParamsTransaction{
+		StakingParams: &v1.StakingParams{UnbondingPeriod: 10, TokensPerPower: config.DefaultStakingParams().TokensPerPower},
+	})
+	require.ErrorContains(t, err, "exceeds the unbonding period")
+
+	_, err = admin.UpdateParams(ctx, &v1.UpdateParamsTransaction{
+		Params:          updated,
+		ConsensusParams: &v1.ConsensusParamsUpdate{BlockMaxBytes: 1 << 20},
+	})
+	require.NoError(t, err)
+
+	params, err = client.GetParams(ctx)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(updated, params))
+
+	// transactions are checked against the new params
+	_, err = client.SetKeyValue(ctx, "params", "value")
+	require.ErrorContains(t, err, "below min fee")
+	client.SetFee(5)
+	_, err = client.SetKeyValue(ctx, "params", strings.Repeat("v", 17))
+	require.ErrorContains(t, err, "exceeds max value size")
+	_, err = client.SetKeyValue(ctx, "params", "value")
+	require.NoError(t, err)
+
+	// CometBFT takes up the consensus params from the next block
+	require.Eventually(t, func() bool {
+		result, err := client.ConsensusParams(ctx, nil)
+		require.NoError(t, err)
+		return result.ConsensusParams.Block.MaxBytes == 1<<20
+	}, 10*time.Second, 100*time.Millisecond)
+}
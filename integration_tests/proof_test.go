@@ -0,0 +1,125 @@
+package integrationtests
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alecsavvy/mojave/sdk"
+	"github.com/alecsavvy/mojave/utils"
+	"github.com/cometbft/cometbft/light"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifiedQueries(t *testing.T) {
+	ctx := t.Context()
+
+	app := StartTestApp(ctx, t.TempDir())
+	t.Cleanup(func() {
+		app.Stop()
+	})
+	client := app.SDK()
+
+	// trust the first block, as a client would after checking it out of band
+	height := int64(1)
+	commit, err := client.Commit(ctx, &height)
+	require.NoError(t, err)
+
+	// the light client needs a witness, and the test network only has the one node
+	err = client.EnableProofVerification(ctx, light.TrustOptions{
+		Period: time.Hour,
+		Height: height,
+		Hash:   commit.Hash(),
+	}, []string{app.config.RPC.ListenAddress})
+	require.NoError(t, err)
+
+	result, err := SendTransaction(ctx, client, keyValueBody("cometbft", "rocks"), nil)
+	require.NoError(t, err)
+
+	kvState, err := client.GetKeyValue(ctx, "cometbft")
+	require.NoError(t, err)
+	require.Equal(t, "rocks", kvState.Value)
+
+	// past heights are proven against their own app hash
+	_, err = client.SetKeyValue(ctx, "cometbft", "still rocks")
+	require.NoError(t, err)
+	kvState, err = client.GetKeyValueAt(ctx, "cometbft", int64(result.Header.BlockHeight))
+	require.NoError(t, err)
+	require.Equal(t, "rocks", kvState.Value)
+
+	_, err = client.GetKeyValue(ctx, "missing")
+	require.ErrorIs(t, err, sdk.ErrNotFound)
+
+	account, err := client.GetAccount(ctx, client.GetPublicKey())
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), account.Nonce)
+
+	// an account that was never written is proven absent and reads as empty
+	account, err = client.GetAccount(ctx, make([]byte, 32))
+	require.NoError(t, err)
+	require.Zero(t, account.Balance)
+}
+
+func TestVerifiedQueriesRejectOldState(t *testing.T) {
+	ctx := t.Context()
+
+	app := StartTestApp(ctx, t.TempDir())
+	t.Cleanup(func() {
+		app.Stop()
+	})
+	node := strings.Replace(app.config.RPC.ListenAddress, "tcp://", "http://", 1)
+
+	// a node that answers every query with the state of height 2, which still proves against
+	// the app hash of height 2
+	const oldHeight = 2
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		if request["method"] == "abci_query" {
+			request["params"].(map[string]any)["height"] = strconv.Itoa(oldHeight)
+		}
+		body, err := json.Marshal(request)
+		require.NoError(t, err)
+		resp, err := http.Post(node, "application/json", bytes.NewReader(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		w.Header().Set("Content-Type", "application/json")
+		_, err = io.Copy(w, resp.Body)
+		require.NoError(t, err)
+	}))
+	t.Cleanup(proxy.Close)
+
+	client, err := sdk.NewMojaveSDK(proxy.URL)
+	require.NoError(t, err)
+	height := int64(1)
+	commit, err := client.Commit(ctx, &height)
+	require.NoError(t, err)
+	err = client.EnableProofVerification(ctx, light.TrustOptions{
+		Period: time.Hour,
+		Height: height,
+		Hash:   commit.Hash(),
+	}, []string{app.config.RPC.ListenAddress})
+	require.NoError(t, err)
+	client.SetMaxQueryLag(2)
+
+	require.Eventually(t, func() bool {
+		status, err := client.Status(ctx)
+		return err == nil && status.SyncInfo.LatestBlockHeight > oldHeight+3
+	}, 20*time.Second, 100*time.Millisecond)
+
+	_, err = client.GetAccountAt(ctx, utils.FaucetPubkey, oldHeight+1)
+	require.ErrorContains(t, err, "query for height 3 was answered at height 2")
+	_, err = client.GetAccount(ctx, utils.FaucetPubkey)
+	require.ErrorContains(t, err, "blocks behind trusted height")
+
+	// the old state itself is still served when it is what was asked for
+	account, err := client.GetAccountAt(ctx, utils.FaucetPubkey, oldHeight)
+	require.NoError(t, err)
+	require.NotZero(t, account.Balance)
+}
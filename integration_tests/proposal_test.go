@@ -0,0 +1,160 @@
+package integrationtests
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"github.com/alecsavvy/mojave/config"
+	mcrypto "github.com/alecsavvy/mojave/crypto"
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/alecsavvy/mojave/utils"
+	"github.com/cockroachdb/pebble/vfs"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmted25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+// signTx signs a transaction from key with the given header fields and marshals it.
+func signTx(t *testing.T, key ed25519.PrivateKey, header *v1.TransactionHeader, body *v1.TransactionBody) []byte {
+	t.Helper()
+	header.FromPubkey = key.Public().(ed25519.PublicKey)
+	if header.WattLimit == 0 {
+		header.WattLimit = 100_000
+	}
+	signedTransaction, err := mcrypto.SignTransaction(key, &v1.Transaction{Header: header, Body: body})
+	require.NoError(t, err)
+	tx, err := proto.Marshal(signedTransaction)
+	require.NoError(t, err)
+	return tx
+}
+
+func TestPrepareProposal(t *testing.T) {
+	ctx := t.Context()
+
+	kvstore, db := openApp(t, vfs.NewMem())
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	validatorKey := cmted25519.GenPrivKey().PubKey()
+	initChain := &abcitypes.InitChainRequest{
+		ChainId:       "proposal-test",
+		Validators:    []abcitypes.ValidatorUpdate{abcitypes.NewValidatorUpdate(validatorKey, 10)},
+		AppStateBytes: faucetAppState(t),
+	}
+	_, err := kvstore.InitChain(ctx, initChain)
+	require.NoError(t, err)
+
+	keys := make([]ed25519.PrivateKey, 3)
+	var funding [][]byte
+	for i := range keys {
+		_, keys[i], err = ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		funding = append(funding, signTx(t, utils.FaucetPrivateKey,
+			&v1.TransactionHeader{ChainId: initChain.ChainId, Nonce: uint64(i + 1)},
+			&v1.TransactionBody{Body: &v1.TransactionBody_TokenTransfer{TokenTransfer: &v1.TokenTransferTransaction{
+				FromPubkey: utils.FaucetPubkey,
+				ToPubkey:   keys[i].Public().(ed25519.PublicKey),
+				Amount:     1000,
+			}}}))
+	}
+	_, err = kvstore.FinalizeBlock(ctx, &abcitypes.FinalizeBlockRequest{Height: 1, Txs: funding, ProposerAddress: validatorKey.Address()})
+	require.NoError(t, err)
+	_, err = kvstore.Commit(ctx, &abcitypes.CommitRequest{})
+	require.NoError(t, err)
+
+	tx := func(key ed25519.PrivateKey, nonce uint64, fee uint64, wattLimit uint64) []byte {
+		return signTx(t, key, &v1.TransactionHeader{ChainId: initChain.ChainId, Nonce: nonce, Fee: fee, WattLimit: wattLimit},
+			keyValueBody("proposal", "value"))
+	}
+	a1 := tx(keys[0], 1, 1, 0)
+	a2 := tx(keys[0], 2, 100, 0)
+	b1 := tx(keys[1], 1, 50, 0)
+	c1 := tx(keys[2], 1, 10, 0)
+	prepare := func(maxTxBytes int64, txs ...[]byte) [][]byte {
+		t.Helper()
+		proposal, err := kvstore.PrepareProposal(ctx, &abcitypes.PrepareProposalRequest{
+			Txs:        txs,
+			MaxTxBytes: maxTxBytes,
+			Height:     2,
+		})
+		require.NoError(t, err)
+		return proposal.Txs
+	}
+
+	// highest fee first, but a sender's high fee transaction still waits for its earlier nonce
+	require.Equal(t, [][]byte{b1, c1, a1, a2}, prepare(1<<20,
+		a1,
+		a2,
+		b1,
+		[]byte("not a transaction"),
+		c1,
+		b1,
+		tx(keys[2], 5, 1000, 0),
+		tx(keys[1], 2, 2000, 0),
+	))
+
+	// what does not fit is left out along with the rest of its sender's transactions
+	maxTxBytes := cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{b1, c1})
+	require.Equal(t, [][]byte{b1, c1}, prepare(maxTxBytes, a1, a2, b1, c1))
+
+	greedy := tx(keys[2], 1, 10, config.DefaultParams().MaxBlockWatts)
+	require.Equal(t, [][]byte{b1, a1, a2}, prepare(1<<20, a1, a2, b1, greedy))
+}
+
+func TestProcessProposal(t *testing.T) {
+	ctx := t.Context()
+
+	kvstore, db := openApp(t, vfs.NewMem())
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	validatorKey := cmted25519.GenPrivKey().PubKey()
+	initChain := &abcitypes.InitChainRequest{
+		ChainId:    "proposal-test",
+		Validators: []abcitypes.ValidatorUpdate{abcitypes.NewValidatorUpdate(validatorKey, 10)},
+	}
+	_, err := kvstore.InitChain(ctx, initChain)
+	require.NoError(t, err)
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	tx := func(nonce uint64, chainID string, wattLimit uint64) []byte {
+		return signTx(t, key, &v1.TransactionHeader{ChainId: chainID, Nonce: nonce, WattLimit: wattLimit},
+			keyValueBody("proposal", "value"))
+	}
+	process := func(txs ...[]byte) abcitypes.ProcessProposalStatus {
+		t.Helper()
+		resp, err := kvstore.ProcessProposal(ctx, &abcitypes.ProcessProposalRequest{Txs: txs, Height: 1})
+		require.NoError(t, err)
+		return resp.Status
+	}
+
+	valid := tx(1, initChain.ChainId, 0)
+	require.Equal(t, abcitypes.PROCESS_PROPOSAL_STATUS_ACCEPT, process())
+	require.Equal(t, abcitypes.PROCESS_PROPOSAL_STATUS_ACCEPT, process(valid, tx(2, initChain.ChainId, 0)))
+
+	require.Equal(t, abcitypes.PROCESS_PROPOSAL_STATUS_REJECT, process(valid, []byte("not a transaction")))
+	require.Equal(t, abcitypes.PROCESS_PROPOSAL_STATUS_REJECT, process(valid, valid))
+	require.Equal(t, abcitypes.PROCESS_PROPOSAL_STATUS_REJECT, process(tx(1, "another-chain", 0)))
+
+	forged := &v1.SignedTransaction{}
+	require.NoError(t, proto.Unmarshal(valid, forged))
+	forged.Signature[0] ^= 0xff
+	forgedTx, err := proto.Marshal(forged)
+	require.NoError(t, err)
+	require.Equal(t, abcitypes.PROCESS_PROPOSAL_STATUS_REJECT, process(forgedTx))
+
+	// unknown fields are not covered by the signature, but still count towards the size
+	padded := protowire.AppendTag(bytes.Clone(valid), 15, protowire.BytesType)
+	padded = protowire.AppendBytes(padded, make([]byte, config.DefaultParams().MaxTxSize))
+	require.Equal(t, abcitypes.PROCESS_PROPOSAL_STATUS_REJECT, process(padded))
+
+	require.Equal(t, abcitypes.PROCESS_PROPOSAL_STATUS_REJECT, process(tx(1, initChain.ChainId, config.DefaultParams().MaxBlockWatts), tx(2, initChain.ChainId, 1)))
+}
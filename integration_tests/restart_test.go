@@ -0,0 +1,165 @@
+package integrationtests
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"github.com/alecsavvy/mojave/app"
+	"github.com/alecsavvy/mojave/config"
+	mcrypto "github.com/alecsavvy/mojave/crypto"
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/alecsavvy/mojave/store"
+	"github.com/alecsavvy/mojave/utils"
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/vfs"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmted25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRestart(t *testing.T) {
+	ctx := t.Context()
+
+	testApp := StartTestApp(ctx, t.TempDir())
+	t.Cleanup(func() {
+		testApp.Stop()
+	})
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	client := testApp.SDK()
+	client.SetPrivateKey(privateKey)
+
+	require.NoError(t, client.FaucetTokens(ctx, client.GetPublicKey(), 1000))
+
+	faucet, err := client.GetAccount(ctx, utils.FaucetPubkey)
+	require.NoError(t, err)
+	before, err := client.ABCIInfo(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, testApp.Restart(ctx))
+
+	// connections kept alive to the stopped node's RPC server would outlive it
+	client = testApp.SDK()
+	client.SetPrivateKey(privateKey)
+
+	// the app reports where it left off instead of replaying from genesis
+	after, err := client.ABCIInfo(ctx)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, after.Response.LastBlockHeight, before.Response.LastBlockHeight)
+	require.NoError(t, testApp.AwaitBlockHeight(ctx, after.Response.LastBlockHeight+2))
+
+	account, err := client.GetAccount(ctx, client.GetPublicKey())
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), account.Balance)
+
+	restartedFaucet, err := client.GetAccount(ctx, utils.FaucetPubkey)
+	require.NoError(t, err)
+	require.Equal(t, faucet.Balance, restartedFaucet.Balance)
+
+	_, err = client.SetKeyValue(ctx, "after", "restart")
+	require.NoError(t, err)
+}
+
+// openApp opens an app over the store in fs, as a node does when it starts.
+func openApp(t *testing.T, fs vfs.FS) (*app.KVStoreApplication, *pebble.DB) {
+	db, err := pebble.Open("", &pebble.Options{FS: fs})
+	require.NoError(t, err)
+
+	kvstore, err := app.NewKVStoreApplication(zap.NewNop().Sugar(), store.NewStore(db), nil, nil, config.DefaultAppConfig())
+	require.NoError(t, err)
+	return kvstore, db
+}
+
+// faucetAppState is a genesis app state that funds the dev faucet.
+func faucetAppState(t *testing.T) []byte {
+	appState, err := config.MarshalAppState(&v1.GenesisState{
+		Accounts: []*v1.GenesisAccount{{Pubkey: utils.FaucetPubkey, Balance: config.DevFaucetSupply}},
+	})
+	require.NoError(t, err)
+	return appState
+}
+
+func TestRecoverUncommittedBlock(t *testing.T) {
+	ctx := t.Context()
+	fs := vfs.NewMem()
+
+	validatorKey := cmted25519.GenPrivKey().PubKey()
+	initChain := &abcitypes.InitChainRequest{
+		ChainId:       "recover-test",
+		Validators:    []abcitypes.ValidatorUpdate{abcitypes.NewValidatorUpdate(validatorKey, 10)},
+		AppStateBytes: faucetAppState(t),
+	}
+
+	kvstore, db := openApp(t, fs)
+	genesis, err := kvstore.InitChain(ctx, initChain)
+	require.NoError(t, err)
+
+	// the node stops before committing the first block, so CometBFT runs InitChain again
+	require.NoError(t, db.Close())
+	kvstore, db = openApp(t, fs)
+	info, err := kvstore.Info(ctx, &abcitypes.InfoRequest{})
+	require.NoError(t, err)
+	require.Zero(t, info.LastBlockHeight)
+
+	replayedGenesis, err := kvstore.InitChain(ctx, initChain)
+	require.NoError(t, err)
+	require.Equal(t, genesis.AppHash, replayedGenesis.AppHash)
+
+	_, err = kvstore.FinalizeBlock(ctx, &abcitypes.FinalizeBlockRequest{Height: 1, ProposerAddress: validatorKey.Address()})
+	require.NoError(t, err)
+	_, err = kvstore.Commit(ctx, &abcitypes.CommitRequest{})
+	require.NoError(t, err)
+
+	committed, err := kvstore.Info(ctx, &abcitypes.InfoRequest{})
+	require.NoError(t, err)
+
+	recipient, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	signedTransaction, err := mcrypto.SignTransaction(utils.FaucetPrivateKey, &v1.Transaction{
+		Header: &v1.TransactionHeader{
+			ChainId:    initChain.ChainId,
+			Nonce:      1,
+			FromPubkey: utils.FaucetPubkey,
+			WattLimit:  100_000,
+		},
+		Body: &v1.TransactionBody{
+			Body: &v1.TransactionBody_TokenTransfer{
+				TokenTransfer: &v1.TokenTransferTransaction{FromPubkey: utils.FaucetPubkey, ToPubkey: recipient, Amount: 1000},
+			},
+		},
+	})
+	require.NoError(t, err)
+	tx, err := proto.Marshal(signedTransaction)
+	require.NoError(t, err)
+	block := &abcitypes.FinalizeBlockRequest{Height: 2, Txs: [][]byte{tx}, ProposerAddress: validatorKey.Address()}
+
+	// the node stops between FinalizeBlock and Commit
+	finalized, err := kvstore.FinalizeBlock(ctx, block)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	kvstore, db = openApp(t, fs)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	info, err = kvstore.Info(ctx, &abcitypes.InfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, committed.LastBlockHeight, info.LastBlockHeight)
+	require.Equal(t, committed.LastBlockAppHash, info.LastBlockAppHash)
+
+	// CometBFT replays the block, which must reach the same state
+	replayed, err := kvstore.FinalizeBlock(ctx, block)
+	require.NoError(t, err)
+	require.Equal(t, finalized.AppHash, replayed.AppHash)
+	require.Zero(t, replayed.TxResults[0].Code)
+	_, err = kvstore.Commit(ctx, &abcitypes.CommitRequest{})
+	require.NoError(t, err)
+
+	info, err = kvstore.Info(ctx, &abcitypes.InfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), info.LastBlockHeight)
+	require.Equal(t, finalized.AppHash, info.LastBlockAppHash)
+}
@@ -0,0 +1,156 @@
+package integrationtests
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"strconv"
+	"testing"
+
+	"github.com/alecsavvy/mojave/config"
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/alecsavvy/mojave/store"
+	"github.com/cockroachdb/pebble/vfs"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmted25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlashing(t *testing.T) {
+	ctx := t.Context()
+
+	kvstore, db := openApp(t, vfs.NewMem())
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	// a validator is jailed once it misses more than 2 of the last 4 blocks
+	appState, err := config.MarshalAppState(&v1.GenesisState{
+		StakingParams: &v1.StakingParams{UnbondingPeriod: 10, TokensPerPower: 1000},
+		SlashingParams: &v1.SlashingParams{
+			SignedBlocksWindow: 4,
+			MinSignedBps:       5_000,
+			DowntimeSlashBps:   1_000,
+			DoubleSignSlashBps: 5_000,
+			JailPeriod:         3,
+		},
+	})
+	require.NoError(t, err)
+	keyA, keyB := cmted25519.GenPrivKey(), cmted25519.GenPrivKey()
+	initChain := &abcitypes.InitChainRequest{
+		ChainId: "slashing-test",
+		Validators: []abcitypes.ValidatorUpdate{
+			abcitypes.NewValidatorUpdate(keyA.PubKey(), 10),
+			abcitypes.NewValidatorUpdate(keyB.PubKey(), 10),
+		},
+		AppStateBytes: appState,
+	}
+	_, err = kvstore.InitChain(ctx, initChain)
+	require.NoError(t, err)
+
+	validatorA := abcitypes.Validator{Address: keyA.PubKey().Address(), Power: 10}
+	validatorB := abcitypes.Validator{Address: keyB.PubKey().Address(), Power: 10}
+	nonce := uint64(0)
+	unjail := func() []byte {
+		nonce++
+		return signTx(t, ed25519.PrivateKey(keyB.Bytes()), &v1.TransactionHeader{ChainId: initChain.ChainId, Nonce: nonce},
+			&v1.TransactionBody{Body: &v1.TransactionBody_Unjail{Unjail: &v1.UnjailTransaction{}}})
+	}
+	height := int64(0)
+	finalize := func(signedB bool, misbehavior []abcitypes.Misbehavior, txs ...[]byte) *abcitypes.FinalizeBlockResponse {
+		t.Helper()
+		height++
+		flagB := cmtproto.BlockIDFlagCommit
+		if !signedB {
+			flagB = cmtproto.BlockIDFlagAbsent
+		}
+		resp, err := kvstore.FinalizeBlock(ctx, &abcitypes.FinalizeBlockRequest{
+			Height: height,
+			Txs:    txs,
+			DecidedLastCommit: abcitypes.CommitInfo{Votes: []abcitypes.VoteInfo{
+				{Validator: validatorA, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+				{Validator: validatorB, BlockIdFlag: flagB},
+			}},
+			Misbehavior:     misbehavior,
+			ProposerAddress: validatorA.Address,
+		})
+		require.NoError(t, err)
+		_, err = kvstore.Commit(ctx, &abcitypes.CommitRequest{})
+		require.NoError(t, err)
+		return resp
+	}
+	updateB := func(power int64) []abcitypes.ValidatorUpdate {
+		return []abcitypes.ValidatorUpdate{abcitypes.NewValidatorUpdate(keyB.PubKey(), power)}
+	}
+	stateB := func() *v1.ValidatorState {
+		validator, err := store.NewStore(db).GetValidator(ctx, db, validatorB.Address)
+		require.NoError(t, err)
+		return validator
+	}
+
+	finalize(true, nil)
+	require.Empty(t, finalize(false, nil).ValidatorUpdates)
+	require.Empty(t, finalize(false, nil).ValidatorUpdates)
+	missed, err := store.NewStore(db).GetMissedBlocks(ctx, db, validatorB.Address)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, missed.Heights)
+
+	// the third miss jails it and burns a tenth of its bonded tokens
+	jailed := finalize(false, nil)
+	require.Equal(t, updateB(0), jailed.ValidatorUpdates)
+	require.True(t, stateB().Jailed)
+	require.Equal(t, uint64(9000), stateB().Tokens)
+	require.Equal(t, uint64(height+3), stateB().JailedUntil)
+	pubkeyB := hex.EncodeToString(keyB.PubKey().Bytes())
+	require.Contains(t, jailed.Events, event("validator_slashed",
+		"validator_pubkey", pubkeyB, "reason", "downtime", "infraction_height", strconv.FormatInt(height, 10), "burned", "1000"))
+	require.Contains(t, jailed.Events, event("validator_jailed", "validator_pubkey", pubkeyB, "jailed_until", strconv.FormatInt(height+3, 10)))
+
+	early := finalize(true, nil, unjail())
+	require.Equal(t, uint32(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_REQUEST), early.TxResults[0].Code)
+	finalize(true, nil)
+	unjailed := finalize(true, nil, unjail())
+	require.Zero(t, unjailed.TxResults[0].Code)
+	require.Equal(t, updateB(9), unjailed.ValidatorUpdates)
+
+	// misses that fell out of the window no longer count
+	finalize(false, nil)
+	for range 4 {
+		finalize(true, nil)
+	}
+	finalize(false, nil)
+	require.Empty(t, finalize(false, nil).ValidatorUpdates)
+	require.False(t, stateB().Jailed)
+
+	// double signing burns half of the bonded tokens, and of the tokens unbonded since the
+	// misbehavior
+	infraction := height
+	nonce++
+	unbond := signTx(t, ed25519.PrivateKey(keyB.Bytes()), &v1.TransactionHeader{ChainId: initChain.ChainId, Nonce: nonce},
+		&v1.TransactionBody{Body: &v1.TransactionBody_Unbond{Unbond: &v1.UnbondTransaction{ValidatorPubkey: keyB.PubKey().Bytes(), Amount: 1000}}})
+	require.Zero(t, finalize(true, nil, unbond).TxResults[0].Code)
+	evidence := func(validator abcitypes.Validator, height int64) []abcitypes.Misbehavior {
+		return []abcitypes.Misbehavior{{Type: abcitypes.MISBEHAVIOR_TYPE_DUPLICATE_VOTE, Validator: validator, Height: height, TotalVotingPower: 19}}
+	}
+	unbondingB := func() uint64 {
+		t.Helper()
+		queue, err := store.NewStore(db).GetUnbonding(ctx, db, keyB.PubKey().Bytes())
+		require.NoError(t, err)
+		require.Len(t, queue.Entries, 1)
+		return queue.Entries[0].Amount
+	}
+	slashed := finalize(true, evidence(validatorB, infraction))
+	require.Equal(t, updateB(0), slashed.ValidatorUpdates)
+	require.Equal(t, uint64(4000), stateB().Tokens)
+	require.Equal(t, uint64(500), unbondingB())
+	require.Contains(t, slashed.Events, event("validator_slashed", "validator_pubkey", pubkeyB,
+		"reason", abcitypes.MISBEHAVIOR_TYPE_DUPLICATE_VOTE.String(), "infraction_height", strconv.FormatInt(infraction, 10), "burned", "4500"))
+
+	// the same evidence is not punished twice
+	finalize(true, evidence(validatorB, infraction))
+	require.Equal(t, uint64(4000), stateB().Tokens)
+	require.Equal(t, uint64(500), unbondingB())
+
+	// but the last validator with power is never jailed
+	require.Empty(t, finalize(true, evidence(validatorA, height)).ValidatorUpdates)
+}
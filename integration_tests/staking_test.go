@@ -0,0 +1,167 @@
+package integrationtests
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+
+	"github.com/alecsavvy/mojave/config"
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/alecsavvy/mojave/store"
+	"github.com/alecsavvy/mojave/utils"
+	"github.com/cockroachdb/pebble/vfs"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmted25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestStaking(t *testing.T) {
+	ctx := t.Context()
+
+	kvstore, db := openApp(t, vfs.NewMem())
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	// without a validator admin any account can bond its way into the validator set
+	appState, err := config.MarshalAppState(&v1.GenesisState{
+		StakingParams:     &v1.StakingParams{UnbondingPeriod: 2, TokensPerPower: 1000},
+		Accounts:          []*v1.GenesisAccount{{Pubkey: utils.FaucetPubkey, Balance: config.DevFaucetSupply}},
+		ParamsAdminPubkey: utils.FaucetPubkey,
+	})
+	require.NoError(t, err)
+	validatorKey := cmted25519.GenPrivKey().PubKey()
+	initChain := &abcitypes.InitChainRequest{
+		ChainId:       "staking-test",
+		Validators:    []abcitypes.ValidatorUpdate{abcitypes.NewValidatorUpdate(validatorKey, 10)},
+		AppStateBytes: appState,
+	}
+	_, err = kvstore.InitChain(ctx, initChain)
+	require.NoError(t, err)
+
+	_, staker, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	stakerPubkey := []byte(staker.Public().(ed25519.PublicKey))
+	stakerValidator := abcitypes.ValidatorUpdate{PubKeyType: cmted25519.KeyType, PubKeyBytes: stakerPubkey}
+
+	nonces := map[string]uint64{}
+	tx := func(key ed25519.PrivateKey, body *v1.TransactionBody) []byte {
+		nonces[string(key)]++
+		return signTx(t, key, &v1.TransactionHeader{ChainId: initChain.ChainId, Nonce: nonces[string(key)]}, body)
+	}
+	height := int64(0)
+	finalize := func(txs ...[]byte) *abcitypes.FinalizeBlockResponse {
+		t.Helper()
+		height++
+		resp, err := kvstore.FinalizeBlock(ctx, &abcitypes.FinalizeBlockRequest{Height: height, Txs: txs, ProposerAddress: validatorKey.Address()})
+		require.NoError(t, err)
+		_, err = kvstore.Commit(ctx, &abcitypes.CommitRequest{})
+		require.NoError(t, err)
+		return resp
+	}
+	query := func(q *v1.Query) *v1.QueryResponse {
+		t.Helper()
+		data, err := proto.Marshal(q)
+		require.NoError(t, err)
+		resp, err := kvstore.Query(ctx, &abcitypes.QueryRequest{Data: data})
+		require.NoError(t, err)
+		require.Zero(t, resp.Code, resp.Log)
+		response := &v1.QueryResponse{}
+		require.NoError(t, proto.Unmarshal(resp.Value, response))
+		return response
+	}
+	balance := func() uint64 {
+		account, err := store.NewStore(db).GetAccount(ctx, db, stakerPubkey)
+		require.NoError(t, err)
+		return account.Balance
+	}
+
+	finalize(tx(utils.FaucetPrivateKey, &v1.TransactionBody{Body: &v1.TransactionBody_TokenTransfer{
+		TokenTransfer: &v1.TokenTransferTransaction{FromPubkey: utils.FaucetPubkey, ToPubkey: stakerPubkey, Amount: 10_000},
+	}}))
+
+	// the staker bonds to its own validator and the faucet delegates to it
+	bonded := finalize(
+		tx(staker, &v1.TransactionBody{Body: &v1.TransactionBody_Bond{Bond: &v1.BondTransaction{Amount: 3000}}}),
+		tx(utils.FaucetPrivateKey, &v1.TransactionBody{Body: &v1.TransactionBody_Delegate{
+			Delegate: &v1.DelegateTransaction{ValidatorPubkey: stakerPubkey, Amount: 1500},
+		}}),
+	)
+	for _, result := range bonded.TxResults {
+		require.Zero(t, result.Code)
+	}
+	stakerValidator.Power = 4
+	require.Equal(t, []abcitypes.ValidatorUpdate{stakerValidator}, bonded.ValidatorUpdates)
+	require.Equal(t, uint64(7000), balance())
+
+	delegations := query(&v1.Query{Query: &v1.Query_Delegations{Delegations: &v1.DelegationsQuery{DelegatorPubkey: utils.FaucetPubkey}}}).GetDelegations()
+	require.Len(t, delegations.Delegations, 1)
+	require.Equal(t, stakerPubkey, delegations.Delegations[0].ValidatorPubkey)
+	require.Equal(t, uint64(1500), delegations.Delegations[0].Shares)
+
+	unbond := func(amount uint64) []byte {
+		return tx(staker, &v1.TransactionBody{Body: &v1.TransactionBody_Unbond{
+			Unbond: &v1.UnbondTransaction{ValidatorPubkey: stakerPubkey, Amount: amount},
+		}})
+	}
+	overdrawn := finalize(unbond(3001))
+	require.Equal(t, uint32(v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INSUFFICIENT_FUNDS), overdrawn.TxResults[0].Code)
+
+	// unbonded tokens leave the validator's power at once but return to the balance only after the unbonding period
+	unbonded := finalize(unbond(2000))
+	require.Zero(t, unbonded.TxResults[0].Code)
+	stakerValidator.Power = 2
+	require.Equal(t, []abcitypes.ValidatorUpdate{stakerValidator}, unbonded.ValidatorUpdates)
+
+	queue := query(&v1.Query{Query: &v1.Query_Unbonding{Unbonding: &v1.UnbondingQuery{DelegatorPubkey: stakerPubkey}}}).GetUnbonding()
+	require.Len(t, queue.Entries, 1)
+	require.Equal(t, uint64(2000), queue.Entries[0].Amount)
+	require.Equal(t, uint64(height+2), queue.Entries[0].CompletionHeight)
+
+	finalize()
+	require.Equal(t, uint64(7000), balance())
+	completed := finalize()
+	require.Equal(t, uint64(9000), balance())
+	require.Contains(t, completed.Events, event("unbonding_completed", "delegator_pubkey", hex.EncodeToString(stakerPubkey), "amount", "2000"))
+
+	queue = query(&v1.Query{Query: &v1.Query_Unbonding{Unbonding: &v1.UnbondingQuery{DelegatorPubkey: stakerPubkey}}}).GetUnbonding()
+	require.Empty(t, queue.Entries)
+	delegations = query(&v1.Query{Query: &v1.Query_Delegations{Delegations: &v1.DelegationsQuery{DelegatorPubkey: stakerPubkey}}}).GetDelegations()
+	require.Len(t, delegations.Delegations, 1)
+	require.Equal(t, uint64(1000), delegations.Delegations[0].Shares)
+
+	// entries queued after the unbonding period shortens complete before those queued earlier
+	unbondingPeriod := func(period uint64) []byte {
+		return tx(utils.FaucetPrivateKey, &v1.TransactionBody{Body: &v1.TransactionBody_UpdateParams{
+			UpdateParams: &v1.UpdateParamsTransaction{StakingParams: &v1.StakingParams{UnbondingPeriod: period, TokensPerPower: 1000}},
+		}})
+	}
+	faucetUnbond := func(amount uint64) []byte {
+		return tx(utils.FaucetPrivateKey, &v1.TransactionBody{Body: &v1.TransactionBody_Unbond{
+			Unbond: &v1.UnbondTransaction{ValidatorPubkey: stakerPubkey, Amount: amount},
+		}})
+	}
+	faucetQueue := func() []*v1.UnbondingEntry {
+		t.Helper()
+		return query(&v1.Query{Query: &v1.Query_Unbonding{Unbonding: &v1.UnbondingQuery{DelegatorPubkey: utils.FaucetPubkey}}}).GetUnbonding().Entries
+	}
+	for _, result := range finalize(unbondingPeriod(5), faucetUnbond(500), unbondingPeriod(2), faucetUnbond(300)).TxResults {
+		require.Zero(t, result.Code, result.Log)
+	}
+	queued := faucetQueue()
+	require.Len(t, queued, 2)
+	require.Equal(t, []uint64{300, 500}, []uint64{queued[0].Amount, queued[1].Amount})
+	require.Equal(t, []uint64{uint64(height + 2), uint64(height + 5)}, []uint64{queued[0].CompletionHeight, queued[1].CompletionHeight})
+
+	finalize()
+	finalize()
+	queued = faucetQueue()
+	require.Len(t, queued, 1)
+	require.Equal(t, uint64(500), queued[0].Amount)
+	for range 3 {
+		finalize()
+	}
+	require.Empty(t, faucetQueue())
+}
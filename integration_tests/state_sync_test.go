@@ -0,0 +1,199 @@
+package integrationtests
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/alecsavvy/mojave/app"
+	"github.com/alecsavvy/mojave/config"
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/alecsavvy/mojave/store"
+	"github.com/alecsavvy/mojave/utils"
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/vfs"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cfg "github.com/cometbft/cometbft/config"
+	cmted25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestStateSync(t *testing.T) {
+	ctx := t.Context()
+
+	appConfig := config.DefaultAppConfig()
+	appConfig.SnapshotInterval = 3
+	// snapshots are taken quickly, keep enough that the one being synced is not pruned mid-sync
+	appConfig.SnapshotKeepRecent = 10
+	validator := StartTestAppWithConfig(ctx, t.TempDir(), appConfig)
+	t.Cleanup(func() {
+		validator.Stop()
+	})
+	client := validator.SDK()
+
+	_, err := client.SetKeyValue(ctx, "synced", "from a snapshot")
+	require.NoError(t, err)
+	require.NoError(t, client.FaucetTokens(ctx, client.GetPublicKey(), 1000))
+
+	status, err := client.Status(ctx)
+	require.NoError(t, err)
+	trustHeight := status.SyncInfo.LatestBlockHeight
+	trustCommit, err := client.Commit(ctx, &trustHeight)
+	require.NoError(t, err)
+
+	// wait for a snapshot taken after the writes above
+	require.NoError(t, validator.AwaitBlockHeight(ctx, trustHeight+4))
+
+	nodeKey, err := p2p.LoadNodeKey(validator.config.NodeKeyFile())
+	require.NoError(t, err)
+
+	// a fresh node on the same network that state syncs from the validator
+	cmtConfig := cfg.DefaultConfig()
+	cmtConfig.SetRoot(t.TempDir())
+	cmtConfig.P2P.ListenAddress = "tcp://127.0.0.1:26666"
+	cmtConfig.RPC.ListenAddress = "tcp://127.0.0.1:26667"
+	cmtConfig.P2P.PersistentPeers = string(nodeKey.ID()) + "@127.0.0.1:26656"
+	cmtConfig.P2P.AddrBookStrict = false
+	cmtConfig.P2P.AllowDuplicateIP = true
+	cmtConfig.StateSync.Enable = true
+	cmtConfig.StateSync.RPCServers = []string{validator.config.RPC.ListenAddress, validator.config.RPC.ListenAddress}
+	cmtConfig.StateSync.TrustHeight = trustHeight
+	cmtConfig.StateSync.TrustHash = hex.EncodeToString(trustCommit.Hash())
+	cmtConfig.StateSync.DiscoveryTime = 5 * time.Second
+	_, _, _, err = config.InitFilesWithConfig(cmtConfig)
+	require.NoError(t, err)
+
+	genesis, err := os.ReadFile(validator.config.GenesisFile())
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(cmtConfig.GenesisFile(), genesis, 0644))
+
+	a, err := app.NewApp(cmtConfig, appConfig)
+	require.NoError(t, err)
+	follower := &TestApp{config: cmtConfig, appConfig: appConfig, app: a}
+	t.Cleanup(func() {
+		follower.Stop()
+	})
+
+	// the follower starts from a snapshot rather than replaying from genesis, and
+	// keeps executing blocks on top of the restored state
+	require.NoError(t, follower.AwaitBlockHeight(ctx, trustHeight+8))
+	followerClient := follower.SDK()
+	followerStatus, err := followerClient.Status(ctx)
+	require.NoError(t, err)
+	require.Greater(t, followerStatus.SyncInfo.EarliestBlockHeight, int64(1))
+
+	kvState, err := followerClient.GetKeyValue(ctx, "synced")
+	require.NoError(t, err)
+	require.Equal(t, "from a snapshot", kvState.Value)
+
+	account, err := followerClient.GetAccount(ctx, client.GetPublicKey())
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), account.Balance)
+
+	info, err := followerClient.ABCIInfo(ctx)
+	require.NoError(t, err)
+	nextHeight := info.Response.LastBlockHeight + 1
+	require.NoError(t, validator.AwaitBlockHeight(ctx, nextHeight))
+	block, err := client.Block(ctx, &nextHeight)
+	require.NoError(t, err)
+	require.Equal(t, []byte(block.Block.AppHash), info.Response.LastBlockAppHash)
+}
+
+func TestStateSyncRejectsForgedSnapshot(t *testing.T) {
+	ctx := t.Context()
+
+	// the source keeps its store on disk, which snapshot checkpoints need
+	db, err := pebble.Open(t.TempDir(), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	snapshots, err := store.NewSnapshotStore(t.TempDir(), 1, store.DefaultSnapshotChunkSize)
+	require.NoError(t, err)
+	source, err := app.NewKVStoreApplication(zap.NewNop().Sugar(), store.NewStore(db), snapshots, nil, config.DefaultAppConfig())
+	require.NoError(t, err)
+
+	validatorKey := cmted25519.GenPrivKey()
+	_, err = source.InitChain(ctx, &abcitypes.InitChainRequest{
+		ChainId:       "state-sync-test",
+		Validators:    []abcitypes.ValidatorUpdate{abcitypes.NewValidatorUpdate(validatorKey.PubKey(), 10)},
+		AppStateBytes: faucetAppState(t),
+	})
+	require.NoError(t, err)
+	resp, err := source.FinalizeBlock(ctx, &abcitypes.FinalizeBlockRequest{
+		Height: 1,
+		Txs: [][]byte{signTx(t, utils.FaucetPrivateKey, &v1.TransactionHeader{ChainId: "state-sync-test", Nonce: 1},
+			&v1.TransactionBody{Body: &v1.TransactionBody_KeyValue{KeyValue: &v1.KeyValueTransaction{Key: "synced", Value: "genuine value"}}})},
+		ProposerAddress: validatorKey.PubKey().Address(),
+	})
+	require.NoError(t, err)
+	_, err = source.Commit(ctx, &abcitypes.CommitRequest{})
+	require.NoError(t, err)
+	require.NoError(t, snapshots.Checkpoint(db, 1))
+	require.NoError(t, snapshots.Export(1))
+
+	list, err := source.ListSnapshots(ctx, &abcitypes.ListSnapshotsRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Snapshots, 1)
+	snapshot := list.Snapshots[0]
+	var chunks [][]byte
+	for i := range snapshot.Chunks {
+		chunk, err := source.LoadSnapshotChunk(ctx, &abcitypes.LoadSnapshotChunkRequest{Height: snapshot.Height, Format: snapshot.Format, Chunk: i})
+		require.NoError(t, err)
+		chunks = append(chunks, chunk.Chunk)
+	}
+
+	target, targetDB := openApp(t, vfs.NewMem())
+	t.Cleanup(func() {
+		targetDB.Close()
+	})
+	restore := func(snapshot *abcitypes.Snapshot, chunks [][]byte) abcitypes.ApplySnapshotChunkResult {
+		t.Helper()
+		offer, err := target.OfferSnapshot(ctx, &abcitypes.OfferSnapshotRequest{Snapshot: snapshot, AppHash: resp.AppHash})
+		require.NoError(t, err)
+		require.Equal(t, abcitypes.OFFER_SNAPSHOT_RESULT_ACCEPT, offer.Result)
+		var result abcitypes.ApplySnapshotChunkResult
+		for i, chunk := range chunks {
+			applied, err := target.ApplySnapshotChunk(ctx, &abcitypes.ApplySnapshotChunkRequest{Index: uint32(i), Chunk: chunk, Sender: "peer"})
+			require.NoError(t, err)
+			result = applied.Result
+		}
+		return result
+	}
+
+	// a peer forges a leaf value and ships metadata that matches its chunks, so only the
+	// trusted app hash can catch it
+	forged := make([][]byte, len(chunks))
+	metadata := &v1.SnapshotMetadata{}
+	for i, chunk := range chunks {
+		forged[i] = bytes.Replace(chunk, []byte("genuine value"), []byte("forged  value"), 1)
+		hash := sha256.Sum256(forged[i])
+		metadata.ChunkHashes = append(metadata.ChunkHashes, hash[:])
+	}
+	require.False(t, slices.EqualFunc(chunks, forged, bytes.Equal))
+	forgedSnapshot := *snapshot
+	forgedSnapshot.Metadata, err = proto.Marshal(metadata)
+	require.NoError(t, err)
+	hash := sha256.Sum256(forgedSnapshot.Metadata)
+	forgedSnapshot.Hash = hash[:]
+	require.Equal(t, abcitypes.APPLY_SNAPSHOT_CHUNK_RESULT_REJECT_SNAPSHOT, restore(&forgedSnapshot, forged))
+	_, err = store.NewStore(targetDB).GetKeyValue(ctx, targetDB, "synced")
+	require.ErrorIs(t, err, pebble.ErrNotFound)
+
+	// the genuine snapshot is still accepted afterwards
+	require.Equal(t, abcitypes.APPLY_SNAPSHOT_CHUNK_RESULT_ACCEPT, restore(snapshot, chunks))
+	info, err := target.Info(ctx, &abcitypes.InfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), info.LastBlockHeight)
+	require.Equal(t, resp.AppHash, info.LastBlockAppHash)
+	kv, err := store.NewStore(targetDB).GetKeyValue(ctx, targetDB, "synced")
+	require.NoError(t, err)
+	require.Equal(t, "genuine value", kv.Value)
+}
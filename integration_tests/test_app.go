@@ -9,16 +9,24 @@ import (
 
 	"github.com/alecsavvy/mojave/app"
 	"github.com/alecsavvy/mojave/config"
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
 	"github.com/alecsavvy/mojave/sdk"
 	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/privval"
 )
 
 type TestApp struct {
-	config *cfg.Config
-	app    *app.App
+	config    *cfg.Config
+	appConfig *config.AppConfig
+	app       *app.App
 }
 
 func StartTestApp(ctx context.Context, homeDir string) *TestApp {
+	return StartTestAppWithConfig(ctx, homeDir, config.DefaultAppConfig())
+}
+
+// StartTestAppWithConfig starts a single validator network in homeDir with the given app settings.
+func StartTestAppWithConfig(ctx context.Context, homeDir string, appConfig *config.AppConfig) *TestApp {
 	cmtConfig := cfg.DefaultConfig()
 	cmtConfig.SetRoot(homeDir)
 
@@ -26,7 +34,7 @@ func StartTestApp(ctx context.Context, homeDir string) *TestApp {
 		panic(err)
 	}
 
-	a, err := app.NewApp(cmtConfig)
+	a, err := app.NewApp(cmtConfig, appConfig)
 	if err != nil {
 		panic(err)
 	}
@@ -36,8 +44,9 @@ func StartTestApp(ctx context.Context, homeDir string) *TestApp {
 	}
 
 	testApp := &TestApp{
-		config: cmtConfig,
-		app:    a,
+		config:    cmtConfig,
+		appConfig: appConfig,
+		app:       a,
 	}
 
 	if err := testApp.AwaitBlockHeight(ctx, 1); err != nil {
@@ -62,6 +71,16 @@ func (node *TestApp) SDK() *sdk.MojaveSDK {
 	return sdk
 }
 
+// ValidatorPubKey returns the consensus pubkey of the test node, which also owns its validator account.
+func (node *TestApp) ValidatorPubKey() []byte {
+	pv := privval.LoadFilePV(node.config.PrivValidatorKeyFile(), node.config.PrivValidatorStateFile())
+	pubKey, err := pv.GetPubKey()
+	if err != nil {
+		panic(err)
+	}
+	return pubKey.Bytes()
+}
+
 func (node *TestApp) Start() error {
 	if err := node.app.Start(); err != nil {
 		return fmt.Errorf("failed to run app: %w", err)
@@ -73,6 +92,20 @@ func (node *TestApp) Stop() error {
 	return node.app.Stop()
 }
 
+// Restart stops the node and starts a new one from the same home directory.
+func (node *TestApp) Restart(ctx context.Context) error {
+	if err := node.app.Stop(); err != nil {
+		return err
+	}
+
+	a, err := app.NewApp(node.config, node.appConfig)
+	if err != nil {
+		return err
+	}
+	node.app = a
+	return nil
+}
+
 func (node *TestApp) AwaitBlockHeight(ctx context.Context, height int64) error {
 	sdk := node.SDK()
 	for {
@@ -90,3 +123,29 @@ func (node *TestApp) AwaitBlockHeight(ctx context.Context, height int64) error {
 		}
 	}
 }
+
+// SendTransaction builds a transaction for body, lets mutate adjust it before it is signed, and sends it.
+func SendTransaction(ctx context.Context, client *sdk.MojaveSDK, body *v1.TransactionBody, mutate func(*v1.Transaction)) (*v1.TransactionResult, error) {
+	transaction, err := client.NewTransaction(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+	if mutate != nil {
+		mutate(transaction)
+	}
+
+	signedTransaction, err := client.SignTransaction(transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.SendTransaction(ctx, signedTransaction)
+}
+
+func keyValueBody(key string, value string) *v1.TransactionBody {
+	return &v1.TransactionBody{
+		Body: &v1.TransactionBody_KeyValue{
+			KeyValue: &v1.KeyValueTransaction{Key: key, Value: value},
+		},
+	}
+}
@@ -1,9 +1,9 @@
 package integrationtests
 
 import (
-	"math"
 	"testing"
 
+	"github.com/alecsavvy/mojave/config"
 	"github.com/alecsavvy/mojave/utils"
 	"github.com/stretchr/testify/require"
 )
@@ -18,11 +18,11 @@ func TestTransfer(t *testing.T) {
 
 	sdk := app.SDK()
 
-	account, err := sdk.GetAccount(ctx, utils.ZeroAddress)
+	account, err := sdk.GetAccount(ctx, utils.FaucetPubkey)
 	if err != nil {
 		t.Fatalf("failed to get account: %v", err)
 	}
-	require.Equal(t, uint64(math.MaxUint64), account.Balance)
+	require.Equal(t, config.DevFaucetSupply, account.Balance)
 
 	err = sdk.FaucetTokens(ctx, sdk.GetPublicKey(), 1000)
 	if err != nil {
@@ -54,3 +54,38 @@ func TestTransfer(t *testing.T) {
 	}
 	require.Equal(t, uint64(100), account.Balance)
 }
+
+func TestTransferAuthorization(t *testing.T) {
+	ctx := t.Context()
+
+	app := StartTestApp(ctx, t.TempDir())
+	t.Cleanup(func() {
+		app.Stop()
+	})
+
+	owner := app.SDK()
+	thief := app.SDK()
+
+	err := owner.FaucetTokens(ctx, owner.GetPublicKey(), 1000)
+	require.NoError(t, err)
+
+	// moving someone else's funds
+	_, err = thief.TransferTokens(ctx, owner.GetPublicKey(), thief.GetPublicKey(), 1000)
+	require.ErrorContains(t, err, "not signed by its owner")
+
+	// draining the faucet as if it were the zero address
+	_, err = thief.TransferTokens(ctx, utils.ZeroAddress, thief.GetPublicKey(), 1000)
+	require.ErrorContains(t, err, "not signed by its owner")
+
+	// overdraft
+	_, err = owner.TransferTokens(ctx, owner.GetPublicKey(), thief.GetPublicKey(), 1001)
+	require.ErrorContains(t, err, "insufficient funds")
+
+	account, err := owner.GetAccount(ctx, owner.GetPublicKey())
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), account.Balance)
+
+	account, err = thief.GetAccount(ctx, thief.GetPublicKey())
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), account.Balance)
+}
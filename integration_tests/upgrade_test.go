@@ -0,0 +1,156 @@
+package integrationtests
+
+import (
+	"context"
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/alecsavvy/mojave/app"
+	"github.com/alecsavvy/mojave/config"
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/alecsavvy/mojave/sdk"
+	"github.com/alecsavvy/mojave/store"
+	"github.com/alecsavvy/mojave/utils"
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/vfs"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmted25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpgrade(t *testing.T) {
+	ctx := t.Context()
+	fs := vfs.NewMem()
+
+	appState, err := config.MarshalAppState(&v1.GenesisState{
+		GovParams: &v1.GovParams{MinDeposit: 1, DepositPeriod: 1, VotingPeriod: 1, QuorumBps: 5_000, ThresholdBps: 5_000},
+		Accounts:  []*v1.GenesisAccount{{Pubkey: utils.FaucetPubkey, Balance: 1000}},
+	})
+	require.NoError(t, err)
+	validatorKey := cmted25519.GenPrivKey()
+	initChain := &abcitypes.InitChainRequest{
+		ChainId:       "upgrade-test",
+		Validators:    []abcitypes.ValidatorUpdate{abcitypes.NewValidatorUpdate(validatorKey.PubKey(), 10)},
+		AppStateBytes: appState,
+	}
+
+	// the new binary writes a key value in its migration
+	upgrade := app.Upgrade{
+		Name: "v2",
+		Migrate: func(ctx context.Context, s *store.Store, cache *store.Cache) error {
+			return s.SetKeyValue(ctx, cache, &v1.KeyValueState{Key: "migrated", Value: "v2"})
+		},
+	}
+	kvstore, db := openApp(t, fs)
+	reopen := func(binary ...app.Upgrade) {
+		t.Helper()
+		require.NoError(t, db.Close())
+		kvstore, db = openApp(t, fs)
+		for _, upgrade := range binary {
+			kvstore.RegisterUpgrade(upgrade)
+		}
+	}
+	t.Cleanup(func() {
+		db.Close()
+	})
+	appVersion := func() uint64 {
+		t.Helper()
+		info, err := kvstore.Info(ctx, &abcitypes.InfoRequest{})
+		require.NoError(t, err)
+		require.Equal(t, app.Version, info.Version)
+		return info.AppVersion
+	}
+	finalize := func(height int64, txs ...[]byte) (*abcitypes.FinalizeBlockResponse, error) {
+		resp, err := kvstore.FinalizeBlock(ctx, &abcitypes.FinalizeBlockRequest{
+			Height:          height,
+			Txs:             txs,
+			ProposerAddress: validatorKey.PubKey().Address(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		_, err = kvstore.Commit(ctx, &abcitypes.CommitRequest{})
+		require.NoError(t, err)
+		return resp, nil
+	}
+
+	require.Equal(t, uint64(1), appVersion())
+	genesis, err := kvstore.InitChain(ctx, initChain)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), genesis.ConsensusParams.Version.App)
+
+	// governance schedules the upgrade at height 4
+	submit := signTx(t, utils.FaucetPrivateKey, &v1.TransactionHeader{ChainId: initChain.ChainId, Nonce: 1},
+		&v1.TransactionBody{Body: &v1.TransactionBody_SubmitProposal{SubmitProposal: &v1.SubmitProposalTransaction{
+			Title:          "upgrade to v2",
+			Content:        &v1.ProposalContent{Content: &v1.ProposalContent_Upgrade{Upgrade: &v1.UpgradePlan{Name: "v2", Height: 4}}},
+			InitialDeposit: 1,
+		}}})
+	vote := signTx(t, ed25519.PrivateKey(validatorKey), &v1.TransactionHeader{ChainId: initChain.ChainId, Nonce: 1},
+		&v1.TransactionBody{Body: &v1.TransactionBody_Vote{Vote: &v1.VoteTransaction{ProposalId: 1, Option: v1.VoteOption_VOTE_OPTION_YES}}})
+	resp, err := finalize(1, submit, vote)
+	require.NoError(t, err)
+	for _, result := range resp.TxResults {
+		require.Zero(t, result.Code)
+	}
+	_, err = finalize(2)
+	require.NoError(t, err)
+	plan, err := store.NewStore(db).GetUpgradePlan(ctx, db)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), plan.Height)
+
+	// the new binary refuses to run before the upgrade height
+	reopen(upgrade)
+	_, err = finalize(3)
+	require.ErrorContains(t, err, "state is at app version 1, but this binary runs app version 2")
+
+	// the old binary runs up to the upgrade height and halts there
+	reopen()
+	_, err = finalize(3)
+	require.NoError(t, err)
+	_, err = finalize(4)
+	require.ErrorContains(t, err, `upgrade "v2" is scheduled at height 4`)
+	require.Equal(t, uint64(1), appVersion())
+
+	// the new binary migrates the state before it runs the block at the upgrade height
+	reopen(upgrade)
+	resp, err = finalize(4)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), resp.ConsensusParamUpdates.Version.App)
+	require.Equal(t, uint64(2), appVersion())
+	s := store.NewStore(db)
+	kv, err := s.GetKeyValue(ctx, db, "migrated")
+	require.NoError(t, err)
+	require.Equal(t, "v2", kv.Value)
+	_, err = s.GetUpgradePlan(ctx, db)
+	require.ErrorIs(t, err, pebble.ErrNotFound)
+	_, err = finalize(5)
+	require.NoError(t, err)
+
+	// the old binary cannot run the upgraded state
+	reopen()
+	_, err = kvstore.Info(ctx, &abcitypes.InfoRequest{})
+	require.ErrorContains(t, err, "newer than the app version 1 of this binary")
+}
+
+func TestAppVersion(t *testing.T) {
+	ctx := t.Context()
+
+	testApp := StartTestApp(ctx, t.TempDir())
+	t.Cleanup(func() {
+		testApp.Stop()
+	})
+	client := testApp.SDK()
+
+	info, err := client.ABCIInfo(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), info.Response.AppVersion)
+	require.Equal(t, app.Version, info.Response.Version)
+
+	block, err := client.Block(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), block.Block.Version.App)
+
+	_, err = client.GetUpgradePlan(ctx)
+	require.ErrorIs(t, err, sdk.ErrNotFound)
+}
@@ -0,0 +1,172 @@
+package integrationtests
+
+import (
+	"crypto/ed25519"
+	"testing"
+	"time"
+
+	"github.com/alecsavvy/mojave/config"
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/alecsavvy/mojave/utils"
+	"github.com/cockroachdb/pebble/vfs"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto"
+	cmted25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorUpdates(t *testing.T) {
+	ctx := t.Context()
+
+	app := StartTestApp(ctx, t.TempDir())
+	t.Cleanup(func() {
+		app.Stop()
+	})
+	client := app.SDK()
+	admin := app.SDK()
+	admin.SetPrivateKey(utils.DevAdminPrivateKey)
+
+	params, err := client.GetStakingParams(ctx)
+	require.NoError(t, err)
+	tokensPerPower := params.TokensPerPower
+
+	validators, err := client.GetValidators(ctx)
+	require.NoError(t, err)
+	require.Len(t, validators.Validators, 1)
+	require.Equal(t, app.ValidatorPubKey(), validators.Validators[0].Pubkey)
+	require.Equal(t, config.DevValidatorBond, validators.Validators[0].Tokens)
+	require.Equal(t, int64(config.DevValidatorBond/tokensPerPower), validators.Validators[0].Power)
+
+	consensusPower := func(pubkey []byte) int64 {
+		result, err := client.Validators(ctx, nil, nil, nil)
+		require.NoError(t, err)
+		for _, validator := range result.Validators {
+			if string(validator.PubKey.Bytes()) == string(pubkey) {
+				return validator.VotingPower
+			}
+		}
+		return 0
+	}
+	require.Equal(t, int64(config.DevValidatorBond/tokensPerPower), consensusPower(app.ValidatorPubKey()))
+
+	// a validator with too little power to matter, so the node keeps producing blocks alone
+	newValidator := cmted25519.GenPrivKey().PubKey().Bytes()
+	require.NoError(t, client.FaucetTokens(ctx, client.GetPublicKey(), 5*tokensPerPower))
+	_, err = client.Delegate(ctx, newValidator, tokensPerPower)
+	require.ErrorContains(t, err, "does not exist")
+
+	_, err = client.AddValidator(ctx, newValidator)
+	require.ErrorContains(t, err, "signed by the validator admin")
+	_, err = admin.AddValidator(ctx, newValidator)
+	require.NoError(t, err)
+	_, err = admin.AddValidator(ctx, newValidator)
+	require.ErrorContains(t, err, "already in the set")
+
+	// an admitted validator only joins the set once tokens are bonded to it
+	validators, err = client.GetValidators(ctx)
+	require.NoError(t, err)
+	require.Len(t, validators.Validators, 1)
+
+	_, err = client.Delegate(ctx, newValidator, 2*tokensPerPower)
+	require.NoError(t, err)
+	validators, err = client.GetValidators(ctx)
+	require.NoError(t, err)
+	require.Len(t, validators.Validators, 2)
+	require.Eventually(t, func() bool {
+		return consensusPower(newValidator) == 2
+	}, 10*time.Second, 100*time.Millisecond)
+
+	_, err = admin.RemoveValidator(ctx, newValidator)
+	require.NoError(t, err)
+	_, err = admin.RemoveValidator(ctx, newValidator)
+	require.ErrorContains(t, err, "not in the set")
+	_, err = client.Delegate(ctx, newValidator, tokensPerPower)
+	require.ErrorContains(t, err, "has been removed")
+	_, err = admin.RemoveValidator(ctx, app.ValidatorPubKey())
+	require.ErrorContains(t, err, "without power")
+
+	require.Eventually(t, func() bool {
+		return consensusPower(newValidator) == 0
+	}, 10*time.Second, 100*time.Millisecond)
+	require.Equal(t, int64(config.DevValidatorBond/tokensPerPower), consensusPower(app.ValidatorPubKey()))
+
+	// delegations to a removed validator can still be unbonded
+	_, err = client.Unbond(ctx, newValidator, 2*tokensPerPower)
+	require.NoError(t, err)
+
+	// with a validator admin, keys it has not admitted cannot bond their way into the set
+	_, err = client.Bond(ctx, tokensPerPower)
+	require.ErrorContains(t, err, "not been admitted")
+}
+
+func TestValidatorUpdatesInBlock(t *testing.T) {
+	ctx := t.Context()
+
+	kvstore, db := openApp(t, vfs.NewMem())
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	validatorKey := cmted25519.GenPrivKey().PubKey()
+	genesis, genesisValidators := config.DevGenesis([]crypto.PubKey{validatorKey})
+	appState, err := config.MarshalAppState(genesis)
+	require.NoError(t, err)
+	initChain := &abcitypes.InitChainRequest{
+		ChainId:       "validators-test",
+		Validators:    []abcitypes.ValidatorUpdate{abcitypes.NewValidatorUpdate(validatorKey, genesisValidators[0].Power)},
+		AppStateBytes: appState,
+	}
+	initialized, err := kvstore.InitChain(ctx, initChain)
+	require.NoError(t, err)
+	require.Equal(t, initChain.Validators, initialized.Validators)
+
+	tokensPerPower := genesis.StakingParams.TokensPerPower
+	nonces := map[string]uint64{}
+	tx := func(key ed25519.PrivateKey, body *v1.TransactionBody) []byte {
+		nonces[string(key)]++
+		return signTx(t, key, &v1.TransactionHeader{ChainId: initChain.ChainId, Nonce: nonces[string(key)]}, body)
+	}
+	add := func(pubkey []byte) []byte {
+		return tx(utils.DevAdminPrivateKey, &v1.TransactionBody{Body: &v1.TransactionBody_AddValidator{
+			AddValidator: &v1.AddValidatorTransaction{Pubkey: pubkey},
+		}})
+	}
+	remove := func(pubkey []byte) []byte {
+		return tx(utils.DevAdminPrivateKey, &v1.TransactionBody{Body: &v1.TransactionBody_RemoveValidator{
+			RemoveValidator: &v1.RemoveValidatorTransaction{Pubkey: pubkey},
+		}})
+	}
+	delegate := func(pubkey []byte, amount uint64) []byte {
+		return tx(utils.FaucetPrivateKey, &v1.TransactionBody{Body: &v1.TransactionBody_Delegate{
+			Delegate: &v1.DelegateTransaction{ValidatorPubkey: pubkey, Amount: amount},
+		}})
+	}
+	height := int64(0)
+	finalize := func(txs ...[]byte) []abcitypes.ValidatorUpdate {
+		t.Helper()
+		height++
+		resp, err := kvstore.FinalizeBlock(ctx, &abcitypes.FinalizeBlockRequest{Height: height, Txs: txs, ProposerAddress: validatorKey.Address()})
+		require.NoError(t, err)
+		for _, result := range resp.TxResults {
+			require.Zero(t, result.Code)
+		}
+		_, err = kvstore.Commit(ctx, &abcitypes.CommitRequest{})
+		require.NoError(t, err)
+		return resp.ValidatorUpdates
+	}
+
+	// a validator changed several times in a block gets a single update with its final power
+	added := cmted25519.GenPrivKey().PubKey()
+	require.Equal(t, []abcitypes.ValidatorUpdate{abcitypes.NewValidatorUpdate(added, 3)},
+		finalize(add(added.Bytes()), delegate(added.Bytes(), tokensPerPower), delegate(added.Bytes(), 2*tokensPerPower)))
+
+	// and one that is added and removed again gets none, as CometBFT never knew it
+	transient := cmted25519.GenPrivKey().PubKey()
+	require.Empty(t, finalize(add(transient.Bytes()), delegate(transient.Bytes(), tokensPerPower), remove(transient.Bytes())))
+
+	// tokens short of a unit of power change no power
+	require.Empty(t, finalize(delegate(added.Bytes(), tokensPerPower-1)))
+
+	require.Equal(t, []abcitypes.ValidatorUpdate{abcitypes.NewValidatorUpdate(added, 0)}, finalize(remove(added.Bytes())))
+	require.Empty(t, finalize())
+}
@@ -0,0 +1,40 @@
+package integrationtests
+
+import (
+	"strings"
+	"testing"
+
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/alecsavvy/mojave/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWattMetering(t *testing.T) {
+	ctx := t.Context()
+
+	app := StartTestApp(ctx, t.TempDir())
+	t.Cleanup(func() {
+		app.Stop()
+	})
+	client := app.SDK()
+
+	small, err := SendTransaction(ctx, client, keyValueBody("watts", "a"), nil)
+	require.NoError(t, err)
+	require.NotZero(t, small.Header.WattsUsed)
+
+	large, err := SendTransaction(ctx, client, keyValueBody("watts", strings.Repeat("a", 4096)), nil)
+	require.NoError(t, err)
+	require.Greater(t, large.Header.WattsUsed, small.Header.WattsUsed+4096)
+
+	// enough for the signature check but not the write
+	client.SetWattLimit(2_000)
+	result, err := SendTransaction(ctx, client, keyValueBody("watts", strings.Repeat("a", 4096)), nil)
+	var txErr *sdk.TransactionError
+	require.ErrorAs(t, err, &txErr)
+	require.Equal(t, v1.TransactionResultErrorCode_TRANSACTION_RESULT_ERROR_CODE_INVALID_WATT_LIMIT, txErr.Code)
+	require.Equal(t, uint64(2_000), result.Header.WattsUsed)
+
+	client.SetWattLimit(0)
+	_, err = SendTransaction(ctx, client, keyValueBody("watts", "a"), nil)
+	require.ErrorContains(t, err, "watt limit must be set")
+}
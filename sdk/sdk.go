@@ -4,7 +4,11 @@ import (
 	"context"
 	"crypto/ed25519"
 	"errors"
+	"fmt"
 
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/light"
+	"github.com/cometbft/cometbft/rpc/client"
 	"github.com/cometbft/cometbft/rpc/client/http"
 	"google.golang.org/protobuf/proto"
 
@@ -13,8 +17,36 @@ import (
 	"github.com/alecsavvy/mojave/utils"
 )
 
+// TransactionError is returned when a transaction is included in a block but fails to execute.
+type TransactionError struct {
+	Code v1.TransactionResultErrorCode
+	Log  string
+}
+
+func (e *TransactionError) Error() string {
+	return e.Log
+}
+
+// ErrNotFound is returned when a queried key does not exist.
+var ErrNotFound = errors.New("not found")
+
+// DefaultWattLimit is the watt limit set on transactions built by the SDK unless overridden with SetWattLimit.
+const DefaultWattLimit uint64 = 100_000
+
+// DefaultMaxQueryLag is how many blocks a verified query of the latest state may trail the
+// latest header the light client trusts, unless overridden with SetMaxQueryLag.
+const DefaultMaxQueryLag int64 = 10
+
 type MojaveSDK struct {
 	privateKey ed25519.PrivateKey
+	wattLimit  uint64
+	fee        uint64
+	chainID    string
+
+	rpcURL string
+	// lightClient verifies the headers query proofs are checked against, see EnableProofVerification.
+	lightClient *light.Client
+	maxQueryLag int64
 	*http.HTTP
 }
 
@@ -24,7 +56,10 @@ func NewMojaveSDK(rpcURL string) (*MojaveSDK, error) {
 		return nil, err
 	}
 	return &MojaveSDK{
-		HTTP: rpcClient,
+		HTTP:        rpcClient,
+		rpcURL:      rpcURL,
+		wattLimit:   DefaultWattLimit,
+		maxQueryLag: DefaultMaxQueryLag,
 	}, nil
 }
 
@@ -32,6 +67,22 @@ func (sdk *MojaveSDK) SetPrivateKey(privateKey ed25519.PrivateKey) {
 	sdk.privateKey = privateKey
 }
 
+// SetWattLimit sets the watt limit for transactions built by the SDK.
+func (sdk *MojaveSDK) SetWattLimit(wattLimit uint64) {
+	sdk.wattLimit = wattLimit
+}
+
+// SetFee sets the fee paid by transactions built by the SDK.
+func (sdk *MojaveSDK) SetFee(fee uint64) {
+	sdk.fee = fee
+}
+
+// SetMaxQueryLag sets how many blocks a verified query of the latest state may trail the latest
+// header the light client trusts.
+func (sdk *MojaveSDK) SetMaxQueryLag(blocks int64) {
+	sdk.maxQueryLag = blocks
+}
+
 func (sdk *MojaveSDK) GetPublicKey() ed25519.PublicKey {
 	return sdk.privateKey.Public().(ed25519.PublicKey)
 }
@@ -48,39 +99,84 @@ func (sdk *MojaveSDK) SignTransaction(transaction *v1.Transaction) (*v1.SignedTr
 	return signedTransaction, nil
 }
 
-func (sdk *MojaveSDK) SetKeyValue(ctx context.Context, key string, value string) (*v1.KeyValueResult, error) {
-	transaction := &v1.Transaction{
+// NewTransaction builds a transaction for the given body with the header filled in for this SDK's key,
+// including the chain ID, the next nonce of the signing account and the SDK's watt limit and fee.
+func (sdk *MojaveSDK) NewTransaction(ctx context.Context, body *v1.TransactionBody) (*v1.Transaction, error) {
+	if sdk.privateKey == nil {
+		return nil, errors.New("private key not set")
+	}
+
+	chainID, err := sdk.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := sdk.NextNonce(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.Transaction{
 		Header: &v1.TransactionHeader{
+			ChainId:    chainID,
 			FromPubkey: sdk.GetPublicKey(),
+			Nonce:      nonce,
+			WattLimit:  sdk.wattLimit,
+			Fee:        sdk.fee,
 		},
-		Body: &v1.TransactionBody{
-			Body: &v1.TransactionBody_KeyValue{
-				KeyValue: &v1.KeyValueTransaction{Key: key, Value: value},
-			},
-		},
+		Body: body,
+	}, nil
+}
+
+// ChainID returns the chain ID of the network the SDK is connected to. It is fetched
+// from the node's status on first use and cached afterwards.
+func (sdk *MojaveSDK) ChainID(ctx context.Context) (string, error) {
+	if sdk.chainID != "" {
+		return sdk.chainID, nil
 	}
 
-	signedTransaction, err := sdk.SignTransaction(transaction)
+	status, err := sdk.HTTP.Status(ctx)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
+	sdk.chainID = status.NodeInfo.Network
+	return sdk.chainID, nil
+}
 
-	result, err := sdk.sendTransaction(ctx, signedTransaction)
+// NextNonce fetches the signing account from the chain and returns the nonce its next transaction must carry.
+func (sdk *MojaveSDK) NextNonce(ctx context.Context) (uint64, error) {
+	account, err := sdk.GetAccount(ctx, sdk.GetPublicKey())
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
+	return account.Nonce + 1, nil
+}
 
+func (sdk *MojaveSDK) SetKeyValue(ctx context.Context, key string, value string) (*v1.KeyValueResult, error) {
+	result, err := sdk.sendBody(ctx, &v1.TransactionBody{
+		Body: &v1.TransactionBody_KeyValue{
+			KeyValue: &v1.KeyValueTransaction{Key: key, Value: value},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
 	return result.Body.GetKeyValue(), nil
 }
 
 func (sdk *MojaveSDK) GetKeyValue(ctx context.Context, key string) (*v1.KeyValueState, error) {
+	return sdk.GetKeyValueAt(ctx, key, 0)
+}
+
+// GetKeyValueAt reads a key value as it was committed at height, or the latest when height is 0.
+func (sdk *MojaveSDK) GetKeyValueAt(ctx context.Context, key string, height int64) (*v1.KeyValueState, error) {
 	query := &v1.Query{
 		Query: &v1.Query_KeyValue{
 			KeyValue: &v1.KeyValueQuery{Key: key},
 		},
 	}
 
-	response, err := sdk.sendQuery(ctx, query)
+	response, err := sdk.sendQuery(ctx, query, height)
 	if err != nil {
 		return nil, err
 	}
@@ -89,13 +185,18 @@ func (sdk *MojaveSDK) GetKeyValue(ctx context.Context, key string) (*v1.KeyValue
 }
 
 func (sdk *MojaveSDK) GetAccount(ctx context.Context, pubkey []byte) (*v1.AccountState, error) {
+	return sdk.GetAccountAt(ctx, pubkey, 0)
+}
+
+// GetAccountAt reads an account as it was committed at height, or the latest when height is 0.
+func (sdk *MojaveSDK) GetAccountAt(ctx context.Context, pubkey []byte, height int64) (*v1.AccountState, error) {
 	query := &v1.Query{
 		Query: &v1.Query_Account{
 			Account: &v1.AccountStateQuery{Pubkey: pubkey},
 		},
 	}
 
-	response, err := sdk.sendQuery(ctx, query)
+	response, err := sdk.sendQuery(ctx, query, height)
 	if err != nil {
 		return nil, err
 	}
@@ -104,20 +205,193 @@ func (sdk *MojaveSDK) GetAccount(ctx context.Context, pubkey []byte) (*v1.Accoun
 }
 
 func (sdk *MojaveSDK) TransferTokens(ctx context.Context, fromPubkey []byte, toPubkey []byte, amount uint64) (*v1.TokenTransferResult, error) {
-	transaction := &v1.Transaction{
-		Header: &v1.TransactionHeader{
-			FromPubkey: sdk.GetPublicKey(),
+	result, err := sdk.sendBody(ctx, &v1.TransactionBody{
+		Body: &v1.TransactionBody_TokenTransfer{
+			TokenTransfer: &v1.TokenTransferTransaction{
+				FromPubkey: fromPubkey,
+				ToPubkey:   toPubkey,
+				Amount:     amount,
+			},
 		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.Body.GetTokenTransfer(), nil
+}
 
-		Body: &v1.TransactionBody{
-			Body: &v1.TransactionBody_TokenTransfer{
-				TokenTransfer: &v1.TokenTransferTransaction{
-					FromPubkey: fromPubkey,
-					ToPubkey:   toPubkey,
-					Amount:     amount,
-				},
-			},
+// RegisterContent registers a content item by the sha256 hash of its bytes, so validators
+// start attesting to whether they can serve it.
+func (sdk *MojaveSDK) RegisterContent(ctx context.Context, contentHash []byte) (*v1.ContentRegistrationResult, error) {
+	result, err := sdk.sendBody(ctx, &v1.TransactionBody{
+		Body: &v1.TransactionBody_ContentRegistration{
+			ContentRegistration: &v1.ContentRegistrationTransaction{ContentHash: contentHash},
 		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.Body.GetContentRegistration(), nil
+}
+
+func (sdk *MojaveSDK) GetContent(ctx context.Context, contentHash []byte) (*v1.ContentState, error) {
+	return sdk.GetContentAt(ctx, contentHash, 0)
+}
+
+// GetContentAt reads a registered content item and its availability as committed at height, or
+// the latest when height is 0.
+func (sdk *MojaveSDK) GetContentAt(ctx context.Context, contentHash []byte, height int64) (*v1.ContentState, error) {
+	query := &v1.Query{
+		Query: &v1.Query_Content{
+			Content: &v1.ContentQuery{ContentHash: contentHash},
+		},
+	}
+
+	response, err := sdk.sendQuery(ctx, query, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.GetContent(), nil
+}
+
+// AddValidator admits a validator to the set, which it joins once enough tokens are bonded to it.
+// The SDK's key must be the validator admin set at genesis.
+func (sdk *MojaveSDK) AddValidator(ctx context.Context, pubkey []byte) (*v1.ValidatorUpdateResult, error) {
+	result, err := sdk.sendBody(ctx, &v1.TransactionBody{
+		Body: &v1.TransactionBody_AddValidator{
+			AddValidator: &v1.AddValidatorTransaction{Pubkey: pubkey},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.Body.GetValidatorUpdate(), nil
+}
+
+// RemoveValidator removes a validator from the set. The SDK's key must be the validator admin.
+func (sdk *MojaveSDK) RemoveValidator(ctx context.Context, pubkey []byte) (*v1.ValidatorUpdateResult, error) {
+	result, err := sdk.sendBody(ctx, &v1.TransactionBody{
+		Body: &v1.TransactionBody_RemoveValidator{
+			RemoveValidator: &v1.RemoveValidatorTransaction{Pubkey: pubkey},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.Body.GetValidatorUpdate(), nil
+}
+
+// Bond bonds tokens to the validator whose consensus key is the SDK's key.
+func (sdk *MojaveSDK) Bond(ctx context.Context, amount uint64) (*v1.DelegationResult, error) {
+	result, err := sdk.sendBody(ctx, &v1.TransactionBody{
+		Body: &v1.TransactionBody_Bond{
+			Bond: &v1.BondTransaction{Amount: amount},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.Body.GetDelegation(), nil
+}
+
+func (sdk *MojaveSDK) Delegate(ctx context.Context, validatorPubkey []byte, amount uint64) (*v1.DelegationResult, error) {
+	result, err := sdk.sendBody(ctx, &v1.TransactionBody{
+		Body: &v1.TransactionBody_Delegate{
+			Delegate: &v1.DelegateTransaction{ValidatorPubkey: validatorPubkey, Amount: amount},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.Body.GetDelegation(), nil
+}
+
+// Unbond starts unbonding tokens from a validator. They return to the SDK key's balance at the
+// completion height of the result.
+func (sdk *MojaveSDK) Unbond(ctx context.Context, validatorPubkey []byte, amount uint64) (*v1.UnbondResult, error) {
+	result, err := sdk.sendBody(ctx, &v1.TransactionBody{
+		Body: &v1.TransactionBody_Unbond{
+			Unbond: &v1.UnbondTransaction{ValidatorPubkey: validatorPubkey, Amount: amount},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.Body.GetUnbond(), nil
+}
+
+// Unjail returns the validator whose consensus key is the SDK's key to the set once its jail
+// period is over.
+func (sdk *MojaveSDK) Unjail(ctx context.Context) (*v1.UnjailResult, error) {
+	result, err := sdk.sendBody(ctx, &v1.TransactionBody{
+		Body: &v1.TransactionBody_Unjail{
+			Unjail: &v1.UnjailTransaction{},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.Body.GetUnjail(), nil
+}
+
+// UpdateParams changes the params set in update, signed by the SDK's key, which must be the
+// params admin's.
+func (sdk *MojaveSDK) UpdateParams(ctx context.Context, update *v1.UpdateParamsTransaction) (*v1.UpdateParamsResult, error) {
+	result, err := sdk.sendBody(ctx, &v1.TransactionBody{
+		Body: &v1.TransactionBody_UpdateParams{
+			UpdateParams: update,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.Body.GetUpdateParams(), nil
+}
+
+// SubmitProposal submits a governance proposal with the SDK key's first deposit on it.
+func (sdk *MojaveSDK) SubmitProposal(ctx context.Context, title string, content *v1.ProposalContent, initialDeposit uint64) (*v1.SubmitProposalResult, error) {
+	result, err := sdk.sendBody(ctx, &v1.TransactionBody{
+		Body: &v1.TransactionBody_SubmitProposal{
+			SubmitProposal: &v1.SubmitProposalTransaction{Title: title, Content: content, InitialDeposit: initialDeposit},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.Body.GetSubmitProposal(), nil
+}
+
+func (sdk *MojaveSDK) Deposit(ctx context.Context, proposalID uint64, amount uint64) (*v1.DepositResult, error) {
+	result, err := sdk.sendBody(ctx, &v1.TransactionBody{
+		Body: &v1.TransactionBody_Deposit{
+			Deposit: &v1.DepositTransaction{ProposalId: proposalID, Amount: amount},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.Body.GetDeposit(), nil
+}
+
+// Vote casts the SDK key's vote on a proposal, replacing any it cast before.
+func (sdk *MojaveSDK) Vote(ctx context.Context, proposalID uint64, option v1.VoteOption) (*v1.VoteResult, error) {
+	result, err := sdk.sendBody(ctx, &v1.TransactionBody{
+		Body: &v1.TransactionBody_Vote{
+			Vote: &v1.VoteTransaction{ProposalId: proposalID, Option: option},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.Body.GetVote(), nil
+}
+
+// sendBody signs a transaction with body and sends it.
+func (sdk *MojaveSDK) sendBody(ctx context.Context, body *v1.TransactionBody) (*v1.TransactionResult, error) {
+	transaction, err := sdk.NewTransaction(ctx, body)
+	if err != nil {
+		return nil, err
 	}
 
 	signedTransaction, err := sdk.SignTransaction(transaction)
@@ -125,20 +399,281 @@ func (sdk *MojaveSDK) TransferTokens(ctx context.Context, fromPubkey []byte, toP
 		return nil, err
 	}
 
-	result, err := sdk.sendTransaction(ctx, signedTransaction)
+	return sdk.SendTransaction(ctx, signedTransaction)
+}
+
+func (sdk *MojaveSDK) GetValidators(ctx context.Context) (*v1.ValidatorSet, error) {
+	return sdk.GetValidatorsAt(ctx, 0)
+}
+
+// GetValidatorsAt reads the validator set as committed at height, or the latest when height is 0.
+// Changes committed at a height take effect in consensus two blocks later.
+func (sdk *MojaveSDK) GetValidatorsAt(ctx context.Context, height int64) (*v1.ValidatorSet, error) {
+	query := &v1.Query{
+		Query: &v1.Query_Validators{
+			Validators: &v1.ValidatorSetQuery{},
+		},
+	}
+
+	response, err := sdk.sendQuery(ctx, query, height)
 	if err != nil {
 		return nil, err
 	}
 
-	return result.Body.GetTokenTransfer(), nil
+	return response.GetValidators(), nil
 }
 
+func (sdk *MojaveSDK) GetDelegations(ctx context.Context, delegatorPubkey []byte) (*v1.DelegatorState, error) {
+	return sdk.GetDelegationsAt(ctx, delegatorPubkey, 0)
+}
+
+// GetDelegationsAt reads a delegator's delegations as committed at height, or the latest when
+// height is 0.
+func (sdk *MojaveSDK) GetDelegationsAt(ctx context.Context, delegatorPubkey []byte, height int64) (*v1.DelegatorState, error) {
+	query := &v1.Query{
+		Query: &v1.Query_Delegations{
+			Delegations: &v1.DelegationsQuery{DelegatorPubkey: delegatorPubkey},
+		},
+	}
+
+	response, err := sdk.sendQuery(ctx, query, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.GetDelegations(), nil
+}
+
+func (sdk *MojaveSDK) GetUnbonding(ctx context.Context, delegatorPubkey []byte) (*v1.UnbondingQueue, error) {
+	return sdk.GetUnbondingAt(ctx, delegatorPubkey, 0)
+}
+
+// GetUnbondingAt reads the tokens a delegator is unbonding as committed at height, or the latest
+// when height is 0.
+func (sdk *MojaveSDK) GetUnbondingAt(ctx context.Context, delegatorPubkey []byte, height int64) (*v1.UnbondingQueue, error) {
+	query := &v1.Query{
+		Query: &v1.Query_Unbonding{
+			Unbonding: &v1.UnbondingQuery{DelegatorPubkey: delegatorPubkey},
+		},
+	}
+
+	response, err := sdk.sendQuery(ctx, query, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.GetUnbonding(), nil
+}
+
+func (sdk *MojaveSDK) GetStakingParams(ctx context.Context) (*v1.StakingParams, error) {
+	query := &v1.Query{
+		Query: &v1.Query_StakingParams{
+			StakingParams: &v1.StakingParamsQuery{},
+		},
+	}
+
+	response, err := sdk.sendQuery(ctx, query, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.GetStakingParams(), nil
+}
+
+// GetMissedBlocks reads the heights within the signed blocks window at which a validator did not
+// sign the commit.
+func (sdk *MojaveSDK) GetMissedBlocks(ctx context.Context, validatorAddress []byte) (*v1.MissedBlocks, error) {
+	query := &v1.Query{
+		Query: &v1.Query_MissedBlocks{
+			MissedBlocks: &v1.MissedBlocksQuery{ValidatorAddress: validatorAddress},
+		},
+	}
+
+	response, err := sdk.sendQuery(ctx, query, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.GetMissedBlocks(), nil
+}
+
+func (sdk *MojaveSDK) GetSlashingParams(ctx context.Context) (*v1.SlashingParams, error) {
+	query := &v1.Query{
+		Query: &v1.Query_SlashingParams{
+			SlashingParams: &v1.SlashingParamsQuery{},
+		},
+	}
+
+	response, err := sdk.sendQuery(ctx, query, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.GetSlashingParams(), nil
+}
+
+// GetSupply reads the total supply along with the tokens minted and burned since genesis.
+func (sdk *MojaveSDK) GetSupply(ctx context.Context) (*v1.Supply, error) {
+	return sdk.GetSupplyAt(ctx, 0)
+}
+
+// GetSupplyAt reads the supply as committed at height, or the latest when height is 0.
+func (sdk *MojaveSDK) GetSupplyAt(ctx context.Context, height int64) (*v1.Supply, error) {
+	query := &v1.Query{
+		Query: &v1.Query_Supply{
+			Supply: &v1.SupplyQuery{},
+		},
+	}
+
+	response, err := sdk.sendQuery(ctx, query, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.GetSupply(), nil
+}
+
+// GetParams reads the params transactions are checked against.
+func (sdk *MojaveSDK) GetParams(ctx context.Context) (*v1.Params, error) {
+	query := &v1.Query{
+		Query: &v1.Query_Params{
+			Params: &v1.ParamsQuery{},
+		},
+	}
+
+	response, err := sdk.sendQuery(ctx, query, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.GetParams(), nil
+}
+
+func (sdk *MojaveSDK) GetMintParams(ctx context.Context) (*v1.MintParams, error) {
+	query := &v1.Query{
+		Query: &v1.Query_MintParams{
+			MintParams: &v1.MintParamsQuery{},
+		},
+	}
+
+	response, err := sdk.sendQuery(ctx, query, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.GetMintParams(), nil
+}
+
+// GetProposal reads a governance proposal, including its tally once voting has ended.
+func (sdk *MojaveSDK) GetProposal(ctx context.Context, proposalID uint64) (*v1.Proposal, error) {
+	query := &v1.Query{
+		Query: &v1.Query_Proposal{
+			Proposal: &v1.ProposalQuery{ProposalId: proposalID},
+		},
+	}
+
+	response, err := sdk.sendQuery(ctx, query, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.GetProposal(), nil
+}
+
+// GetProposalDeposit reads what a depositor has deposited on a proposal, empty if nothing.
+func (sdk *MojaveSDK) GetProposalDeposit(ctx context.Context, proposalID uint64, depositorPubkey []byte) (*v1.Deposit, error) {
+	query := &v1.Query{
+		Query: &v1.Query_ProposalDeposit{
+			ProposalDeposit: &v1.ProposalDepositQuery{ProposalId: proposalID, DepositorPubkey: depositorPubkey},
+		},
+	}
+
+	response, err := sdk.sendQuery(ctx, query, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.GetProposalDeposit(), nil
+}
+
+// GetProposalVote reads a voter's vote on a proposal, failing with ErrNotFound if it did not vote.
+func (sdk *MojaveSDK) GetProposalVote(ctx context.Context, proposalID uint64, voterPubkey []byte) (*v1.Vote, error) {
+	query := &v1.Query{
+		Query: &v1.Query_ProposalVote{
+			ProposalVote: &v1.ProposalVoteQuery{ProposalId: proposalID, VoterPubkey: voterPubkey},
+		},
+	}
+
+	response, err := sdk.sendQuery(ctx, query, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.GetProposalVote(), nil
+}
+
+func (sdk *MojaveSDK) GetCommunityPool(ctx context.Context) (*v1.CommunityPool, error) {
+	return sdk.GetCommunityPoolAt(ctx, 0)
+}
+
+// GetCommunityPoolAt reads the community pool as committed at height, or the latest when height is 0.
+func (sdk *MojaveSDK) GetCommunityPoolAt(ctx context.Context, height int64) (*v1.CommunityPool, error) {
+	query := &v1.Query{
+		Query: &v1.Query_CommunityPool{
+			CommunityPool: &v1.CommunityPoolQuery{},
+		},
+	}
+
+	response, err := sdk.sendQuery(ctx, query, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.GetCommunityPool(), nil
+}
+
+func (sdk *MojaveSDK) GetGovParams(ctx context.Context) (*v1.GovParams, error) {
+	query := &v1.Query{
+		Query: &v1.Query_GovParams{
+			GovParams: &v1.GovParamsQuery{},
+		},
+	}
+
+	response, err := sdk.sendQuery(ctx, query, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.GetGovParams(), nil
+}
+
+// GetUpgradePlan reads the software upgrade governance has scheduled, or returns ErrNotFound when
+// there is none.
+func (sdk *MojaveSDK) GetUpgradePlan(ctx context.Context) (*v1.UpgradePlan, error) {
+	query := &v1.Query{
+		Query: &v1.Query_UpgradePlan{
+			UpgradePlan: &v1.UpgradePlanQuery{},
+		},
+	}
+
+	response, err := sdk.sendQuery(ctx, query, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.GetUpgradePlan(), nil
+}
+
+// FaucetTokens transfers tokens from the dev faucet, signing with the well-known faucet key.
 func (sdk *MojaveSDK) FaucetTokens(ctx context.Context, toPubkey []byte, amount uint64) error {
-	_, err := sdk.TransferTokens(ctx, utils.ZeroAddress, toPubkey, amount)
+	faucet := *sdk
+	faucet.privateKey = utils.FaucetPrivateKey
+	_, err := faucet.TransferTokens(ctx, utils.FaucetPubkey, toPubkey, amount)
 	return err
 }
 
-func (sdk *MojaveSDK) sendTransaction(ctx context.Context, transaction *v1.SignedTransaction) (*v1.TransactionResult, error) {
+// SendTransaction broadcasts a signed transaction and waits for it to be committed.
+func (sdk *MojaveSDK) SendTransaction(ctx context.Context, transaction *v1.SignedTransaction) (*v1.TransactionResult, error) {
 	txBytes, err := proto.Marshal(transaction)
 	if err != nil {
 		return nil, err
@@ -159,25 +694,46 @@ func (sdk *MojaveSDK) sendTransaction(ctx context.Context, transaction *v1.Signe
 	resultCode := response.TxResult.Code
 
 	if resultCode != 0 {
-		return result, errors.New(result.Error.Log)
+		return result, &TransactionError{
+			Code: result.Error.Code,
+			Log:  result.Error.Log,
+		}
 	}
 
 	return result, nil
 }
 
-func (sdk *MojaveSDK) sendQuery(ctx context.Context, query *v1.Query) (*v1.QueryResponse, error) {
+func (sdk *MojaveSDK) sendQuery(ctx context.Context, query *v1.Query, height int64) (*v1.QueryResponse, error) {
 	queryBytes, err := proto.Marshal(query)
 	if err != nil {
 		return nil, err
 	}
-	response, err := sdk.HTTP.ABCIQuery(ctx, "", queryBytes)
+	response, err := sdk.HTTP.ABCIQueryWithOptions(ctx, "", queryBytes, client.ABCIQueryOptions{
+		Height: height,
+		Prove:  sdk.lightClient != nil,
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	if sdk.lightClient != nil {
+		return sdk.verifyQueryResponse(ctx, query, height, &response.Response)
+	}
+
+	if response.Response.Code != 0 {
+		return nil, queryError(&response.Response)
+	}
+
 	queryResponse := &v1.QueryResponse{}
 	if err := proto.Unmarshal(response.Response.Value, queryResponse); err != nil {
 		return nil, err
 	}
 	return queryResponse, nil
 }
+
+func queryError(response *abcitypes.QueryResponse) error {
+	if response.Code == uint32(v1.QueryErrorCode_QUERY_ERROR_CODE_NOT_FOUND) {
+		return fmt.Errorf("%w: %s", ErrNotFound, response.Log)
+	}
+	return errors.New(response.Log)
+}
@@ -0,0 +1,146 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/light"
+	lightdb "github.com/cometbft/cometbft/light/store/db"
+	"google.golang.org/protobuf/proto"
+
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/alecsavvy/mojave/store"
+)
+
+// EnableProofVerification makes the SDK request a Merkle proof with every state query and check it
+// against the app hash of a header verified by a light client, so query results can be trusted even
+// when the RPC node is not. The light client is anchored at the trusted header in trustOptions and
+// cross-checks headers against the RPC nodes in witnesses, of which it needs at least one.
+func (sdk *MojaveSDK) EnableProofVerification(ctx context.Context, trustOptions light.TrustOptions, witnesses []string) error {
+	chainID, err := sdk.ChainID(ctx)
+	if err != nil {
+		return err
+	}
+
+	lightClient, err := light.NewHTTPClient(ctx, chainID, trustOptions, sdk.rpcURL, witnesses, lightdb.New(dbm.NewMemDB(), ""))
+	if err != nil {
+		return err
+	}
+	sdk.lightClient = lightClient
+	return nil
+}
+
+// verifyQueryResponse checks the proof in a query response and builds the result from the
+// proven value rather than the value reported by the node. height is the height the query asked
+// for, or 0 for the latest state.
+func (sdk *MojaveSDK) verifyQueryResponse(ctx context.Context, query *v1.Query, height int64, response *abcitypes.QueryResponse) (*v1.QueryResponse, error) {
+	if response.Code != 0 && response.Code != uint32(v1.QueryErrorCode_QUERY_ERROR_CODE_NOT_FOUND) {
+		return nil, queryError(response)
+	}
+	if err := sdk.checkQueryHeight(ctx, height, response.Height); err != nil {
+		return nil, err
+	}
+
+	key, err := store.QueryKey(query)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := proofFromResponse(response, key)
+	if err != nil {
+		return nil, err
+	}
+
+	appHash, err := sdk.trustedAppHash(ctx, response.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := store.VerifyProof(appHash, proof)
+	if err != nil {
+		return nil, fmt.Errorf("invalid query proof at height %d: %w", response.Height, err)
+	}
+
+	queryResponse, err := store.DecodeQueryResponse(query, proof.Value, exists)
+	var notFound *store.QueryNotFoundError
+	if errors.As(err, &notFound) {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, notFound.What)
+	}
+	return queryResponse, err
+}
+
+// proofFromResponse extracts the state proof for key from a query response.
+func proofFromResponse(response *abcitypes.QueryResponse, key []byte) (*v1.StateProof, error) {
+	if response.ProofOps == nil || len(response.ProofOps.Ops) != 1 {
+		return nil, errors.New("query response has no proof")
+	}
+
+	op := response.ProofOps.Ops[0]
+	if op.Type != store.ProofOpType {
+		return nil, fmt.Errorf("unexpected proof type %q", op.Type)
+	}
+
+	proof := &v1.StateProof{}
+	if err := proto.Unmarshal(op.Data, proof); err != nil {
+		return nil, err
+	}
+	if string(proof.Key) != string(key) {
+		return nil, fmt.Errorf("proof is for key %q, not %q", proof.Key, key)
+	}
+	return proof, nil
+}
+
+// checkQueryHeight rejects answers for state other than the state asked for. Old state proves
+// against its own app hash just as well, so without this a node could serve any past value.
+func (sdk *MojaveSDK) checkQueryHeight(ctx context.Context, requested, answered int64) error {
+	if requested != 0 {
+		if answered != requested {
+			return fmt.Errorf("query for height %d was answered at height %d", requested, answered)
+		}
+		return nil
+	}
+
+	if _, err := sdk.lightClient.Update(ctx, time.Now()); err != nil {
+		return err
+	}
+	trusted, err := sdk.lightClient.LastTrustedHeight()
+	if err != nil {
+		return err
+	}
+	if answered < trusted-sdk.maxQueryLag {
+		return fmt.Errorf("query for the latest state was answered at height %d, more than %d blocks behind trusted height %d",
+			answered, sdk.maxQueryLag, trusted)
+	}
+	return nil
+}
+
+// trustedAppHash returns the app hash of the state committed at height from a light client
+// verified header. That app hash is carried by the header of the next block, so this waits
+// for the next block if it has not been produced yet.
+func (sdk *MojaveSDK) trustedAppHash(ctx context.Context, height int64) ([]byte, error) {
+	next := height + 1
+	for {
+		status, err := sdk.HTTP.Status(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if status.SyncInfo.LatestBlockHeight >= next {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+
+	lightBlock, err := sdk.lightClient.VerifyLightBlockAtHeight(ctx, next, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return lightBlock.AppHash, nil
+}
@@ -13,6 +13,17 @@ export default defineConfig({
 					label: 'Overview',
 					items: [
 						{ label: 'Introduction', slug: 'overview/introduction' },
+						{ label: 'Genesis', slug: 'overview/genesis' },
+						{ label: 'Params', slug: 'overview/params' },
+						{ label: 'Transaction Signing', slug: 'overview/transaction-signing' },
+						{ label: 'Content Availability', slug: 'overview/content-availability' },
+						{ label: 'Validators', slug: 'overview/validators' },
+						{ label: 'Staking', slug: 'overview/staking' },
+						{ label: 'Slashing', slug: 'overview/slashing' },
+						{ label: 'Issuance', slug: 'overview/issuance' },
+						{ label: 'Governance', slug: 'overview/governance' },
+						{ label: 'Upgrades', slug: 'overview/upgrades' },
+						{ label: 'Events', slug: 'overview/events' },
 					],
 				},
 			],
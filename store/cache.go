@@ -0,0 +1,156 @@
+package store
+
+import (
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/cockroachdb/pebble"
+)
+
+type cacheEntry struct {
+	value   []byte
+	deleted bool
+}
+
+type nopCloser struct{}
+
+func (nopCloser) Close() error { return nil }
+
+// Cache is a write cache layered over a parent Reader. Reads see the cache's own writes
+// first and fall through to the parent, so a block's cache sees every earlier transaction
+// of the block and a transaction's child cache sees its own writes. Nothing reaches the
+// parent until Write is called, which lets a failed transaction be dropped as a whole.
+type Cache struct {
+	parent Reader
+	writes map[string]cacheEntry
+}
+
+var _ ReadWriter = (*Cache)(nil)
+
+func NewCache(parent Reader) *Cache {
+	return &Cache{
+		parent: parent,
+		writes: make(map[string]cacheEntry),
+	}
+}
+
+// Child returns a new cache layered over this one.
+func (c *Cache) Child() *Cache {
+	return NewCache(c)
+}
+
+func (c *Cache) Get(key []byte) ([]byte, io.Closer, error) {
+	if entry, ok := c.writes[string(key)]; ok {
+		if entry.deleted {
+			return nil, nil, pebble.ErrNotFound
+		}
+		return entry.value, nopCloser{}, nil
+	}
+	return c.parent.Get(key)
+}
+
+func (c *Cache) Set(key, value []byte, _ *pebble.WriteOptions) error {
+	c.writes[string(key)] = cacheEntry{value: slices.Clone(value)}
+	return nil
+}
+
+func (c *Cache) Delete(key []byte, _ *pebble.WriteOptions) error {
+	c.writes[string(key)] = cacheEntry{deleted: true}
+	return nil
+}
+
+// each calls fn for every cached write in key order.
+func (c *Cache) each(fn func(key string, entry cacheEntry) error) error {
+	keys := make([]string, 0, len(c.writes))
+	for key := range c.writes {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		if err := fn(key, c.writes[key]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Write flushes the cached writes into w in key order and empties the cache.
+func (c *Cache) Write(w Writer) error {
+	err := c.each(func(key string, entry cacheEntry) error {
+		if entry.deleted {
+			return w.Delete([]byte(key), nil)
+		}
+		return w.Set([]byte(key), entry.value, nil)
+	})
+	if err != nil {
+		return err
+	}
+
+	c.Discard()
+	return nil
+}
+
+// Discard drops every cached write.
+func (c *Cache) Discard() {
+	clear(c.writes)
+}
+
+// eachInRange calls fn in key order for every key in [lower, upper) that r holds. r is the
+// committed database or a cache over it, whose pending writes are seen. The range is read in
+// full before fn is called, so fn may write through r's cache.
+func eachInRange(r Reader, lower, upper []byte, fn func(key, value []byte) error) error {
+	entries, err := readRange(r, lower, upper)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		if err := fn([]byte(key), entries[key]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readRange(r Reader, lower, upper []byte) (map[string][]byte, error) {
+	switch r := r.(type) {
+	case *Cache:
+		entries, err := readRange(r.parent, lower, upper)
+		if err != nil {
+			return nil, err
+		}
+		for key, entry := range r.writes {
+			if key < string(lower) || key >= string(upper) {
+				continue
+			}
+			if entry.deleted {
+				delete(entries, key)
+			} else {
+				entries[key] = entry.value
+			}
+		}
+		return entries, nil
+	case *pebble.DB:
+		iter, err := r.NewIter(&pebble.IterOptions{LowerBound: lower, UpperBound: upper})
+		if err != nil {
+			return nil, err
+		}
+		defer iter.Close()
+
+		entries := make(map[string][]byte)
+		for iter.First(); iter.Valid(); iter.Next() {
+			entries[string(iter.Key())] = slices.Clone(iter.Value())
+		}
+		return entries, iter.Error()
+	default:
+		return nil, fmt.Errorf("cannot iterate over %T", r)
+	}
+}
@@ -0,0 +1,98 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/vfs"
+	"github.com/stretchr/testify/require"
+)
+
+func openTestDB(t *testing.T) *pebble.DB {
+	db, err := pebble.Open("", &pebble.Options{FS: vfs.NewMem()})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	return db
+}
+
+func requireValue(t *testing.T, r Reader, key string, expected string) {
+	t.Helper()
+	value, closer, err := r.Get([]byte(key))
+	require.NoError(t, err)
+	defer closer.Close()
+	require.Equal(t, expected, string(value))
+}
+
+func requireNotFound(t *testing.T, r Reader, key string) {
+	t.Helper()
+	_, _, err := r.Get([]byte(key))
+	require.ErrorIs(t, err, pebble.ErrNotFound)
+}
+
+func TestCacheLayers(t *testing.T) {
+	db := openTestDB(t)
+	require.NoError(t, db.Set([]byte("a"), []byte("committed"), nil))
+	require.NoError(t, db.Set([]byte("b"), []byte("committed"), nil))
+
+	block := NewCache(db)
+	require.NoError(t, block.Set([]byte("a"), []byte("block"), nil))
+	requireValue(t, block, "a", "block")
+	requireValue(t, block, "b", "committed")
+	requireValue(t, db, "a", "committed")
+
+	// a child sees the block's writes, and its own writes stay local until written
+	tx := block.Child()
+	requireValue(t, tx, "a", "block")
+	require.NoError(t, tx.Set([]byte("a"), []byte("tx"), nil))
+	require.NoError(t, tx.Delete([]byte("b"), nil))
+	requireValue(t, tx, "a", "tx")
+	requireNotFound(t, tx, "b")
+	requireValue(t, block, "a", "block")
+	requireValue(t, block, "b", "committed")
+
+	// a discarded child leaves no trace
+	tx.Discard()
+	requireValue(t, tx, "a", "block")
+
+	tx = block.Child()
+	require.NoError(t, tx.Set([]byte("c"), []byte("tx"), nil))
+	require.NoError(t, tx.Delete([]byte("b"), nil))
+	require.NoError(t, tx.Write(block))
+	requireValue(t, block, "c", "tx")
+	requireNotFound(t, block, "b")
+
+	batch := db.NewBatch()
+	require.NoError(t, block.Write(batch))
+	require.NoError(t, batch.Commit(nil))
+	requireValue(t, db, "a", "block")
+	requireValue(t, db, "c", "tx")
+	requireNotFound(t, db, "b")
+}
+
+func TestEachInRange(t *testing.T) {
+	db := openTestDB(t)
+	for _, key := range []string{"a", "p:1", "p:2", "p:3", "q"} {
+		require.NoError(t, db.Set([]byte(key), []byte("committed"), nil))
+	}
+
+	block := NewCache(db)
+	require.NoError(t, block.Set([]byte("p:2"), []byte("block"), nil))
+	require.NoError(t, block.Delete([]byte("p:3"), nil))
+	tx := block.Child()
+	require.NoError(t, tx.Set([]byte("p:0"), []byte("tx"), nil))
+	require.NoError(t, tx.Delete([]byte("p:1"), nil))
+
+	collect := func(r Reader) []string {
+		var entries []string
+		require.NoError(t, eachInRange(r, []byte("p:"), prefixEnd([]byte("p:")), func(key, value []byte) error {
+			entries = append(entries, string(key)+"="+string(value))
+			return nil
+		}))
+		return entries
+	}
+	require.Equal(t, []string{"p:1=committed", "p:2=committed", "p:3=committed"}, collect(db))
+	require.Equal(t, []string{"p:1=committed", "p:2=block"}, collect(block))
+	require.Equal(t, []string{"p:0=tx", "p:2=block"}, collect(tx))
+}
@@ -0,0 +1,76 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/cockroachdb/pebble"
+	"google.golang.org/protobuf/proto"
+)
+
+var contentPrefix = []byte("content:")
+
+// ContentKey is the store key of a registered content item.
+func ContentKey(contentHash []byte) []byte {
+	return fmt.Appendf(nil, "content:%x", contentHash)
+}
+
+func (s *Store) GetContent(ctx context.Context, r Reader, contentHash []byte) (*v1.ContentState, error) {
+	value, closer, err := r.Get(ContentKey(contentHash))
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	content := &v1.ContentState{}
+	if err := proto.Unmarshal(value, content); err != nil {
+		return nil, err
+	}
+	return content, nil
+}
+
+func (s *Store) SetContent(ctx context.Context, w Writer, content *v1.ContentState) error {
+	value, err := proto.Marshal(content)
+	if err != nil {
+		return err
+	}
+	return w.Set(ContentKey(content.ContentHash), value, nil)
+}
+
+// EachContentFrom calls fn for up to limit content items in committed state that were registered
+// before registeredBefore, in key order from the first item whose hash is at or after
+// contentHash, wrapping around to the first item after the last. Items registered later are
+// skipped without counting toward limit.
+func (s *Store) EachContentFrom(ctx context.Context, contentHash []byte, registeredBefore uint64, limit int, fn func(content *v1.ContentState) error) error {
+	start := ContentKey(contentHash)
+	visited := 0
+	for _, bounds := range []pebble.IterOptions{
+		{LowerBound: start, UpperBound: prefixEnd(contentPrefix)},
+		{LowerBound: contentPrefix, UpperBound: start},
+	} {
+		iter, err := s.DB.NewIter(&bounds)
+		if err != nil {
+			return err
+		}
+		for iter.First(); iter.Valid() && visited < limit; iter.Next() {
+			content := &v1.ContentState{}
+			if err := proto.Unmarshal(iter.Value(), content); err != nil {
+				iter.Close()
+				return err
+			}
+			if content.RegisteredHeight >= registeredBefore {
+				continue
+			}
+			if err := fn(content); err != nil {
+				iter.Close()
+				return err
+			}
+			visited++
+		}
+		if err := iter.Close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/cockroachdb/pebble"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	govParamsKey     = []byte("params:gov")
+	proposalCountKey = []byte("proposal_count")
+	communityPoolKey = []byte("community_pool")
+)
+
+// GovParamsKey is the store key of the governance params.
+func GovParamsKey() []byte {
+	return bytes.Clone(govParamsKey)
+}
+
+// CommunityPoolKey is the store key of the community pool.
+func CommunityPoolKey() []byte {
+	return bytes.Clone(communityPoolKey)
+}
+
+// ProposalKey is the store key of a proposal.
+func ProposalKey(id uint64) []byte {
+	return fmt.Appendf(nil, "proposal:%016x", id)
+}
+
+// ProposalDepositKey is the store key of what a depositor has deposited on a proposal.
+func ProposalDepositKey(id uint64, depositor []byte) []byte {
+	return fmt.Appendf(nil, "proposal_deposits:%016x:%x", id, depositor)
+}
+
+// ProposalVoteKey is the store key of a voter's vote on a proposal.
+func ProposalVoteKey(id uint64, voter []byte) []byte {
+	return fmt.Appendf(nil, "proposal_votes:%016x:%x", id, voter)
+}
+
+func proposalQueueKey(height uint64) []byte {
+	return fmt.Appendf(nil, "proposal_queue:%016x", height)
+}
+
+// GetGovParams returns the governance params set at genesis or since, or pebble.ErrNotFound
+// before InitChain.
+func (s *Store) GetGovParams(ctx context.Context, r Reader) (*v1.GovParams, error) {
+	params := &v1.GovParams{}
+	found, err := getMessage(r, govParamsKey, params)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, pebble.ErrNotFound
+	}
+	return params, nil
+}
+
+func (s *Store) SetGovParams(ctx context.Context, w Writer, params *v1.GovParams) error {
+	return setMessage(w, govParamsKey, params, false)
+}
+
+// NextProposalID returns the id of the next proposal and counts it. Ids start at 1.
+func (s *Store) NextProposalID(ctx context.Context, rw ReadWriter) (uint64, error) {
+	var count uint64
+	value, closer, err := rw.Get(proposalCountKey)
+	switch {
+	case err == nil:
+		count = binary.BigEndian.Uint64(value)
+		closer.Close()
+	case !errors.Is(err, pebble.ErrNotFound):
+		return 0, err
+	}
+
+	count++
+	if err := rw.Set(proposalCountKey, binary.BigEndian.AppendUint64(nil, count), nil); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// GetProposal returns a proposal, or pebble.ErrNotFound if it was never submitted.
+func (s *Store) GetProposal(ctx context.Context, r Reader, id uint64) (*v1.Proposal, error) {
+	proposal := &v1.Proposal{}
+	found, err := getMessage(r, ProposalKey(id), proposal)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, pebble.ErrNotFound
+	}
+	return proposal, nil
+}
+
+func (s *Store) SetProposal(ctx context.Context, w Writer, proposal *v1.Proposal) error {
+	return setMessage(w, ProposalKey(proposal.Id), proposal, false)
+}
+
+// GetProposalDeposit returns what a depositor has deposited on a proposal, empty if nothing.
+func (s *Store) GetProposalDeposit(ctx context.Context, r Reader, id uint64, depositor []byte) (*v1.Deposit, error) {
+	deposit := &v1.Deposit{ProposalId: id, DepositorPubkey: depositor}
+	if _, err := getMessage(r, ProposalDepositKey(id, depositor), deposit); err != nil {
+		return nil, err
+	}
+	return deposit, nil
+}
+
+func (s *Store) SetProposalDeposit(ctx context.Context, w Writer, deposit *v1.Deposit) error {
+	return setMessage(w, ProposalDepositKey(deposit.ProposalId, deposit.DepositorPubkey), deposit, deposit.Amount == 0)
+}
+
+// EachProposalDeposit calls fn for every deposit on a proposal, ordered by depositor pubkey.
+func (s *Store) EachProposalDeposit(ctx context.Context, r Reader, id uint64, fn func(deposit *v1.Deposit) error) error {
+	prefix := fmt.Appendf(nil, "proposal_deposits:%016x:", id)
+	return eachInRange(r, prefix, prefixEnd(prefix), func(_, value []byte) error {
+		deposit := &v1.Deposit{}
+		if err := proto.Unmarshal(value, deposit); err != nil {
+			return err
+		}
+		return fn(deposit)
+	})
+}
+
+// GetProposalVote returns a voter's vote on a proposal, or pebble.ErrNotFound if it did not vote.
+func (s *Store) GetProposalVote(ctx context.Context, r Reader, id uint64, voter []byte) (*v1.Vote, error) {
+	vote := &v1.Vote{}
+	found, err := getMessage(r, ProposalVoteKey(id, voter), vote)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, pebble.ErrNotFound
+	}
+	return vote, nil
+}
+
+func (s *Store) SetProposalVote(ctx context.Context, w Writer, vote *v1.Vote) error {
+	return setMessage(w, ProposalVoteKey(vote.ProposalId, vote.VoterPubkey), vote, false)
+}
+
+// EachProposalVote calls fn for every vote on a proposal, ordered by voter pubkey.
+func (s *Store) EachProposalVote(ctx context.Context, r Reader, id uint64, fn func(vote *v1.Vote) error) error {
+	prefix := fmt.Appendf(nil, "proposal_votes:%016x:", id)
+	return eachInRange(r, prefix, prefixEnd(prefix), func(_, value []byte) error {
+		vote := &v1.Vote{}
+		if err := proto.Unmarshal(value, vote); err != nil {
+			return err
+		}
+		return fn(vote)
+	})
+}
+
+// GetProposalQueue returns the proposals whose deposit or voting period ends at height.
+func (s *Store) GetProposalQueue(ctx context.Context, r Reader, height uint64) (*v1.ProposalQueue, error) {
+	queue := &v1.ProposalQueue{}
+	if _, err := getMessage(r, proposalQueueKey(height), queue); err != nil {
+		return nil, err
+	}
+	return queue, nil
+}
+
+// SetProposalQueue writes the proposals whose deposit or voting period ends at height, removing
+// the record once there are none.
+func (s *Store) SetProposalQueue(ctx context.Context, w Writer, height uint64, queue *v1.ProposalQueue) error {
+	return setMessage(w, proposalQueueKey(height), queue, len(queue.ProposalIds) == 0)
+}
+
+// GetCommunityPool returns the community pool, empty before anything is paid into it.
+func (s *Store) GetCommunityPool(ctx context.Context, r Reader) (*v1.CommunityPool, error) {
+	pool := &v1.CommunityPool{}
+	if _, err := getMessage(r, communityPoolKey, pool); err != nil {
+		return nil, err
+	}
+	return pool, nil
+}
+
+func (s *Store) SetCommunityPool(ctx context.Context, w Writer, pool *v1.CommunityPool) error {
+	return setMessage(w, communityPoolKey, pool, false)
+}
@@ -0,0 +1,196 @@
+package store
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/cockroachdb/pebble"
+)
+
+// Every write to state is also kept as a version of the key at the height it was written,
+// so state can be read as of any retained height:
+//
+//	h: || uvarint(len(key)) || key || height -> 0x01 || value, or 0x00 if the key was deleted
+//
+// The length prefix keeps the versions of one key contiguous and apart from every other key.
+// A version is needed until the height it is superseded at, which is indexed for pruning:
+//
+//	hp: || superseded height || version key -> nil
+//
+// A deletion is superseded at its own height, as reading the key at a later height finds
+// nothing whether or not the deletion is still there.
+
+var (
+	historyPrefix      = []byte("h:")
+	historyPrunePrefix = []byte("hp:")
+	earliestHeightKey  = []byte("meta:earliest_height")
+)
+
+const (
+	versionDeleted byte = 0x00
+	versionSet     byte = 0x01
+)
+
+func historyKeyPrefix(key []byte) []byte {
+	prefix := binary.AppendUvarint(bytes.Clone(historyPrefix), uint64(len(key)))
+	return append(prefix, key...)
+}
+
+func historyKey(key []byte, height int64) []byte {
+	return binary.BigEndian.AppendUint64(historyKeyPrefix(key), uint64(height))
+}
+
+func historyPruneKey(height int64, versionKey []byte) []byte {
+	pruneKey := binary.BigEndian.AppendUint64(bytes.Clone(historyPrunePrefix), uint64(height))
+	return append(pruneKey, versionKey...)
+}
+
+// versionWriter records the versions of the keys written at a height.
+type versionWriter struct {
+	batch  *pebble.Batch
+	height int64
+	iter   *pebble.Iterator
+}
+
+func (s *Store) newVersionWriter(batch *pebble.Batch, height int64) (*versionWriter, error) {
+	iter, err := s.DB.NewIter(nil)
+	if err != nil {
+		return nil, err
+	}
+	return &versionWriter{batch: batch, height: height, iter: iter}, nil
+}
+
+func (w *versionWriter) write(key []byte, entry cacheEntry) error {
+	versionKey := historyKey(key, w.height)
+	value := []byte{versionSet}
+	if entry.deleted {
+		value = []byte{versionDeleted}
+	} else {
+		value = append(value, entry.value...)
+	}
+	if err := w.batch.Set(versionKey, value, nil); err != nil {
+		return err
+	}
+
+	// the previous version is superseded by this one
+	prefix := historyKeyPrefix(key)
+	if w.iter.SeekLT(versionKey) && bytes.HasPrefix(w.iter.Key(), prefix) && len(w.iter.Key()) == len(versionKey) {
+		if err := w.batch.Set(historyPruneKey(w.height, w.iter.Key()), nil, nil); err != nil {
+			return err
+		}
+	}
+	if err := w.iter.Error(); err != nil {
+		return err
+	}
+
+	if entry.deleted {
+		return w.batch.Set(historyPruneKey(w.height, versionKey), nil, nil)
+	}
+	return nil
+}
+
+func (w *versionWriter) close() error {
+	return w.iter.Close()
+}
+
+// historyReader reads state as it was committed at a height.
+type historyReader struct {
+	db     *pebble.DB
+	height int64
+}
+
+// At returns a reader of the state committed at height. Reads of a pruned height find nothing,
+// callers check the height against EarliestHeight first.
+func (s *Store) At(height int64) Reader {
+	return &historyReader{db: s.DB, height: height}
+}
+
+func (r *historyReader) Get(key []byte) ([]byte, io.Closer, error) {
+	iter, err := r.db.NewIter(&pebble.IterOptions{
+		LowerBound: historyKeyPrefix(key),
+		UpperBound: historyKey(key, r.height+1),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	if !iter.Last() {
+		if err := iter.Error(); err != nil {
+			return nil, nil, err
+		}
+		return nil, nil, pebble.ErrNotFound
+	}
+
+	version := iter.Value()
+	if len(version) == 0 || version[0] == versionDeleted {
+		return nil, nil, pebble.ErrNotFound
+	}
+	return bytes.Clone(version[1:]), nopCloser{}, nil
+}
+
+// Prune deletes the versions, tree nodes and state roots that are only needed to read heights
+// below earliest, and records earliest as the first readable height. It must be written to the
+// batch before the state of the next height, which may bring back a tree node pruned here.
+func (s *Store) Prune(batch *pebble.Batch, earliest int64) error {
+	upper := binary.BigEndian.AppendUint64(nil, uint64(earliest)+1)
+
+	if err := s.pruneIndex(batch, historyPrunePrefix, upper, func(rest []byte) error {
+		return batch.Delete(rest, nil)
+	}); err != nil {
+		return err
+	}
+
+	if err := s.pruneIndex(batch, smtOrphanPrefix, upper, func(hash []byte) error {
+		if err := batch.Delete(smtNodeKey(hash), nil); err != nil {
+			return err
+		}
+		return batch.Delete(smtOrphanedKey(hash), nil)
+	}); err != nil {
+		return err
+	}
+
+	if err := batch.DeleteRange(smtRootAtKey(0), smtRootAtKey(earliest), nil); err != nil {
+		return err
+	}
+	return batch.Set(earliestHeightKey, binary.BigEndian.AppendUint64(nil, uint64(earliest)), nil)
+}
+
+// pruneIndex visits and deletes every entry of a height index with a height below upper,
+// passing fn what follows the height in the entry's key.
+func (s *Store) pruneIndex(batch *pebble.Batch, prefix []byte, upper []byte, fn func(rest []byte) error) error {
+	iter, err := s.DB.NewIter(&pebble.IterOptions{
+		LowerBound: prefix,
+		UpperBound: append(bytes.Clone(prefix), upper...),
+	})
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for iter.First(); iter.Valid(); iter.Next() {
+		rest := bytes.Clone(iter.Key()[len(prefix)+8:])
+		if err := fn(rest); err != nil {
+			return err
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return err
+	}
+	return batch.DeleteRange(prefix, append(bytes.Clone(prefix), upper...), nil)
+}
+
+// EarliestHeight returns the first height whose state can still be read.
+func (s *Store) EarliestHeight() (int64, error) {
+	value, closer, err := s.DB.Get(earliestHeightKey)
+	if errors.Is(err, pebble.ErrNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer closer.Close()
+	return int64(binary.BigEndian.Uint64(value)), nil
+}
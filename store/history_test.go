@@ -0,0 +1,81 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func prune(t *testing.T, s *Store, earliest int64) {
+	t.Helper()
+	batch := s.NewBatch()
+	require.NoError(t, s.Prune(batch, earliest))
+	require.NoError(t, batch.Commit(nil))
+}
+
+func TestReadAtHeight(t *testing.T) {
+	s := &Store{openTestDB(t)}
+
+	v1, v2, v3 := "v1", "v2", "v3"
+	other := "other"
+	commitState(t, s, 1, map[string]*string{"key": &v1})
+	commitState(t, s, 2, map[string]*string{"key-other": &other})
+	commitState(t, s, 3, map[string]*string{"key": &v2})
+	commitState(t, s, 5, map[string]*string{"key": nil})
+	commitState(t, s, 7, map[string]*string{"key": &v3})
+
+	expected := map[int64]string{1: v1, 2: v1, 3: v2, 4: v2, 7: v3, 8: v3}
+	check := func(from int64) {
+		t.Helper()
+		for height := from; height <= 8; height++ {
+			if value, ok := expected[height]; ok {
+				requireValue(t, s.At(height), "key", value)
+			} else {
+				requireNotFound(t, s.At(height), "key")
+			}
+		}
+	}
+	check(0)
+
+	// pruning keeps every version still visible from the earliest height on
+	prune(t, s, 4)
+	check(4)
+	requireValue(t, s.At(4), "key-other", other)
+
+	earliest, err := s.EarliestHeight()
+	require.NoError(t, err)
+	require.Equal(t, int64(4), earliest)
+
+	_, err = s.StateRootAt(3)
+	require.Error(t, err)
+}
+
+func TestProveAtHeight(t *testing.T) {
+	s := &Store{openTestDB(t)}
+
+	x, y := "x", "y"
+	root1 := commitState(t, s, 1, map[string]*string{"a": &x, "b": &x})
+	commitState(t, s, 2, map[string]*string{"a": &y})
+	// the leaf for a=x drops out of the tree at 2 and comes back at 3
+	root3 := commitState(t, s, 3, map[string]*string{"a": &x})
+	commitState(t, s, 4, map[string]*string{"b": &y})
+
+	proof, err := s.Prove([]byte("b"), 1)
+	require.NoError(t, err)
+	require.Equal(t, x, string(proof.Value))
+	exists, err := VerifyProof(root1, proof)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	prune(t, s, 3)
+
+	proof, err = s.Prove([]byte("a"), 3)
+	require.NoError(t, err)
+	require.Equal(t, x, string(proof.Value))
+	exists, err = VerifyProof(root3, proof)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	_, err = s.Prove([]byte("a"), 1)
+	require.Error(t, err)
+}
@@ -5,29 +5,29 @@ import (
 	"fmt"
 
 	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
-	"github.com/cockroachdb/pebble"
 	"google.golang.org/protobuf/proto"
 )
 
-func keyValueKey(key string) []byte {
+// KeyValueKey is the store key of a key value entry.
+func KeyValueKey(key string) []byte {
 	return fmt.Appendf(nil, "kv:%s", key)
 }
 
-func (s *Store) SetKeyValue(ctx context.Context, batch *pebble.Batch, tx *v1.KeyValueState) error {
-	key := keyValueKey(tx.Key)
+func (s *Store) SetKeyValue(ctx context.Context, w Writer, tx *v1.KeyValueState) error {
+	key := KeyValueKey(tx.Key)
 
 	value, err := proto.Marshal(tx)
 	if err != nil {
 		return err
 	}
 
-	return batch.Set(key, value, nil)
+	return w.Set(key, value, nil)
 }
 
-func (s *Store) GetKeyValue(ctx context.Context, k string) (*v1.KeyValueState, error) {
-	key := keyValueKey(k)
+func (s *Store) GetKeyValue(ctx context.Context, r Reader, k string) (*v1.KeyValueState, error) {
+	key := KeyValueKey(k)
 
-	value, closer, err := s.DB.Get(key)
+	value, closer, err := r.Get(key)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,52 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+)
+
+var (
+	chainIDKey   = []byte("meta:chain_id")
+	lastBlockKey = []byte("meta:last_block")
+)
+
+// SetChainID records the chain ID from genesis in w.
+func (s *Store) SetChainID(ctx context.Context, w Writer, chainID string) error {
+	return w.Set(chainIDKey, []byte(chainID), nil)
+}
+
+// GetChainID returns the committed chain ID, or pebble.ErrNotFound before InitChain has run.
+func (s *Store) GetChainID(ctx context.Context) (string, error) {
+	value, closer, err := s.DB.Get(chainIDKey)
+	if err != nil {
+		return "", err
+	}
+	defer closer.Close()
+
+	return string(value), nil
+}
+
+// SetLastBlock records the height and app hash of a block in w. It must be written in the
+// same batch as the block's state so the two are committed atomically.
+func (s *Store) SetLastBlock(ctx context.Context, w Writer, height int64, appHash []byte) error {
+	value := binary.BigEndian.AppendUint64(nil, uint64(height))
+	value = append(value, appHash...)
+	return w.Set(lastBlockKey, value, nil)
+}
+
+// GetLastBlock returns the height and app hash of the last committed block, or pebble.ErrNotFound
+// before InitChain has run. InitChain is recorded as height 0 with the genesis app hash.
+func (s *Store) GetLastBlock(ctx context.Context) (int64, []byte, error) {
+	value, closer, err := s.DB.Get(lastBlockKey)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer closer.Close()
+
+	if len(value) < 8 {
+		return 0, nil, errors.New("invalid last block record")
+	}
+	return int64(binary.BigEndian.Uint64(value)), bytes.Clone(value[8:]), nil
+}
@@ -0,0 +1,72 @@
+package store
+
+import (
+	"bytes"
+	"context"
+
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/cockroachdb/pebble"
+)
+
+var (
+	mintParamsKey = []byte("params:mint")
+	mintStateKey  = []byte("mint")
+	supplyKey     = []byte("supply")
+)
+
+// MintParamsKey is the store key of the mint params.
+func MintParamsKey() []byte {
+	return bytes.Clone(mintParamsKey)
+}
+
+// SupplyKey is the store key of the token supply.
+func SupplyKey() []byte {
+	return bytes.Clone(supplyKey)
+}
+
+// GetMintParams returns the mint params set at genesis, or pebble.ErrNotFound before InitChain.
+func (s *Store) GetMintParams(ctx context.Context, r Reader) (*v1.MintParams, error) {
+	params := &v1.MintParams{}
+	found, err := getMessage(r, mintParamsKey, params)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, pebble.ErrNotFound
+	}
+	return params, nil
+}
+
+func (s *Store) SetMintParams(ctx context.Context, w Writer, params *v1.MintParams) error {
+	return setMessage(w, mintParamsKey, params, false)
+}
+
+// GetMintState returns where the issuance schedule stands, or pebble.ErrNotFound before InitChain.
+func (s *Store) GetMintState(ctx context.Context, r Reader) (*v1.MintState, error) {
+	state := &v1.MintState{}
+	found, err := getMessage(r, mintStateKey, state)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, pebble.ErrNotFound
+	}
+	return state, nil
+}
+
+func (s *Store) SetMintState(ctx context.Context, w Writer, state *v1.MintState) error {
+	return setMessage(w, mintStateKey, state, false)
+}
+
+// GetSupply returns the token supply, empty before InitChain.
+func (s *Store) GetSupply(ctx context.Context, r Reader) (*v1.Supply, error) {
+	supply := &v1.Supply{}
+	if _, err := getMessage(r, supplyKey, supply); err != nil {
+		return nil, err
+	}
+	return supply, nil
+}
+
+func (s *Store) SetSupply(ctx context.Context, w Writer, supply *v1.Supply) error {
+	return setMessage(w, supplyKey, supply, false)
+}
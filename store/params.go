@@ -0,0 +1,78 @@
+package store
+
+import (
+	"bytes"
+	"context"
+
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/cockroachdb/pebble"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+)
+
+var (
+	paramsKey          = []byte("params:app")
+	paramsAdminKey     = []byte("params_admin")
+	consensusParamsKey = []byte("params:consensus")
+)
+
+// ParamsKey is the store key of the params.
+func ParamsKey() []byte {
+	return bytes.Clone(paramsKey)
+}
+
+// GetParams returns the params set at genesis or since, or pebble.ErrNotFound before InitChain.
+func (s *Store) GetParams(ctx context.Context, r Reader) (*v1.Params, error) {
+	params := &v1.Params{}
+	found, err := getMessage(r, paramsKey, params)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, pebble.ErrNotFound
+	}
+	return params, nil
+}
+
+func (s *Store) SetParams(ctx context.Context, w Writer, params *v1.Params) error {
+	return setMessage(w, paramsKey, params, false)
+}
+
+func (s *Store) SetParamsAdmin(ctx context.Context, w Writer, pubkey []byte) error {
+	return w.Set(paramsAdminKey, pubkey, nil)
+}
+
+// GetParamsAdmin returns the key allowed to change params, or pebble.ErrNotFound if genesis did
+// not set one.
+func (s *Store) GetParamsAdmin(ctx context.Context, r Reader) ([]byte, error) {
+	value, closer, err := r.Get(paramsAdminKey)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	return bytes.Clone(value), nil
+}
+
+// GetConsensusParams returns the CometBFT consensus params the chain runs with, or
+// pebble.ErrNotFound before InitChain.
+func (s *Store) GetConsensusParams(ctx context.Context, r Reader) (*cmtproto.ConsensusParams, error) {
+	value, closer, err := r.Get(consensusParamsKey)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	params := &cmtproto.ConsensusParams{}
+	if err := params.Unmarshal(value); err != nil {
+		return nil, err
+	}
+	return params, nil
+}
+
+func (s *Store) SetConsensusParams(ctx context.Context, w Writer, params *cmtproto.ConsensusParams) error {
+	value, err := params.Marshal()
+	if err != nil {
+		return err
+	}
+	return w.Set(consensusParamsKey, value, nil)
+}
@@ -0,0 +1,107 @@
+package store
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+)
+
+// ProofOpType is the type of the ABCI proof op carrying a StateProof.
+const ProofOpType = "mojave:smt"
+
+// Prove builds a proof of the value of key committed at height, or of its absence, against
+// the state root at that height. The height must not have been pruned.
+func (s *Store) Prove(key []byte, height int64) (*v1.StateProof, error) {
+	root, err := s.StateRootAt(height)
+	if err != nil {
+		return nil, err
+	}
+
+	path := sha256.Sum256(key)
+	proof := &v1.StateProof{Key: key}
+	for depth, hash := 0, root; !isEmpty(hash); depth++ {
+		node, err := getSMTNode(s.DB, hash)
+		if err != nil {
+			return nil, err
+		}
+
+		if node.leaf {
+			proof.LeafPath = node.path
+			proof.LeafValueHash = node.valueHash
+			break
+		}
+
+		if smtBit(path[:], depth) == 0 {
+			proof.Siblings = append(proof.Siblings, node.right)
+			hash = node.left
+		} else {
+			proof.Siblings = append(proof.Siblings, node.left)
+			hash = node.right
+		}
+	}
+
+	if bytes.Equal(proof.LeafPath, path[:]) {
+		value, closer, err := s.At(height).Get(key)
+		if err != nil {
+			return nil, err
+		}
+		defer closer.Close()
+		proof.Value = bytes.Clone(value)
+	}
+
+	return proof, nil
+}
+
+// VerifyProof checks a proof against a trusted state root and reports whether it proves
+// that the key exists, with proof.Value as its value, or that it is absent.
+func VerifyProof(root []byte, proof *v1.StateProof) (bool, error) {
+	if len(proof.Siblings) > 8*sha256.Size {
+		return false, errors.New("proof is deeper than the tree")
+	}
+
+	path := sha256.Sum256(proof.Key)
+	exists := bytes.Equal(proof.LeafPath, path[:])
+	if !exists && len(proof.Value) != 0 {
+		return false, errors.New("proof of absence carries a value")
+	}
+
+	hash := EmptyRoot
+	if len(proof.LeafPath) != 0 {
+		if len(proof.LeafPath) != sha256.Size || len(proof.LeafValueHash) != sha256.Size {
+			return false, errors.New("invalid proof leaf")
+		}
+		if exists {
+			valueHash := sha256.Sum256(proof.Value)
+			if !bytes.Equal(valueHash[:], proof.LeafValueHash) {
+				return false, errors.New("value does not match proof leaf")
+			}
+		}
+		// a different leaf only proves absence if it sits where the key's path would end
+		for depth := range proof.Siblings {
+			if smtBit(proof.LeafPath, depth) != smtBit(path[:], depth) {
+				return false, errors.New("proof leaf is not on the key's path")
+			}
+		}
+		hash = smtLeafHash(proof.LeafPath, proof.LeafValueHash)
+	}
+
+	for depth := len(proof.Siblings) - 1; depth >= 0; depth-- {
+		sibling := proof.Siblings[depth]
+		if len(sibling) != sha256.Size {
+			return false, errors.New("invalid proof sibling")
+		}
+		if smtBit(path[:], depth) == 0 {
+			hash = smtInnerHash(hash, sibling)
+		} else {
+			hash = smtInnerHash(sibling, hash)
+		}
+	}
+
+	if !bytes.Equal(hash, root) {
+		return false, fmt.Errorf("proof root %X does not match state root %X", hash, root)
+	}
+	return exists, nil
+}
@@ -0,0 +1,79 @@
+package store
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProofs(t *testing.T) {
+	s := &Store{openTestDB(t)}
+
+	changes := map[string]*string{}
+	for i := range 50 {
+		value := fmt.Sprintf("value-%d", i)
+		changes[fmt.Sprintf("key-%d", i)] = &value
+	}
+	root := commitState(t, s, 1, changes)
+
+	for i := range 50 {
+		proof, err := s.Prove(fmt.Appendf(nil, "key-%d", i), 1)
+		require.NoError(t, err)
+		require.Equal(t, fmt.Sprintf("value-%d", i), string(proof.Value))
+
+		exists, err := VerifyProof(root, proof)
+		require.NoError(t, err)
+		require.True(t, exists)
+	}
+
+	for i := 50; i < 100; i++ {
+		proof, err := s.Prove(fmt.Appendf(nil, "key-%d", i), 1)
+		require.NoError(t, err)
+		require.Empty(t, proof.Value)
+
+		exists, err := VerifyProof(root, proof)
+		require.NoError(t, err)
+		require.False(t, exists)
+	}
+}
+
+func TestProofOfEmptyState(t *testing.T) {
+	s := &Store{openTestDB(t)}
+	commitState(t, s, 0, nil)
+
+	proof, err := s.Prove([]byte("missing"), 0)
+	require.NoError(t, err)
+
+	exists, err := VerifyProof(EmptyRoot, proof)
+	require.NoError(t, err)
+	require.False(t, exists)
+}
+
+func TestForgedProofsAreRejected(t *testing.T) {
+	s := &Store{openTestDB(t)}
+
+	a, b := "a", "b"
+	root := commitState(t, s, 1, map[string]*string{"key-a": &a, "key-b": &b, "key-c": &b})
+
+	proof, err := s.Prove([]byte("key-a"), 1)
+	require.NoError(t, err)
+
+	proof.Value = []byte("forged")
+	_, err = VerifyProof(root, proof)
+	require.Error(t, err)
+
+	// a proof of one key does not prove the absence of another
+	proof, err = s.Prove([]byte("key-a"), 1)
+	require.NoError(t, err)
+	proof.Key = []byte("key-b")
+	proof.Value = nil
+	_, err = VerifyProof(root, proof)
+	require.Error(t, err)
+
+	// nor is it valid against another root
+	proof, err = s.Prove([]byte("key-a"), 1)
+	require.NoError(t, err)
+	_, err = VerifyProof(EmptyRoot, proof)
+	require.Error(t, err)
+}
@@ -0,0 +1,225 @@
+package store
+
+import (
+	"fmt"
+	"reflect"
+
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// Every query reads a single key, so the app can prove its answer and the SDK can verify it
+// against the key it expects. The app and the SDK both answer queries from the table below,
+// which maps each query type to the key it reads and to how the value there becomes the
+// response.
+
+// QueryNotFoundError is returned for queries of records that must exist but do not.
+type QueryNotFoundError struct {
+	// What describes what the query looked for.
+	What string
+}
+
+func (e *QueryNotFoundError) Error() string {
+	return e.What + " not found"
+}
+
+type queryKind struct {
+	key    func(query *v1.Query) []byte
+	decode func(query *v1.Query, value []byte, exists bool) (*v1.QueryResponse, error)
+}
+
+// required decodes a record that must exist, describing what the query looked for when it does not.
+func required[M proto.Message](describe func(query *v1.Query) string, respond func(M) *v1.QueryResponse) func(*v1.Query, []byte, bool) (*v1.QueryResponse, error) {
+	return func(query *v1.Query, value []byte, exists bool) (*v1.QueryResponse, error) {
+		if !exists {
+			return nil, &QueryNotFoundError{What: describe(query)}
+		}
+		var zero M
+		record := zero.ProtoReflect().New().Interface().(M)
+		if err := proto.Unmarshal(value, record); err != nil {
+			return nil, err
+		}
+		return respond(record), nil
+	}
+}
+
+// optional decodes a record that is answered as empty when it does not exist.
+func optional[M proto.Message](empty func(query *v1.Query) M, respond func(M) *v1.QueryResponse) func(*v1.Query, []byte, bool) (*v1.QueryResponse, error) {
+	return func(query *v1.Query, value []byte, exists bool) (*v1.QueryResponse, error) {
+		record := empty(query)
+		if exists {
+			if err := proto.Unmarshal(value, record); err != nil {
+				return nil, err
+			}
+		}
+		return respond(record), nil
+	}
+}
+
+var queryKinds = map[reflect.Type]queryKind{
+	reflect.TypeFor[*v1.Query_KeyValue](): {
+		key: func(query *v1.Query) []byte { return KeyValueKey(query.GetKeyValue().Key) },
+		decode: required(func(query *v1.Query) string { return fmt.Sprintf("key %q", query.GetKeyValue().Key) },
+			func(kv *v1.KeyValueState) *v1.QueryResponse {
+				return &v1.QueryResponse{Response: &v1.QueryResponse_KeyValue{KeyValue: kv}}
+			}),
+	},
+	reflect.TypeFor[*v1.Query_Account](): {
+		key: func(query *v1.Query) []byte { return AccountKey(query.GetAccount().Pubkey) },
+		// accounts that have never been written are empty
+		decode: optional(func(query *v1.Query) *v1.AccountState { return &v1.AccountState{Pubkey: query.GetAccount().Pubkey} },
+			func(account *v1.AccountState) *v1.QueryResponse {
+				return &v1.QueryResponse{Response: &v1.QueryResponse_Account{Account: account}}
+			}),
+	},
+	reflect.TypeFor[*v1.Query_Content](): {
+		key: func(query *v1.Query) []byte { return ContentKey(query.GetContent().ContentHash) },
+		decode: required(func(query *v1.Query) string { return fmt.Sprintf("content %x", query.GetContent().ContentHash) },
+			func(content *v1.ContentState) *v1.QueryResponse {
+				return &v1.QueryResponse{Response: &v1.QueryResponse_Content{Content: content}}
+			}),
+	},
+	reflect.TypeFor[*v1.Query_Validators](): {
+		key: func(query *v1.Query) []byte { return ValidatorSetKey() },
+		decode: optional(func(query *v1.Query) *v1.ValidatorSet { return &v1.ValidatorSet{} },
+			func(validators *v1.ValidatorSet) *v1.QueryResponse {
+				return &v1.QueryResponse{Response: &v1.QueryResponse_Validators{Validators: validators}}
+			}),
+	},
+	reflect.TypeFor[*v1.Query_Delegations](): {
+		key: func(query *v1.Query) []byte { return DelegationsKey(query.GetDelegations().DelegatorPubkey) },
+		decode: optional(func(query *v1.Query) *v1.DelegatorState {
+			return &v1.DelegatorState{DelegatorPubkey: query.GetDelegations().DelegatorPubkey}
+		}, func(delegations *v1.DelegatorState) *v1.QueryResponse {
+			return &v1.QueryResponse{Response: &v1.QueryResponse_Delegations{Delegations: delegations}}
+		}),
+	},
+	reflect.TypeFor[*v1.Query_Unbonding](): {
+		key: func(query *v1.Query) []byte { return UnbondingKey(query.GetUnbonding().DelegatorPubkey) },
+		decode: optional(func(query *v1.Query) *v1.UnbondingQueue {
+			return &v1.UnbondingQueue{DelegatorPubkey: query.GetUnbonding().DelegatorPubkey}
+		}, func(unbonding *v1.UnbondingQueue) *v1.QueryResponse {
+			return &v1.QueryResponse{Response: &v1.QueryResponse_Unbonding{Unbonding: unbonding}}
+		}),
+	},
+	reflect.TypeFor[*v1.Query_StakingParams](): {
+		key: func(query *v1.Query) []byte { return StakingParamsKey() },
+		decode: required(func(query *v1.Query) string { return "staking params" },
+			func(params *v1.StakingParams) *v1.QueryResponse {
+				return &v1.QueryResponse{Response: &v1.QueryResponse_StakingParams{StakingParams: params}}
+			}),
+	},
+	reflect.TypeFor[*v1.Query_MissedBlocks](): {
+		key: func(query *v1.Query) []byte { return MissedBlocksKey(query.GetMissedBlocks().ValidatorAddress) },
+		decode: optional(func(query *v1.Query) *v1.MissedBlocks {
+			return &v1.MissedBlocks{ValidatorAddress: query.GetMissedBlocks().ValidatorAddress}
+		}, func(missed *v1.MissedBlocks) *v1.QueryResponse {
+			return &v1.QueryResponse{Response: &v1.QueryResponse_MissedBlocks{MissedBlocks: missed}}
+		}),
+	},
+	reflect.TypeFor[*v1.Query_SlashingParams](): {
+		key: func(query *v1.Query) []byte { return SlashingParamsKey() },
+		decode: required(func(query *v1.Query) string { return "slashing params" },
+			func(params *v1.SlashingParams) *v1.QueryResponse {
+				return &v1.QueryResponse{Response: &v1.QueryResponse_SlashingParams{SlashingParams: params}}
+			}),
+	},
+	reflect.TypeFor[*v1.Query_Supply](): {
+		key: func(query *v1.Query) []byte { return SupplyKey() },
+		decode: optional(func(query *v1.Query) *v1.Supply { return &v1.Supply{} },
+			func(supply *v1.Supply) *v1.QueryResponse {
+				return &v1.QueryResponse{Response: &v1.QueryResponse_Supply{Supply: supply}}
+			}),
+	},
+	reflect.TypeFor[*v1.Query_MintParams](): {
+		key: func(query *v1.Query) []byte { return MintParamsKey() },
+		decode: required(func(query *v1.Query) string { return "mint params" },
+			func(params *v1.MintParams) *v1.QueryResponse {
+				return &v1.QueryResponse{Response: &v1.QueryResponse_MintParams{MintParams: params}}
+			}),
+	},
+	reflect.TypeFor[*v1.Query_Params](): {
+		key: func(query *v1.Query) []byte { return ParamsKey() },
+		decode: required(func(query *v1.Query) string { return "params" },
+			func(params *v1.Params) *v1.QueryResponse {
+				return &v1.QueryResponse{Response: &v1.QueryResponse_Params{Params: params}}
+			}),
+	},
+	reflect.TypeFor[*v1.Query_Proposal](): {
+		key: func(query *v1.Query) []byte { return ProposalKey(query.GetProposal().ProposalId) },
+		decode: required(func(query *v1.Query) string { return fmt.Sprintf("proposal %d", query.GetProposal().ProposalId) },
+			func(proposal *v1.Proposal) *v1.QueryResponse {
+				return &v1.QueryResponse{Response: &v1.QueryResponse_Proposal{Proposal: proposal}}
+			}),
+	},
+	reflect.TypeFor[*v1.Query_CommunityPool](): {
+		key: func(query *v1.Query) []byte { return CommunityPoolKey() },
+		decode: optional(func(query *v1.Query) *v1.CommunityPool { return &v1.CommunityPool{} },
+			func(pool *v1.CommunityPool) *v1.QueryResponse {
+				return &v1.QueryResponse{Response: &v1.QueryResponse_CommunityPool{CommunityPool: pool}}
+			}),
+	},
+	reflect.TypeFor[*v1.Query_GovParams](): {
+		key: func(query *v1.Query) []byte { return GovParamsKey() },
+		decode: required(func(query *v1.Query) string { return "gov params" },
+			func(params *v1.GovParams) *v1.QueryResponse {
+				return &v1.QueryResponse{Response: &v1.QueryResponse_GovParams{GovParams: params}}
+			}),
+	},
+	reflect.TypeFor[*v1.Query_UpgradePlan](): {
+		key: func(query *v1.Query) []byte { return UpgradePlanKey() },
+		decode: required(func(query *v1.Query) string { return "upgrade plan" },
+			func(plan *v1.UpgradePlan) *v1.QueryResponse {
+				return &v1.QueryResponse{Response: &v1.QueryResponse_UpgradePlan{UpgradePlan: plan}}
+			}),
+	},
+	reflect.TypeFor[*v1.Query_ProposalDeposit](): {
+		key: func(query *v1.Query) []byte {
+			return ProposalDepositKey(query.GetProposalDeposit().ProposalId, query.GetProposalDeposit().DepositorPubkey)
+		},
+		// depositors that have deposited nothing have an empty deposit
+		decode: optional(func(query *v1.Query) *v1.Deposit {
+			return &v1.Deposit{ProposalId: query.GetProposalDeposit().ProposalId, DepositorPubkey: query.GetProposalDeposit().DepositorPubkey}
+		}, func(deposit *v1.Deposit) *v1.QueryResponse {
+			return &v1.QueryResponse{Response: &v1.QueryResponse_ProposalDeposit{ProposalDeposit: deposit}}
+		}),
+	},
+	reflect.TypeFor[*v1.Query_ProposalVote](): {
+		key: func(query *v1.Query) []byte {
+			return ProposalVoteKey(query.GetProposalVote().ProposalId, query.GetProposalVote().VoterPubkey)
+		},
+		decode: required(func(query *v1.Query) string {
+			return fmt.Sprintf("vote of %x on proposal %d", query.GetProposalVote().VoterPubkey, query.GetProposalVote().ProposalId)
+		}, func(vote *v1.Vote) *v1.QueryResponse {
+			return &v1.QueryResponse{Response: &v1.QueryResponse_ProposalVote{ProposalVote: vote}}
+		}),
+	},
+}
+
+func lookupQuery(query *v1.Query) (queryKind, error) {
+	kind, ok := queryKinds[reflect.TypeOf(query.Query)]
+	if !ok {
+		return queryKind{}, fmt.Errorf("unknown query type: %T", query.Query)
+	}
+	return kind, nil
+}
+
+// QueryKey returns the key a query reads, which its answer is proven against.
+func QueryKey(query *v1.Query) ([]byte, error) {
+	kind, err := lookupQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	return kind.key(query), nil
+}
+
+// DecodeQueryResponse builds the response to a query from the value at its key, which exists
+// unless exists is false. Queries of records that must exist fail with a QueryNotFoundError when
+// it does not.
+func DecodeQueryResponse(query *v1.Query, value []byte, exists bool) (*v1.QueryResponse, error) {
+	kind, err := lookupQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	return kind.decode(query, value, exists)
+}
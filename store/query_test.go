@@ -0,0 +1,28 @@
+package store
+
+import (
+	"testing"
+
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestEveryQueryHasAKey(t *testing.T) {
+	fields := (&v1.Query{}).ProtoReflect().Descriptor().Oneofs().ByName("query").Fields()
+	for i := range fields.Len() {
+		field := fields.Get(i)
+		query := &v1.Query{}
+		m := query.ProtoReflect()
+		m.Set(field, protoreflect.ValueOfMessage(m.NewField(field).Message()))
+
+		key, err := QueryKey(query)
+		require.NoError(t, err, field.Name())
+		require.NotEmpty(t, key, field.Name())
+
+		// the response to a query is of the same name
+		response, err := DecodeQueryResponse(query, nil, true)
+		require.NoError(t, err, field.Name())
+		require.Equal(t, field.Name(), response.ProtoReflect().WhichOneof(response.ProtoReflect().Descriptor().Oneofs().ByName("response")).Name())
+	}
+}
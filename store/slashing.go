@@ -0,0 +1,68 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/cockroachdb/pebble"
+)
+
+var slashingParamsKey = []byte("params:slashing")
+
+// SlashingParamsKey is the store key of the slashing params.
+func SlashingParamsKey() []byte {
+	return bytes.Clone(slashingParamsKey)
+}
+
+// MissedBlocksKey is the store key of the blocks a validator missed within the signed blocks window.
+func MissedBlocksKey(address []byte) []byte {
+	return fmt.Appendf(nil, "missed_blocks:%x", address)
+}
+
+func punishedEvidenceKey(address []byte, height int64) []byte {
+	return fmt.Appendf(nil, "punished_evidence:%x:%016x", address, height)
+}
+
+// GetSlashingParams returns the slashing params set at genesis, or pebble.ErrNotFound before InitChain.
+func (s *Store) GetSlashingParams(ctx context.Context, r Reader) (*v1.SlashingParams, error) {
+	params := &v1.SlashingParams{}
+	found, err := getMessage(r, slashingParamsKey, params)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, pebble.ErrNotFound
+	}
+	return params, nil
+}
+
+func (s *Store) SetSlashingParams(ctx context.Context, w Writer, params *v1.SlashingParams) error {
+	return setMessage(w, slashingParamsKey, params, false)
+}
+
+// GetMissedBlocks returns the blocks a validator missed, empty if it missed none.
+func (s *Store) GetMissedBlocks(ctx context.Context, r Reader, address []byte) (*v1.MissedBlocks, error) {
+	missed := &v1.MissedBlocks{ValidatorAddress: address}
+	if _, err := getMessage(r, MissedBlocksKey(address), missed); err != nil {
+		return nil, err
+	}
+	return missed, nil
+}
+
+// SetMissedBlocks writes the blocks a validator missed, removing the record once there are none.
+func (s *Store) SetMissedBlocks(ctx context.Context, w Writer, missed *v1.MissedBlocks) error {
+	return setMessage(w, MissedBlocksKey(missed.ValidatorAddress), missed, len(missed.Heights) == 0)
+}
+
+// HasPunishedEvidence reports whether the validator was already punished for evidence of
+// misbehavior at height.
+func (s *Store) HasPunishedEvidence(ctx context.Context, r Reader, address []byte, height int64) (bool, error) {
+	return getMessage(r, punishedEvidenceKey(address, height), &v1.PunishedEvidence{})
+}
+
+// SetPunishedEvidence records that a validator was punished for evidence of misbehavior.
+func (s *Store) SetPunishedEvidence(ctx context.Context, w Writer, evidence *v1.PunishedEvidence) error {
+	return setMessage(w, punishedEvidenceKey(evidence.ValidatorAddress, evidence.Height), evidence, false)
+}
@@ -0,0 +1,369 @@
+package store
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/cockroachdb/pebble"
+)
+
+// The state commitment is a compact sparse Merkle tree. Every key written through a block's
+// Cache is a leaf at the position given by the bits of sha256(key), committing to sha256(value).
+//
+//	empty subtree = 32 zero bytes
+//	leaf          = sha256(0x00 || sha256(key) || sha256(value))
+//	inner         = sha256(0x01 || left || right)
+//
+// A subtree holding a single leaf is represented by that leaf, so the tree only gets as deep
+// as needed to tell its keys apart. The shape follows from the set of leaves alone, which
+// makes the root independent of the order keys were written in.
+//
+// Nodes are content addressed and shared between versions of the tree. A node that drops out
+// of the tree is recorded as orphaned at that height and only deleted once every height whose
+// tree still holds it has been pruned, so proofs can be made against any retained height.
+
+var (
+	smtRootKey    = []byte("smt:root")
+	smtRootPrefix = []byte("smt:roots:")
+	smtNodePrefix = []byte("smt:node:")
+	// orphans are indexed by height for pruning, and by hash so a node that rejoins the tree is kept
+	smtOrphanPrefix   = []byte("smt:orphan:")
+	smtOrphanedPrefix = []byte("smt:orphaned:")
+
+	// EmptyRoot is the state root of a store with nothing in it.
+	EmptyRoot = make([]byte, sha256.Size)
+)
+
+const (
+	smtLeafPrefix  byte = 0x00
+	smtInnerPrefix byte = 0x01
+)
+
+func smtNodeKey(hash []byte) []byte {
+	return append(bytes.Clone(smtNodePrefix), hash...)
+}
+
+func smtRootAtKey(height int64) []byte {
+	return binary.BigEndian.AppendUint64(bytes.Clone(smtRootPrefix), uint64(height))
+}
+
+func smtOrphanKey(height int64, hash []byte) []byte {
+	key := binary.BigEndian.AppendUint64(bytes.Clone(smtOrphanPrefix), uint64(height))
+	return append(key, hash...)
+}
+
+func smtOrphanedKey(hash []byte) []byte {
+	return append(bytes.Clone(smtOrphanedPrefix), hash...)
+}
+
+func smtLeafHash(path []byte, valueHash []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{smtLeafPrefix})
+	h.Write(path)
+	h.Write(valueHash)
+	return h.Sum(nil)
+}
+
+func smtInnerHash(left []byte, right []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{smtInnerPrefix})
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
+
+// smtBit returns the bit of path at depth, counting from the most significant bit.
+func smtBit(path []byte, depth int) int {
+	return int(path[depth/8]>>(7-depth%8)) & 1
+}
+
+func isEmpty(hash []byte) bool {
+	return bytes.Equal(hash, EmptyRoot)
+}
+
+// smtNode is stored under its hash as 0x00 || path || value hash for a leaf
+// and 0x01 || left || right for an inner node.
+type smtNode struct {
+	leaf      bool
+	path      []byte
+	valueHash []byte
+	left      []byte
+	right     []byte
+}
+
+func (n *smtNode) hash() []byte {
+	if n.leaf {
+		return smtLeafHash(n.path, n.valueHash)
+	}
+	return smtInnerHash(n.left, n.right)
+}
+
+func (n *smtNode) encode() []byte {
+	if n.leaf {
+		return append(append([]byte{smtLeafPrefix}, n.path...), n.valueHash...)
+	}
+	return append(append([]byte{smtInnerPrefix}, n.left...), n.right...)
+}
+
+func decodeSMTNode(b []byte) (*smtNode, error) {
+	if len(b) != 1+2*sha256.Size {
+		return nil, fmt.Errorf("invalid smt node length %d", len(b))
+	}
+	a, c := bytes.Clone(b[1:1+sha256.Size]), bytes.Clone(b[1+sha256.Size:])
+	switch b[0] {
+	case smtLeafPrefix:
+		return &smtNode{leaf: true, path: a, valueHash: c}, nil
+	case smtInnerPrefix:
+		return &smtNode{left: a, right: c}, nil
+	default:
+		return nil, fmt.Errorf("invalid smt node prefix %x", b[0])
+	}
+}
+
+func getSMTNode(r Reader, hash []byte) (*smtNode, error) {
+	value, closer, err := r.Get(smtNodeKey(hash))
+	if err != nil {
+		return nil, fmt.Errorf("smt node %x: %w", hash, err)
+	}
+	defer closer.Close()
+	return decodeSMTNode(value)
+}
+
+// smtUpdate applies a block's writes to the committed tree. New nodes are held in memory and
+// nodes that drop out of the tree are recorded as orphaned at the block's height once the update
+// is written.
+type smtUpdate struct {
+	db      Reader
+	height  int64
+	pending map[string]*smtNode
+	orphans map[string]struct{}
+}
+
+func newSMTUpdate(db Reader, height int64) *smtUpdate {
+	return &smtUpdate{
+		db:      db,
+		height:  height,
+		pending: make(map[string]*smtNode),
+		orphans: make(map[string]struct{}),
+	}
+}
+
+func (u *smtUpdate) get(hash []byte) (*smtNode, error) {
+	if node, ok := u.pending[string(hash)]; ok {
+		return node, nil
+	}
+	return getSMTNode(u.db, hash)
+}
+
+func (u *smtUpdate) put(node *smtNode) []byte {
+	hash := node.hash()
+	u.pending[string(hash)] = node
+	delete(u.orphans, string(hash))
+	return hash
+}
+
+func (u *smtUpdate) orphan(hash []byte) {
+	if _, ok := u.pending[string(hash)]; ok {
+		delete(u.pending, string(hash))
+		return
+	}
+	u.orphans[string(hash)] = struct{}{}
+}
+
+// update sets the leaf at path to valueHash, or removes it when valueHash is nil, in the
+// subtree rooted at hash and depth. It returns the new root of the subtree.
+func (u *smtUpdate) update(hash []byte, depth int, path []byte, valueHash []byte) ([]byte, error) {
+	if isEmpty(hash) {
+		if valueHash == nil {
+			return hash, nil
+		}
+		return u.put(&smtNode{leaf: true, path: path, valueHash: valueHash}), nil
+	}
+
+	node, err := u.get(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	if node.leaf {
+		if bytes.Equal(node.path, path) {
+			if bytes.Equal(node.valueHash, valueHash) {
+				return hash, nil
+			}
+			u.orphan(hash)
+			if valueHash == nil {
+				return EmptyRoot, nil
+			}
+			return u.put(&smtNode{leaf: true, path: path, valueHash: valueHash}), nil
+		}
+		if valueHash == nil {
+			return hash, nil
+		}
+		leaf := u.put(&smtNode{leaf: true, path: path, valueHash: valueHash})
+		return u.split(depth, node.path, hash, path, leaf), nil
+	}
+
+	left, right := node.left, node.right
+	if smtBit(path, depth) == 0 {
+		left, err = u.update(left, depth+1, path, valueHash)
+	} else {
+		right, err = u.update(right, depth+1, path, valueHash)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(left, node.left) && bytes.Equal(right, node.right) {
+		return hash, nil
+	}
+	u.orphan(hash)
+	return u.inner(left, right)
+}
+
+// split builds the subtree at depth holding two leaves with different paths.
+func (u *smtUpdate) split(depth int, pathA []byte, hashA []byte, pathB []byte, hashB []byte) []byte {
+	bitA, bitB := smtBit(pathA, depth), smtBit(pathB, depth)
+	if bitA == bitB {
+		child := u.split(depth+1, pathA, hashA, pathB, hashB)
+		if bitA == 0 {
+			return u.put(&smtNode{left: child, right: EmptyRoot})
+		}
+		return u.put(&smtNode{left: EmptyRoot, right: child})
+	}
+	if bitA == 0 {
+		return u.put(&smtNode{left: hashA, right: hashB})
+	}
+	return u.put(&smtNode{left: hashB, right: hashA})
+}
+
+// inner builds an inner node, collapsing it into its only child when that child is a leaf.
+func (u *smtUpdate) inner(left []byte, right []byte) ([]byte, error) {
+	switch {
+	case isEmpty(left) && isEmpty(right):
+		return EmptyRoot, nil
+	case isEmpty(left) || isEmpty(right):
+		only := left
+		if isEmpty(left) {
+			only = right
+		}
+		node, err := u.get(only)
+		if err != nil {
+			return nil, err
+		}
+		if node.leaf {
+			return only, nil
+		}
+	}
+	return u.put(&smtNode{left: left, right: right}), nil
+}
+
+func (u *smtUpdate) write(batch *pebble.Batch) error {
+	for hash, node := range u.pending {
+		if err := batch.Set(smtNodeKey([]byte(hash)), node.encode(), nil); err != nil {
+			return err
+		}
+		// a node orphaned at an earlier height is back in the tree and must not be pruned
+		if err := u.revive([]byte(hash), batch); err != nil {
+			return err
+		}
+	}
+	for hash := range u.orphans {
+		if err := batch.Set(smtOrphanKey(u.height, []byte(hash)), nil, nil); err != nil {
+			return err
+		}
+		if err := batch.Set(smtOrphanedKey([]byte(hash)), binary.BigEndian.AppendUint64(nil, uint64(u.height)), nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *smtUpdate) revive(hash []byte, batch *pebble.Batch) error {
+	value, closer, err := u.db.Get(smtOrphanedKey(hash))
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	height := int64(binary.BigEndian.Uint64(value))
+	closer.Close()
+
+	if err := batch.Delete(smtOrphanKey(height, hash), nil); err != nil {
+		return err
+	}
+	return batch.Delete(smtOrphanedKey(hash), nil)
+}
+
+// StateRootAt returns the state root committed at height, or pebble.ErrNotFound if it was pruned.
+func (s *Store) StateRootAt(height int64) ([]byte, error) {
+	value, closer, err := s.DB.Get(smtRootAtKey(height))
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	return bytes.Clone(value), nil
+}
+
+// StateRoot returns the committed state root.
+func (s *Store) StateRoot() ([]byte, error) {
+	value, closer, err := s.DB.Get(smtRootKey)
+	if errors.Is(err, pebble.ErrNotFound) {
+		return EmptyRoot, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	return bytes.Clone(value), nil
+}
+
+// WriteState flushes the cache into the batch as the state at height, recording a version of
+// every key the cache wrote and updating the state commitment. It returns the new state root,
+// which the app reports as its app hash.
+func (s *Store) WriteState(cache *Cache, batch *pebble.Batch, height int64) ([]byte, error) {
+	root, err := s.StateRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := s.newVersionWriter(batch, height)
+	if err != nil {
+		return nil, err
+	}
+	defer versions.close()
+
+	update := newSMTUpdate(s.DB, height)
+	err = cache.each(func(key string, entry cacheEntry) error {
+		path := sha256.Sum256([]byte(key))
+		var valueHash []byte
+		if !entry.deleted {
+			sum := sha256.Sum256(entry.value)
+			valueHash = sum[:]
+		}
+		root, err = update.update(root, 0, path[:], valueHash)
+		if err != nil {
+			return err
+		}
+		return versions.write([]byte(key), entry)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := update.write(batch); err != nil {
+		return nil, err
+	}
+	if err := batch.Set(smtRootKey, root, nil); err != nil {
+		return nil, err
+	}
+	if err := batch.Set(smtRootAtKey(height), root, nil); err != nil {
+		return nil, err
+	}
+	if err := cache.Write(batch); err != nil {
+		return nil, err
+	}
+	return root, nil
+}
@@ -0,0 +1,138 @@
+package store
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// referenceRoot computes the state root of a set of key values directly from the tree's definition.
+func referenceRoot(state map[string]string) []byte {
+	type leaf struct{ path, valueHash []byte }
+	leaves := make([]leaf, 0, len(state))
+	for key, value := range state {
+		path := sha256.Sum256([]byte(key))
+		valueHash := sha256.Sum256([]byte(value))
+		leaves = append(leaves, leaf{path[:], valueHash[:]})
+	}
+
+	var root func(leaves []leaf, depth int) []byte
+	root = func(leaves []leaf, depth int) []byte {
+		switch len(leaves) {
+		case 0:
+			return EmptyRoot
+		case 1:
+			return smtLeafHash(leaves[0].path, leaves[0].valueHash)
+		}
+		var left, right []leaf
+		for _, l := range leaves {
+			if smtBit(l.path, depth) == 0 {
+				left = append(left, l)
+			} else {
+				right = append(right, l)
+			}
+		}
+		return smtInnerHash(root(left, depth+1), root(right, depth+1))
+	}
+	return root(leaves, 0)
+}
+
+// commitState writes the changes through a cache the way a block at height does and commits them.
+func commitState(t *testing.T, s *Store, height int64, changes map[string]*string) []byte {
+	cache := NewCache(s.DB)
+	for key, value := range changes {
+		if value == nil {
+			require.NoError(t, cache.Delete([]byte(key), nil))
+		} else {
+			require.NoError(t, cache.Set([]byte(key), []byte(*value), nil))
+		}
+	}
+	batch := s.NewBatch()
+	root, err := s.WriteState(cache, batch, height)
+	require.NoError(t, err)
+	require.NoError(t, batch.Commit(nil))
+	return root
+}
+
+func TestStateRootMatchesReference(t *testing.T) {
+	s := NewStore(openTestDB(t))
+	rng := rand.New(rand.NewSource(1))
+
+	root, err := s.StateRoot()
+	require.NoError(t, err)
+	require.Equal(t, EmptyRoot, root)
+
+	state := make(map[string]string)
+	for block := range 20 {
+		changes := make(map[string]*string)
+		for range 25 {
+			key := fmt.Sprintf("kv:%d", rng.Intn(200))
+			if _, ok := state[key]; ok && rng.Intn(3) == 0 {
+				changes[key] = nil
+				delete(state, key)
+				continue
+			}
+			value := fmt.Sprintf("value-%d-%d", block, rng.Int())
+			changes[key] = &value
+			state[key] = value
+		}
+
+		root := commitState(t, s, int64(block+1), changes)
+		require.Equal(t, referenceRoot(state), root, "block %d", block)
+
+		committed, err := s.StateRoot()
+		require.NoError(t, err)
+		require.Equal(t, root, committed)
+	}
+
+	// removing everything and pruning the history leaves an empty tree with nothing behind
+	changes := make(map[string]*string)
+	keys := make([]string, 0, len(state))
+	for key := range state {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		changes[key] = nil
+	}
+	require.Equal(t, EmptyRoot, commitState(t, s, 21, changes))
+
+	batch := s.NewBatch()
+	require.NoError(t, s.Prune(batch, 21))
+	require.NoError(t, batch.Commit(nil))
+
+	iter, err := s.NewIter(nil)
+	require.NoError(t, err)
+	defer iter.Close()
+	for iter.First(); iter.Valid(); iter.Next() {
+		for _, prefix := range [][]byte{smtNodePrefix, smtOrphanPrefix, smtOrphanedPrefix, historyPrefix, historyPrunePrefix} {
+			require.False(t, bytes.HasPrefix(iter.Key(), prefix), "%q left behind", iter.Key())
+		}
+	}
+}
+
+func TestStateRootIsOrderIndependent(t *testing.T) {
+	a := NewStore(openTestDB(t))
+	b := NewStore(openTestDB(t))
+
+	values := make(map[string]*string)
+	for i := range 50 {
+		value := fmt.Sprintf("%d", i)
+		values[fmt.Sprintf("account:%d", i)] = &value
+	}
+
+	// a commits everything in one block, b one key per block
+	rootA := commitState(t, a, 1, values)
+	var rootB []byte
+	height := int64(0)
+	for key, value := range values {
+		height++
+		rootB = commitState(t, b, height, map[string]*string{key: value})
+	}
+	require.Equal(t, rootA, rootB)
+}
@@ -0,0 +1,409 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"sync"
+
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/cockroachdb/pebble"
+	"google.golang.org/protobuf/proto"
+)
+
+// SnapshotFormat is the format of state sync snapshots. It changes whenever the chunk encoding does.
+// Format 2 carries only the state the app hash commits to.
+const SnapshotFormat uint32 = 2
+
+// DefaultSnapshotChunkSize is the size chunks are filled up to before a new one is started.
+const DefaultSnapshotChunkSize = 4 << 20
+
+var restoringKey = []byte("meta:restoring")
+
+// ErrRestoredStateMismatch is returned by FinishRestore when the restored state does not hash to
+// the trusted app hash.
+var ErrRestoredStateMismatch = errors.New("restored state does not match the trusted app hash")
+
+// Beside the state, the store keeps the tree, the history and records of its own that are not
+// committed to by the app hash. Snapshots leave them out, and a restore rebuilds them from the state.
+var (
+	localPrefixes = [][]byte{[]byte("smt:"), historyPrefix, historyPrunePrefix}
+	localKeys     = [][]byte{lastBlockKey, earliestHeightKey, restoringKey}
+)
+
+// isStateKey reports whether key belongs to the state the app hash commits to.
+func isStateKey(key []byte) bool {
+	for _, prefix := range localPrefixes {
+		if bytes.HasPrefix(key, prefix) {
+			return false
+		}
+	}
+	for _, local := range localKeys {
+		if bytes.Equal(key, local) {
+			return false
+		}
+	}
+	return true
+}
+
+const (
+	snapshotCheckpointDir = "checkpoint"
+	snapshotMetadataFile  = "metadata"
+)
+
+// Snapshot is a completed state sync snapshot of the store at a height.
+type Snapshot struct {
+	Height   uint64
+	Metadata *v1.SnapshotMetadata
+}
+
+// SnapshotStore keeps state sync snapshots on disk. A snapshot is taken as a pebble checkpoint,
+// which is cheap enough to do while committing, and exported into chunks in the background. Each
+// snapshot lives in a directory named after its height and is complete once its metadata is written.
+type SnapshotStore struct {
+	dir        string
+	keepRecent int
+	chunkSize  int
+
+	// mu serializes exports and pruning, so only one snapshot is exported at a time.
+	mu sync.Mutex
+}
+
+// NewSnapshotStore keeps snapshots in dir, pruning all but the keepRecent most recent.
+func NewSnapshotStore(dir string, keepRecent int, chunkSize int) (*SnapshotStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &SnapshotStore{
+		dir:        dir,
+		keepRecent: keepRecent,
+		chunkSize:  chunkSize,
+	}, nil
+}
+
+func (s *SnapshotStore) snapshotDir(height uint64) string {
+	return filepath.Join(s.dir, strconv.FormatUint(height, 10))
+}
+
+// Checkpoint takes a checkpoint of the committed store for the snapshot at height.
+func (s *SnapshotStore) Checkpoint(db *pebble.DB, height uint64) error {
+	dir := s.snapshotDir(height)
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	return db.Checkpoint(filepath.Join(dir, snapshotCheckpointDir), pebble.WithFlushedWAL())
+}
+
+// Export chunks the checkpoint taken at height, completes the snapshot and prunes old snapshots.
+func (s *SnapshotStore) Export(height uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	dir := s.snapshotDir(height)
+	checkpointDir := filepath.Join(dir, snapshotCheckpointDir)
+	checkpoint, err := pebble.Open(checkpointDir, &pebble.Options{ReadOnly: true})
+	if err != nil {
+		return err
+	}
+
+	metadata, err := s.writeChunks(dir, checkpoint)
+	if closeErr := checkpoint.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	metadataBytes, err := proto.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+	// the metadata is written last and renamed into place, marking the snapshot complete
+	tmp := filepath.Join(dir, snapshotMetadataFile+".tmp")
+	if err := os.WriteFile(tmp, metadataBytes, 0644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, filepath.Join(dir, snapshotMetadataFile)); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(checkpointDir); err != nil {
+		return err
+	}
+
+	return s.prune()
+}
+
+// writeChunks encodes every state key in db into chunk files in dir and returns their hashes.
+func (s *SnapshotStore) writeChunks(dir string, db *pebble.DB) (*v1.SnapshotMetadata, error) {
+	metadata := &v1.SnapshotMetadata{}
+	var chunk []byte
+	flush := func() error {
+		path := filepath.Join(dir, strconv.Itoa(len(metadata.ChunkHashes)))
+		if err := os.WriteFile(path, chunk, 0644); err != nil {
+			return err
+		}
+		hash := sha256.Sum256(chunk)
+		metadata.ChunkHashes = append(metadata.ChunkHashes, hash[:])
+		chunk = chunk[:0]
+		return nil
+	}
+
+	iter, err := db.NewIter(nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	for iter.First(); iter.Valid(); iter.Next() {
+		if !isStateKey(iter.Key()) {
+			continue
+		}
+		chunk = appendSnapshotRecord(chunk, iter.Key(), iter.Value())
+		if len(chunk) >= s.chunkSize {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+
+	// an empty store still has a chunk, so every snapshot has at least one
+	if len(chunk) > 0 || len(metadata.ChunkHashes) == 0 {
+		if err := flush(); err != nil {
+			return nil, err
+		}
+	}
+	return metadata, nil
+}
+
+// prune removes all but the keepRecent most recent complete snapshots, along with any
+// unfinished snapshot older than them.
+func (s *SnapshotStore) prune() error {
+	heights, err := s.heights()
+	if err != nil {
+		return err
+	}
+
+	kept := 0
+	for _, height := range heights {
+		if kept < s.keepRecent {
+			if _, err := os.Stat(filepath.Join(s.snapshotDir(height), snapshotMetadataFile)); err == nil {
+				kept++
+			}
+			continue
+		}
+		if err := os.RemoveAll(s.snapshotDir(height)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// heights lists the heights of the snapshot directories, newest first.
+func (s *SnapshotStore) heights() ([]uint64, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var heights []uint64
+	for _, entry := range entries {
+		height, err := strconv.ParseUint(entry.Name(), 10, 64)
+		if err != nil || !entry.IsDir() {
+			continue
+		}
+		heights = append(heights, height)
+	}
+	slices.Sort(heights)
+	slices.Reverse(heights)
+	return heights, nil
+}
+
+// List returns the complete snapshots, newest first.
+func (s *SnapshotStore) List() ([]*Snapshot, error) {
+	heights, err := s.heights()
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshots []*Snapshot
+	for _, height := range heights {
+		metadataBytes, err := os.ReadFile(filepath.Join(s.snapshotDir(height), snapshotMetadataFile))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		metadata := &v1.SnapshotMetadata{}
+		if err := proto.Unmarshal(metadataBytes, metadata); err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, &Snapshot{Height: height, Metadata: metadata})
+	}
+	return snapshots, nil
+}
+
+// LoadChunk reads a chunk of the snapshot at height.
+func (s *SnapshotStore) LoadChunk(height uint64, index uint32) ([]byte, error) {
+	return os.ReadFile(filepath.Join(s.snapshotDir(height), strconv.FormatUint(uint64(index), 10)))
+}
+
+// RestoreChunk writes the keys in a snapshot chunk to the store. Chunks may only hold state keys,
+// which FinishRestore then checks against the app hash.
+func (s *Store) RestoreChunk(chunk []byte) error {
+	batch := s.NewBatch()
+	defer batch.Close()
+
+	for len(chunk) > 0 {
+		var key, value []byte
+		var err error
+		key, value, chunk, err = readSnapshotRecord(chunk)
+		if err != nil {
+			return err
+		}
+		if !isStateKey(key) {
+			return fmt.Errorf("snapshot record for key %q outside the state", key)
+		}
+		if err := batch.Set(key, value, nil); err != nil {
+			return err
+		}
+	}
+	return batch.Commit(pebble.Sync)
+}
+
+// Reset deletes everything in the store, discarding a partially restored snapshot.
+func (s *Store) Reset() error {
+	// every key starts with a printable prefix
+	return s.DB.DeleteRange([]byte{}, []byte{0xff}, pebble.Sync)
+}
+
+// BeginRestore empties the store and marks it as being restored from a snapshot until FinishRestore.
+func (s *Store) BeginRestore() error {
+	if err := s.Reset(); err != nil {
+		return err
+	}
+	return s.DB.Set(restoringKey, nil, pebble.Sync)
+}
+
+// FinishRestore completes a restore as the state committed at height. It rebuilds the tree from
+// the restored state and only commits to it if its root is appHash, which callers take from a
+// trusted header, so a snapshot cannot forge any of the state. The history starts at height along
+// with the tree, so earlier heights cannot be read.
+func (s *Store) FinishRestore(ctx context.Context, height int64, appHash []byte) error {
+	batch := s.NewBatch()
+	defer batch.Close()
+
+	versions, err := s.newVersionWriter(batch, height)
+	if err != nil {
+		return err
+	}
+	defer versions.close()
+
+	iter, err := s.DB.NewIter(nil)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	update := newSMTUpdate(s.DB, height)
+	root := EmptyRoot
+	for iter.First(); iter.Valid(); iter.Next() {
+		if !isStateKey(iter.Key()) {
+			continue
+		}
+		key, value := bytes.Clone(iter.Key()), bytes.Clone(iter.Value())
+		path := sha256.Sum256(key)
+		valueHash := sha256.Sum256(value)
+		root, err = update.update(root, 0, path[:], valueHash[:])
+		if err != nil {
+			return err
+		}
+		if err := versions.write(key, cacheEntry{value: value}); err != nil {
+			return err
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return err
+	}
+	if !bytes.Equal(root, appHash) {
+		return fmt.Errorf("%w: state root %X, app hash %X", ErrRestoredStateMismatch, root, appHash)
+	}
+
+	if err := update.write(batch); err != nil {
+		return err
+	}
+	if err := batch.Set(smtRootKey, root, nil); err != nil {
+		return err
+	}
+	if err := batch.Set(smtRootAtKey(height), root, nil); err != nil {
+		return err
+	}
+	if err := batch.Set(earliestHeightKey, binary.BigEndian.AppendUint64(nil, uint64(height)), nil); err != nil {
+		return err
+	}
+	if err := s.SetLastBlock(ctx, batch, height, root); err != nil {
+		return err
+	}
+	if err := batch.Delete(restoringKey, nil); err != nil {
+		return err
+	}
+	return batch.Commit(pebble.Sync)
+}
+
+// DiscardInterruptedRestore empties the store if a node stopped while restoring a snapshot,
+// since the partial state cannot be used.
+func (s *Store) DiscardInterruptedRestore() error {
+	_, closer, err := s.DB.Get(restoringKey)
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	closer.Close()
+	return s.Reset()
+}
+
+// A chunk is a sequence of records, each a uvarint length prefixed key followed by a uvarint
+// length prefixed value. Records are never split across chunks.
+func appendSnapshotRecord(chunk []byte, key []byte, value []byte) []byte {
+	chunk = binary.AppendUvarint(chunk, uint64(len(key)))
+	chunk = append(chunk, key...)
+	chunk = binary.AppendUvarint(chunk, uint64(len(value)))
+	return append(chunk, value...)
+}
+
+func readSnapshotRecord(chunk []byte) (key []byte, value []byte, rest []byte, err error) {
+	key, rest, err = readSnapshotField(chunk)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	value, rest, err = readSnapshotField(rest)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return key, value, rest, nil
+}
+
+func readSnapshotField(chunk []byte) ([]byte, []byte, error) {
+	length, n := binary.Uvarint(chunk)
+	if n <= 0 || length > uint64(len(chunk)-n) {
+		return nil, nil, fmt.Errorf("invalid snapshot record")
+	}
+	chunk = chunk[n:]
+	return bytes.Clone(chunk[:length]), chunk[length:], nil
+}
@@ -0,0 +1,100 @@
+package store
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotRestore(t *testing.T) {
+	// checkpoints are taken on disk, so the source store cannot live in memory
+	db, err := pebble.Open(t.TempDir(), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	source := &Store{db}
+
+	changes := map[string]*string{}
+	for i := range 200 {
+		value := fmt.Sprintf("value-%d", i)
+		changes[fmt.Sprintf("key-%d", i)] = &value
+	}
+	root := commitState(t, source, 1, changes)
+
+	snapshots, err := NewSnapshotStore(t.TempDir(), 1, 256)
+	require.NoError(t, err)
+	require.NoError(t, snapshots.Checkpoint(source.DB, 5))
+	require.NoError(t, snapshots.Export(5))
+
+	list, err := snapshots.List()
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Equal(t, uint64(5), list[0].Height)
+	require.Greater(t, len(list[0].Metadata.ChunkHashes), 1)
+
+	target := &Store{openTestDB(t)}
+	require.NoError(t, target.BeginRestore())
+	for i, hash := range list[0].Metadata.ChunkHashes {
+		chunk, err := snapshots.LoadChunk(5, uint32(i))
+		require.NoError(t, err)
+		chunkHash := sha256.Sum256(chunk)
+		require.Equal(t, hash, chunkHash[:])
+		require.NoError(t, target.RestoreChunk(chunk))
+	}
+	require.NoError(t, target.FinishRestore(t.Context(), 5, root))
+
+	// the tree, history and last block are rebuilt rather than restored
+	restoredRoot, err := target.StateRoot()
+	require.NoError(t, err)
+	require.Equal(t, root, restoredRoot)
+	requireValue(t, target.DB, "key-7", "value-7")
+	requireValue(t, target.At(5), "key-7", "value-7")
+	height, appHash, err := target.GetLastBlock(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, int64(5), height)
+	require.Equal(t, root, appHash)
+	earliest, err := target.EarliestHeight()
+	require.NoError(t, err)
+	require.Equal(t, int64(5), earliest)
+
+	// only the most recent snapshot is kept
+	require.NoError(t, snapshots.Checkpoint(source.DB, 10))
+	require.NoError(t, snapshots.Export(10))
+	list, err = snapshots.List()
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Equal(t, uint64(10), list[0].Height)
+}
+
+func TestRestoreRejectsForgedState(t *testing.T) {
+	source := &Store{openTestDB(t)}
+	value := "value"
+	root := commitState(t, source, 1, map[string]*string{"key": &value})
+
+	// a leaf that does not hash to the trusted root
+	target := &Store{openTestDB(t)}
+	require.NoError(t, target.BeginRestore())
+	require.NoError(t, target.RestoreChunk(appendSnapshotRecord(nil, []byte("key"), []byte("forged"))))
+	require.ErrorIs(t, target.FinishRestore(t.Context(), 1, root), ErrRestoredStateMismatch)
+	_, _, err := target.GetLastBlock(t.Context())
+	require.ErrorIs(t, err, pebble.ErrNotFound)
+
+	// records the store keeps beside the state cannot be restored at all
+	require.NoError(t, target.BeginRestore())
+	require.Error(t, target.RestoreChunk(appendSnapshotRecord(nil, smtRootKey, root)))
+	require.Error(t, target.RestoreChunk(appendSnapshotRecord(nil, historyKey([]byte("key"), 1), []byte{versionSet})))
+}
+
+func TestInterruptedRestoreIsDiscarded(t *testing.T) {
+	s := &Store{openTestDB(t)}
+
+	require.NoError(t, s.BeginRestore())
+	require.NoError(t, s.RestoreChunk(appendSnapshotRecord(nil, []byte("key"), []byte("value"))))
+
+	require.NoError(t, s.DiscardInterruptedRestore())
+	requireNotFound(t, s.DB, "key")
+}
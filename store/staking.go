@@ -0,0 +1,156 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/cockroachdb/pebble"
+	"google.golang.org/protobuf/proto"
+)
+
+var stakingParamsKey = []byte("params:staking")
+
+// StakingParamsKey is the store key of the staking params.
+func StakingParamsKey() []byte {
+	return bytes.Clone(stakingParamsKey)
+}
+
+// DelegationsKey is the store key of a delegator's delegations.
+func DelegationsKey(delegator []byte) []byte {
+	return fmt.Appendf(nil, "delegations:%x", delegator)
+}
+
+// UnbondingKey is the store key of a delegator's unbonding queue.
+func UnbondingKey(delegator []byte) []byte {
+	return fmt.Appendf(nil, "unbonding:%x", delegator)
+}
+
+// unbondingMaturityKey indexes a delegator with an unbonding entry completing at height. The
+// height is fixed width, so the keys of the entries completing by a height sort before it.
+func unbondingMaturityKey(height uint64, delegator []byte) []byte {
+	return fmt.Appendf(nil, "unbonding_maturity:%016x:%x", height, delegator)
+}
+
+// unbondingDelegatorKey indexes a delegator with an entry unbonding from validator.
+func unbondingDelegatorKey(validator, delegator []byte) []byte {
+	return fmt.Appendf(nil, "unbonding_delegators:%x:%x", validator, delegator)
+}
+
+// getMessage reads key from r into m. It reports whether the key exists rather than
+// returning pebble.ErrNotFound, since staking records that do not exist are empty.
+func getMessage(r Reader, key []byte, m proto.Message) (bool, error) {
+	value, closer, err := r.Get(key)
+	if errors.Is(err, pebble.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer closer.Close()
+
+	return true, proto.Unmarshal(value, m)
+}
+
+// setMessage writes m to key in w, or deletes the key when deleted is set.
+func setMessage(w Writer, key []byte, m proto.Message, deleted bool) error {
+	if deleted {
+		return w.Delete(key, nil)
+	}
+	value, err := proto.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return w.Set(key, value, nil)
+}
+
+// GetStakingParams returns the staking params set at genesis, or pebble.ErrNotFound before InitChain.
+func (s *Store) GetStakingParams(ctx context.Context, r Reader) (*v1.StakingParams, error) {
+	params := &v1.StakingParams{}
+	found, err := getMessage(r, stakingParamsKey, params)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, pebble.ErrNotFound
+	}
+	return params, nil
+}
+
+func (s *Store) SetStakingParams(ctx context.Context, w Writer, params *v1.StakingParams) error {
+	return setMessage(w, stakingParamsKey, params, false)
+}
+
+// GetDelegations returns a delegator's delegations, empty if it has none.
+func (s *Store) GetDelegations(ctx context.Context, r Reader, delegator []byte) (*v1.DelegatorState, error) {
+	delegations := &v1.DelegatorState{DelegatorPubkey: delegator}
+	if _, err := getMessage(r, DelegationsKey(delegator), delegations); err != nil {
+		return nil, err
+	}
+	return delegations, nil
+}
+
+// SetDelegations writes a delegator's delegations, removing the record once it has none.
+func (s *Store) SetDelegations(ctx context.Context, w Writer, delegations *v1.DelegatorState) error {
+	return setMessage(w, DelegationsKey(delegations.DelegatorPubkey), delegations, len(delegations.Delegations) == 0)
+}
+
+// GetUnbonding returns a delegator's unbonding queue, empty if it is not unbonding anything.
+func (s *Store) GetUnbonding(ctx context.Context, r Reader, delegator []byte) (*v1.UnbondingQueue, error) {
+	queue := &v1.UnbondingQueue{DelegatorPubkey: delegator}
+	if _, err := getMessage(r, UnbondingKey(delegator), queue); err != nil {
+		return nil, err
+	}
+	return queue, nil
+}
+
+// SetUnbonding writes a delegator's unbonding queue, removing the record once it is empty.
+func (s *Store) SetUnbonding(ctx context.Context, w Writer, queue *v1.UnbondingQueue) error {
+	return setMessage(w, UnbondingKey(queue.DelegatorPubkey), queue, len(queue.Entries) == 0)
+}
+
+// SetUnbondingMaturity records that the delegator has an unbonding entry completing at height.
+func (s *Store) SetUnbondingMaturity(ctx context.Context, w Writer, height uint64, delegator []byte) error {
+	return w.Set(unbondingMaturityKey(height, delegator), delegator, nil)
+}
+
+// DeleteUnbondingMaturity removes the record that the delegator has an entry completing at height.
+func (s *Store) DeleteUnbondingMaturity(ctx context.Context, w Writer, height uint64, delegator []byte) error {
+	return w.Delete(unbondingMaturityKey(height, delegator), nil)
+}
+
+// EachUnbondingMaturity calls fn, in height order, for every delegator with an unbonding entry
+// completing by height and the height it was recorded at.
+func (s *Store) EachUnbondingMaturity(ctx context.Context, r Reader, height uint64, fn func(height uint64, delegator []byte) error) error {
+	prefix := []byte("unbonding_maturity:")
+	end := prefixEnd(fmt.Appendf(nil, "unbonding_maturity:%016x:", height))
+	return eachInRange(r, prefix, end, func(key, value []byte) error {
+		completion, err := strconv.ParseUint(string(key[len(prefix):len(prefix)+16]), 16, 64)
+		if err != nil {
+			return fmt.Errorf("malformed unbonding maturity key %q: %w", key, err)
+		}
+		return fn(completion, value)
+	})
+}
+
+// SetUnbondingDelegator records that the delegator has tokens unbonding from the validator.
+func (s *Store) SetUnbondingDelegator(ctx context.Context, w Writer, validator, delegator []byte) error {
+	return w.Set(unbondingDelegatorKey(validator, delegator), delegator, nil)
+}
+
+// DeleteUnbondingDelegator removes the record that the delegator has tokens unbonding from the
+// validator.
+func (s *Store) DeleteUnbondingDelegator(ctx context.Context, w Writer, validator, delegator []byte) error {
+	return w.Delete(unbondingDelegatorKey(validator, delegator), nil)
+}
+
+// EachUnbondingDelegator calls fn for every delegator with tokens unbonding from the validator.
+func (s *Store) EachUnbondingDelegator(ctx context.Context, r Reader, validator []byte, fn func(delegator []byte) error) error {
+	prefix := fmt.Appendf(nil, "unbonding_delegators:%x:", validator)
+	return eachInRange(r, prefix, prefixEnd(prefix), func(_, value []byte) error {
+		return fn(value)
+	})
+}
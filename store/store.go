@@ -1,9 +1,29 @@
 package store
 
 import (
+	"bytes"
+	"io"
+
 	"github.com/cockroachdb/pebble"
 )
 
+// Reader is the read side of the store. It is satisfied by the committed *pebble.DB and by a Cache,
+// the latter seeing its own pending writes.
+type Reader interface {
+	Get(key []byte) ([]byte, io.Closer, error)
+}
+
+// Writer is the write side of the store. It is satisfied by *pebble.Batch and by a Cache.
+type Writer interface {
+	Set(key, value []byte, opts *pebble.WriteOptions) error
+	Delete(key []byte, opts *pebble.WriteOptions) error
+}
+
+type ReadWriter interface {
+	Reader
+	Writer
+}
+
 type Store struct {
 	*pebble.DB
 }
@@ -13,3 +33,16 @@ func NewStore(db *pebble.DB) *Store {
 		DB: db,
 	}
 }
+
+// prefixEnd returns the first key after every key starting with prefix, the upper bound of an
+// iterator over the prefix.
+func prefixEnd(prefix []byte) []byte {
+	end := bytes.Clone(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/cockroachdb/pebble"
+)
+
+var (
+	upgradePlanKey = []byte("upgrade:plan")
+	appVersionKey  = []byte("meta:app_version")
+)
+
+// UpgradePlanKey is the store key of the scheduled upgrade.
+func UpgradePlanKey() []byte {
+	return bytes.Clone(upgradePlanKey)
+}
+
+// GetUpgradePlan returns the upgrade governance scheduled, or pebble.ErrNotFound if there is none.
+func (s *Store) GetUpgradePlan(ctx context.Context, r Reader) (*v1.UpgradePlan, error) {
+	plan := &v1.UpgradePlan{}
+	found, err := getMessage(r, upgradePlanKey, plan)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, pebble.ErrNotFound
+	}
+	return plan, nil
+}
+
+// SetUpgradePlan schedules an upgrade, replacing any scheduled before.
+func (s *Store) SetUpgradePlan(ctx context.Context, w Writer, plan *v1.UpgradePlan) error {
+	return setMessage(w, upgradePlanKey, plan, false)
+}
+
+// DeleteUpgradePlan removes the scheduled upgrade once it has run.
+func (s *Store) DeleteUpgradePlan(ctx context.Context, w Writer) error {
+	return w.Delete(upgradePlanKey, nil)
+}
+
+// SetAppVersion records the app version the state is at.
+func (s *Store) SetAppVersion(ctx context.Context, w Writer, version uint64) error {
+	return w.Set(appVersionKey, binary.BigEndian.AppendUint64(nil, version), nil)
+}
+
+// GetAppVersion returns the app version the state is at, or pebble.ErrNotFound for state written
+// before app versions were recorded.
+func (s *Store) GetAppVersion(ctx context.Context, r Reader) (uint64, error) {
+	value, closer, err := r.Get(appVersionKey)
+	if err != nil {
+		return 0, err
+	}
+	defer closer.Close()
+
+	if len(value) != 8 {
+		return 0, errors.New("invalid app version record")
+	}
+	return binary.BigEndian.Uint64(value), nil
+}
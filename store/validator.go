@@ -0,0 +1,114 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	v1 "github.com/alecsavvy/mojave/gen/mojave/v1"
+	"github.com/cockroachdb/pebble"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	validatorSetKey   = []byte("validators")
+	validatorAdminKey = []byte("validator_admin")
+)
+
+func validatorKey(address []byte) []byte {
+	return fmt.Appendf(nil, "validator:%x", address)
+}
+
+// ValidatorSetKey is the store key of the active validator set.
+func ValidatorSetKey() []byte {
+	return bytes.Clone(validatorSetKey)
+}
+
+// GetValidator reads a validator by its CometBFT address. Validators removed from the set are
+// kept with no power, so blocks they still signed or proposed can be attributed to them.
+func (s *Store) GetValidator(ctx context.Context, r Reader, address []byte) (*v1.ValidatorState, error) {
+	value, closer, err := r.Get(validatorKey(address))
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	validator := &v1.ValidatorState{}
+	if err := proto.Unmarshal(value, validator); err != nil {
+		return nil, err
+	}
+	return validator, nil
+}
+
+// SetValidator writes the validator keyed by its address and updates the active validator set,
+// which holds it as long as it has power.
+func (s *Store) SetValidator(ctx context.Context, rw ReadWriter, validator *v1.ValidatorState) error {
+	value, err := proto.Marshal(validator)
+	if err != nil {
+		return err
+	}
+	if err := rw.Set(validatorKey(validator.Address), value, nil); err != nil {
+		return err
+	}
+
+	set, err := s.GetValidatorSet(ctx, rw)
+	if err != nil {
+		return err
+	}
+	i, found := slices.BinarySearchFunc(set.Validators, validator.Address, func(v *v1.ValidatorState, address []byte) int {
+		return bytes.Compare(v.Address, address)
+	})
+	switch {
+	case validator.Power > 0 && found:
+		set.Validators[i] = validator
+	case validator.Power > 0:
+		set.Validators = slices.Insert(set.Validators, i, validator)
+	case found:
+		set.Validators = slices.Delete(set.Validators, i, i+1)
+	default:
+		return nil
+	}
+
+	value, err = proto.Marshal(set)
+	if err != nil {
+		return err
+	}
+	return rw.Set(validatorSetKey, value, nil)
+}
+
+// GetValidatorSet reads the active validator set, ordered by address.
+func (s *Store) GetValidatorSet(ctx context.Context, r Reader) (*v1.ValidatorSet, error) {
+	value, closer, err := r.Get(validatorSetKey)
+	if errors.Is(err, pebble.ErrNotFound) {
+		return &v1.ValidatorSet{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	set := &v1.ValidatorSet{}
+	if err := proto.Unmarshal(value, set); err != nil {
+		return nil, err
+	}
+	return set, nil
+}
+
+// SetValidatorAdmin records the key allowed to change the validator set.
+func (s *Store) SetValidatorAdmin(ctx context.Context, w Writer, pubkey []byte) error {
+	return w.Set(validatorAdminKey, pubkey, nil)
+}
+
+// GetValidatorAdmin returns the key allowed to change the validator set, or pebble.ErrNotFound
+// if genesis did not set one.
+func (s *Store) GetValidatorAdmin(ctx context.Context, r Reader) ([]byte, error) {
+	value, closer, err := r.Get(validatorAdminKey)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	return bytes.Clone(value), nil
+}
@@ -1,12 +1,34 @@
 package utils
 
 import (
+	"crypto/ed25519"
+	"crypto/sha256"
+
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	"github.com/cometbft/cometbft/libs/bytes"
 )
 
 var ZeroAddress = make([]byte, 32)
 
+// FaucetPrivateKey holds the dev chain's faucet balance. It is derived from a public seed
+// so that any client can request tokens, which means it must never hold real value.
+var FaucetPrivateKey = func() ed25519.PrivateKey {
+	seed := sha256.Sum256([]byte("mojave-dev-faucet"))
+	return ed25519.NewKeyFromSeed(seed[:])
+}()
+
+var FaucetPubkey = []byte(FaucetPrivateKey.Public().(ed25519.PublicKey))
+
+// DevAdminPrivateKey is the validator admin and params admin of dev chains and testnets. Like the
+// faucet it is derived from a public seed, so anyone can change the validator set and the limits
+// of a chain that uses it.
+var DevAdminPrivateKey = func() ed25519.PrivateKey {
+	seed := sha256.Sum256([]byte("mojave-dev-admin"))
+	return ed25519.NewKeyFromSeed(seed[:])
+}()
+
+var DevAdminPubkey = []byte(DevAdminPrivateKey.Public().(ed25519.PublicKey))
+
 func Hash(tx []byte) string {
 	return bytes.HexBytes(tmhash.Sum(tx)).String()
 }